@@ -22,16 +22,19 @@ package blockchain
 
 import (
 	"context"
-	"maps"
-	"slices"
 	"strconv"
 	"time"
 
 	"github.com/berachain/beacon-kit/primitives/math"
 )
 
-// defaultRetryInterval processes a deposit event.
-const defaultRetryInterval = 20 * time.Second
+const (
+	// defaultRetryInterval processes a deposit event.
+	defaultRetryInterval = 20 * time.Second
+	// missingBlocksBatchSize is the number of missing blocks moved to the
+	// failed set and fetched at once.
+	missingBlocksBatchSize = 256
+)
 
 func (s *Service[
 	_, _, ConsensusBlockT, _, _, _,
@@ -48,7 +51,88 @@ func (s *Service[
 		return
 	}
 
-	s.fetchAndStoreDeposits(ctx, blockNum-s.eth1FollowDistance)
+	target := blockNum - s.eth1FollowDistance
+	s.fetchAndStoreDeposits(ctx, target)
+	s.advanceDepositCursor(target)
+}
+
+// advanceDepositCursor moves the persisted deposit cursor to the given
+// execution block. Blocks skipped since the cursor are recorded as a single
+// missing range, which depositCatchupFetcher backfills.
+func (s *Service[
+	_, _, ConsensusBlockT, _, _, _,
+]) advanceDepositCursor(blockNum math.U64) {
+	depositStore := s.storageBackend.DepositStore()
+	cursor, err := depositStore.GetEth1BlockCursor()
+	if err != nil {
+		s.logger.Error("Failed to get deposit cursor", "error", err)
+		return
+	}
+
+	// A zero cursor means no block was processed yet, so there is no gap.
+	if cursor != 0 && blockNum.Unwrap() > cursor+1 {
+		s.logger.Warn(
+			"Detected gap in blocks fetched for deposits, backfilling",
+			"from", cursor+1, "to", blockNum-1,
+		)
+		if err = depositStore.MarkEth1BlocksMissing(
+			blockNum.Unwrap() - 1,
+		); err != nil {
+			s.logger.Error(
+				"Failed to record missing deposit blocks", "error", err,
+			)
+			return
+		}
+	}
+
+	if blockNum.Unwrap() > cursor {
+		if err = depositStore.SetEth1BlockCursor(blockNum.Unwrap()); err != nil {
+			s.logger.Error("Failed to set deposit cursor", "error", err)
+			return
+		}
+	}
+	s.updateDepositFetchLag()
+}
+
+// detectDepositGap compares the deposit cursor with the execution head minus
+// the follow distance, e.g. after the node was down or restored from a
+// snapshot, and records the blocks in between as a missing range so that
+// they are backfilled before the chain reaches them.
+func (s *Service[
+	_, _, ConsensusBlockT, _, _, _,
+]) detectDepositGap(ctx context.Context) {
+	head, err := s.executionClient.BlockNumber(ctx)
+	if err != nil {
+		s.logger.Error("Failed to get execution head", "error", err)
+		return
+	}
+	if head <= s.eth1FollowDistance {
+		return
+	}
+	target := head - s.eth1FollowDistance
+
+	depositStore := s.storageBackend.DepositStore()
+	cursor, err := depositStore.GetEth1BlockCursor()
+	if err != nil {
+		s.logger.Error("Failed to get deposit cursor", "error", err)
+		return
+	}
+	// A zero cursor means no block was processed yet, so there is no gap.
+	if cursor == 0 || target.Unwrap() <= cursor {
+		return
+	}
+
+	s.logger.Warn(
+		"Deposit cursor is behind the execution chain, backfilling",
+		"from", cursor+1, "to", target,
+	)
+	if err = depositStore.MarkEth1BlocksMissing(target.Unwrap()); err != nil {
+		s.logger.Error(
+			"Failed to record missing deposit blocks", "error", err,
+		)
+		return
+	}
+	s.updateDepositFetchLag()
 }
 
 func (s *Service[
@@ -57,6 +141,7 @@ func (s *Service[
 	ctx context.Context,
 	blockNum math.U64,
 ) {
+	depositStore := s.storageBackend.DepositStore()
 	deposits, err := s.depositContract.ReadDeposits(ctx, blockNum)
 	if err != nil {
 		s.logger.Error("Failed to read deposits", "error", err)
@@ -65,9 +150,7 @@ func (s *Service[
 			"block_num",
 			strconv.FormatUint(blockNum.Unwrap(), 10),
 		)
-		s.failedBlocksMu.Lock()
-		s.failedBlocks[blockNum] = struct{}{}
-		s.failedBlocksMu.Unlock()
+		s.markDepositBlockFailed(blockNum)
 		return
 	}
 
@@ -78,22 +161,92 @@ func (s *Service[
 		)
 	}
 
-	if err = s.storageBackend.DepositStore().EnqueueDeposits(deposits); err != nil {
+	if err = depositStore.EnqueueDeposits(deposits); err != nil {
 		s.logger.Error("Failed to store deposits", "error", err)
-		s.failedBlocksMu.Lock()
-		s.failedBlocks[blockNum] = struct{}{}
-		s.failedBlocksMu.Unlock()
+		s.markDepositBlockFailed(blockNum)
+		return
+	}
+
+	// Withdrawal requests are read along with deposits so that the block is
+	// only considered fetched once both are stored.
+	requests, err := s.withdrawalRequestContract.ReadWithdrawalRequests(
+		ctx, blockNum,
+	)
+	if err != nil {
+		s.logger.Error("Failed to read withdrawal requests", "error", err)
+		s.markDepositBlockFailed(blockNum)
+		return
+	}
+
+	if len(requests) > 0 {
+		s.logger.Info(
+			"Found withdrawal requests on execution layer",
+			"block", blockNum, "requests", len(requests),
+		)
+	}
+
+	if err = depositStore.EnqueueWithdrawalRequests(requests); err != nil {
+		s.logger.Error("Failed to store withdrawal requests", "error", err)
+		s.markDepositBlockFailed(blockNum)
+		return
+	}
+
+	if err = depositStore.RemoveFailedEth1Block(blockNum.Unwrap()); err != nil {
+		s.logger.Error(
+			"Failed to clear failed deposit block",
+			"block", blockNum, "error", err,
+		)
+	}
+}
+
+// markDepositBlockFailed persists the given execution block in the failed set
+// so that it is retried, even across restarts.
+func (s *Service[
+	_, _, ConsensusBlockT, _, _, _,
+]) markDepositBlockFailed(blockNum math.U64) {
+	if err := s.storageBackend.DepositStore().AddFailedEth1Block(
+		blockNum.Unwrap(),
+	); err != nil {
+		s.logger.Error(
+			"Failed to record failed deposit block",
+			"block", blockNum, "error", err,
+		)
+	}
+}
+
+// updateDepositFetchLag reports how far the oldest block whose deposits are
+// still failed or missing lags behind the deposit cursor.
+func (s *Service[
+	_, _, ConsensusBlockT, _, _, _,
+]) updateDepositFetchLag() {
+	depositStore := s.storageBackend.DepositStore()
+	cursor, err := depositStore.GetEth1BlockCursor()
+	if err != nil {
+		s.logger.Error("Failed to get deposit cursor", "error", err)
+		return
+	}
+	oldest, found, err := depositStore.GetOldestMissingEth1Block()
+	if err != nil {
+		s.logger.Error("Failed to get missing deposit blocks", "error", err)
 		return
 	}
 
-	s.failedBlocksMu.Lock()
-	delete(s.failedBlocks, blockNum)
-	s.failedBlocksMu.Unlock()
+	var lag uint64
+	if found && oldest <= cursor {
+		lag = cursor - oldest + 1
+	}
+	s.metrics.setDepositFetchLag(lag)
 }
 
 func (s *Service[
 	_, _, ConsensusBlockT, _, _, _,
 ]) depositCatchupFetcher(ctx context.Context) {
+	// Backfill and retry right away the blocks missed or failed before a
+	// restart.
+	s.detectDepositGap(ctx)
+	s.fillMissingDeposits(ctx)
+	s.retryFailedDeposits(ctx)
+
 	ticker := time.NewTicker(defaultRetryInterval)
 	defer ticker.Stop()
 	for {
@@ -101,22 +254,64 @@ func (s *Service[
 		case <-ctx.Done():
 			return
 		case <-ticker.C:
-			s.failedBlocksMu.RLock()
-			failedBlks := slices.Collect(maps.Keys(s.failedBlocks))
-			s.failedBlocksMu.RUnlock()
-			if len(failedBlks) == 0 {
-				continue
-			}
-			s.logger.Warn(
-				"Failed to get deposits from block(s), retrying...",
-				"num_blocks",
-				failedBlks,
-			)
+			s.fillMissingDeposits(ctx)
+			s.retryFailedDeposits(ctx)
+		}
+	}
+}
 
-			// Fetch deposits for blocks that failed to be processed.
-			for _, blockNum := range failedBlks {
-				s.fetchAndStoreDeposits(ctx, blockNum)
+// fillMissingDeposits fetches the deposits of the missing blocks, moving them
+// to the failed set in batches so that a failed fetch is retried.
+func (s *Service[
+	_, _, ConsensusBlockT, _, _, _,
+]) fillMissingDeposits(ctx context.Context) {
+	depositStore := s.storageBackend.DepositStore()
+	for ctx.Err() == nil {
+		blocks, err := depositStore.TakeMissingEth1Blocks(
+			missingBlocksBatchSize,
+		)
+		if err != nil {
+			s.logger.Error("Failed to get missing deposit blocks", "error", err)
+			return
+		}
+		if len(blocks) == 0 {
+			return
+		}
+		for _, blockNum := range blocks {
+			if ctx.Err() != nil {
+				return
 			}
+			s.fetchAndStoreDeposits(ctx, math.U64(blockNum))
+		}
+		s.updateDepositFetchLag()
+	}
+}
+
+// retryFailedDeposits fetches the deposits of the blocks in the persisted
+// failed set.
+func (s *Service[
+	_, _, ConsensusBlockT, _, _, _,
+]) retryFailedDeposits(ctx context.Context) {
+	failedBlks, err := s.storageBackend.DepositStore().GetFailedEth1Blocks()
+	if err != nil {
+		s.logger.Error("Failed to get failed deposit blocks", "error", err)
+		return
+	}
+	if len(failedBlks) == 0 {
+		return
+	}
+	s.logger.Warn(
+		"Failed to get deposits from block(s), retrying...",
+		"num_blocks",
+		len(failedBlks),
+	)
+
+	// Fetch deposits for blocks that failed to be processed.
+	for _, blockNum := range failedBlks {
+		if ctx.Err() != nil {
+			return
 		}
+		s.fetchAndStoreDeposits(ctx, math.U64(blockNum))
 	}
+	s.updateDepositFetchLag()
 }
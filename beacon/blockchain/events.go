@@ -0,0 +1,79 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package blockchain
+
+import (
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	datypes "github.com/berachain/beacon-kit/da/types"
+	"github.com/berachain/beacon-kit/node-api/events"
+	"github.com/berachain/beacon-kit/primitives/common"
+)
+
+// publishFinalizedBlockEvents publishes the events of a block that has just
+// been finalized, along with the events of its blob sidecars.
+//
+// NOTE: CometBFT provides single slot finality, hence every finalized block is
+// also the new head and the latest finalized checkpoint.
+func (s *Service[
+	_, _, _, _, _, _,
+]) publishFinalizedBlockEvents(
+	blk *ctypes.BeaconBlock,
+	sidecars datypes.BlobSidecars,
+) {
+	var (
+		slot      = blk.GetSlot()
+		blockRoot = blk.HashTreeRoot()
+		stateRoot = blk.GetStateRoot()
+		epoch     = s.chainSpec.SlotToEpoch(slot)
+	)
+
+	for _, sidecar := range sidecars {
+		s.eventPublisher.Publish(events.TopicBlobSidecar, &events.BlobSidecarEvent{
+			BlockRoot:     blockRoot,
+			Index:         sidecar.GetIndex(),
+			Slot:          slot.Unwrap(),
+			KzgCommitment: sidecar.GetKzgCommitment(),
+			VersionedHash: common.ExecutionHash(
+				sidecar.GetKzgCommitment().ToVersionedHash(),
+			),
+		})
+	}
+
+	s.eventPublisher.Publish(events.TopicBlock, &events.BlockEvent{
+		Slot:  slot.Unwrap(),
+		Block: blockRoot,
+	})
+
+	s.eventPublisher.Publish(events.TopicHead, &events.HeadEvent{
+		Slot:            slot.Unwrap(),
+		Block:           blockRoot,
+		State:           stateRoot,
+		EpochTransition: slot.Unwrap()%s.chainSpec.SlotsPerEpoch() == 0,
+	})
+
+	s.eventPublisher.Publish(
+		events.TopicFinalizedCheckpoint, &events.FinalizedCheckpointEvent{
+			Block: blockRoot,
+			State: stateRoot,
+			Epoch: epoch.Unwrap(),
+		},
+	)
+}
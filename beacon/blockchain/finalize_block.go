@@ -24,8 +24,10 @@ import (
 	"context"
 	"time"
 
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
 	"github.com/berachain/beacon-kit/consensus/cometbft/service/encoding"
 	"github.com/berachain/beacon-kit/consensus/types"
+	datypes "github.com/berachain/beacon-kit/da/types"
 	"github.com/berachain/beacon-kit/primitives/math"
 	"github.com/berachain/beacon-kit/primitives/transition"
 	statedb "github.com/berachain/beacon-kit/state-transition/core/state"
@@ -75,6 +77,8 @@ func (s *Service[
 		blk,
 		req.GetProposerAddress(),
 		req.GetTime(),
+		req.GetMisbehavior(),
+		req.GetDecidedLastCommit(),
 	)
 
 	cBlk, ok := any(consensusBlk).(ConsensusBlockT)
@@ -88,6 +92,8 @@ func (s *Service[
 		s.logger.Error("Failed to process verified beacon block",
 			"error", finalizeErr,
 		)
+	} else {
+		s.publishFinalizedBlockEvents(blk, blobs)
 	}
 
 	// STEP 4: Post Finalizations cleanups
@@ -95,6 +101,15 @@ func (s *Service[
 	// fetch and store the deposit for the block
 	blockNum := blk.GetBody().GetExecutionPayload().GetNumber()
 	s.depositFetcher(ctx, blockNum)
+	if err = s.storageBackend.DepositStore().RecordCheckpoint(
+		//#nosec:G115 // the height is never negative.
+		uint64(req.Height),
+	); err != nil {
+		s.logger.Error(
+			"failed to record deposit checkpoint",
+			"height", req.Height, "error", err,
+		)
+	}
 
 	// store the finalized block in the KVStore.
 	slot := blk.GetSlot()
@@ -103,6 +118,7 @@ func (s *Service[
 			"failed to store block", "slot", slot, "error", err,
 		)
 	}
+	s.storeBlockSignature(blk, blobs)
 
 	// prune the availability and deposit store
 	err = s.processPruning(blk)
@@ -185,9 +201,36 @@ func (s *Service[
 
 			ProposerAddress: blk.GetProposerAddress(),
 			ConsensusTime:   blk.GetConsensusTime(),
+			Equivocations:   blk.GetEquivocations(),
+			Votes:           blk.GetVotes(),
 		},
 		st,
 		blk.GetBeaconBlock(),
 	)
 	return valUpdates, err
 }
+
+// storeBlockSignature stores the proposer signature of the block header, which
+// only travels with the blob sidecars of the block.
+func (s *Service[
+	_, _, _, _, _, _,
+]) storeBlockSignature(
+	blk *ctypes.BeaconBlock,
+	blobs datypes.BlobSidecars,
+) {
+	if len(blobs) == 0 {
+		return
+	}
+	signed := blobs[0].GetSignedBeaconBlockHeader()
+	if signed.GetHeader().HashTreeRoot() != blk.GetHeader().HashTreeRoot() {
+		return
+	}
+	if err := s.storageBackend.BlockStore().SetSignature(
+		blk.GetSlot(), signed.GetSignature(),
+	); err != nil {
+		s.logger.Error(
+			"failed to store block signature",
+			"slot", blk.GetSlot(), "error", err,
+		)
+	}
+}
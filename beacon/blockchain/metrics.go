@@ -116,3 +116,12 @@ func (cm *chainMetrics) measureStateRootVerificationTime(start time.Time) {
 		"beacon_kit.blockchain.state_root_verification_duration", start,
 	)
 }
+
+// setDepositFetchLag sets the number of execution blocks between the oldest
+// block whose deposits are still missing and the deposit cursor.
+func (cm *chainMetrics) setDepositFetchLag(lag uint64) {
+	cm.sink.SetGauge(
+		"beacon_kit.execution.deposit.fetch_lag",
+		int64(lag), //#nosec:G701 // lag is bounded by the block height.
+	)
+}
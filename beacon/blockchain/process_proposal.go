@@ -127,12 +127,16 @@ func (s *Service[
 		blk,
 		req.GetProposerAddress(),
 		req.GetTime(),
+		req.GetMisbehavior(),
+		req.GetProposedLastCommit(),
 	)
 	err = s.VerifyIncomingBlock(
 		ctx,
 		consensusBlk.GetBeaconBlock(),
 		consensusBlk.GetConsensusTime(),
 		consensusBlk.GetProposerAddress(),
+		consensusBlk.GetEquivocations(),
+		consensusBlk.GetVotes(),
 	)
 	if err != nil {
 		s.logger.Error("failed to verify incoming block", "error", err)
@@ -198,6 +202,8 @@ func (s *Service[
 	beaconBlk *ctypes.BeaconBlock,
 	consensusTime math.U64,
 	proposerAddress []byte,
+	equivocations []transition.Equivocation,
+	votes []transition.Vote,
 ) error {
 	// Grab a copy of the state to verify the incoming block.
 	preState := s.storageBackend.StateFromContext(ctx)
@@ -225,7 +231,10 @@ func (s *Service[
 		postState,
 		beaconBlk,
 		consensusTime,
-		proposerAddress)
+		proposerAddress,
+		equivocations,
+		votes,
+	)
 	if err != nil {
 		s.logger.Error(
 			"Rejecting incoming beacon block ❌ ",
@@ -294,6 +303,8 @@ func (s *Service[
 	blk *ctypes.BeaconBlock,
 	consensusTime math.U64,
 	proposerAddress []byte,
+	equivocations []transition.Equivocation,
+	votes []transition.Vote,
 ) error {
 	startTime := time.Now()
 	defer s.metrics.measureStateRootVerificationTime(startTime)
@@ -308,6 +319,8 @@ func (s *Service[
 			SkipValidateRandao:      false,
 			ProposerAddress:         proposerAddress,
 			ConsensusTime:           consensusTime,
+			Equivocations:           equivocations,
+			Votes:                   votes,
 		},
 		st, blk,
 	)
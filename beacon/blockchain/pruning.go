@@ -23,6 +23,8 @@ package blockchain
 import (
 	"github.com/berachain/beacon-kit/chain-spec/chain"
 	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	blockstore "github.com/berachain/beacon-kit/node-api/block_store"
+	"github.com/berachain/beacon-kit/storage/pruner"
 )
 
 func (s *Service[
@@ -45,7 +47,18 @@ func (s *Service[
 		return err
 	}
 
-	return nil
+	// prune the withdrawal requests processed by the block
+	start, end = withdrawalRequestPruneRangeFn(
+		beaconBlk.GetBody().GetWithdrawalRequests())
+	err = s.storageBackend.DepositStore().PruneWithdrawalRequests(start, end)
+	if err != nil {
+		return err
+	}
+
+	// prune block store
+	start, end = blockPruneRangeFn(
+		beaconBlk.GetSlot().Unwrap(), s.blockStoreCfg)
+	return s.storageBackend.BlockStore().Prune(start, end)
 }
 
 func depositPruneRangeFn([]*ctypes.Deposit, chain.ChainSpec) (uint64, uint64) {
@@ -55,13 +68,30 @@ func depositPruneRangeFn([]*ctypes.Deposit, chain.ChainSpec) (uint64, uint64) {
 	return 0, 0
 }
 
+func withdrawalRequestPruneRangeFn(
+	requests ctypes.WithdrawalRequests) (uint64, uint64) {
+	// Unlike deposits, withdrawal requests are only checked against the local
+	// store when included, so they are dropped once processed.
+	if len(requests) == 0 {
+		return 0, 0
+	}
+	return requests[0].Index, requests[len(requests)-1].Index + 1
+}
+
 //nolint:unparam // this is ok
 func availabilityPruneRangeFn(
 	slot uint64, cs chain.ChainSpec) (uint64, uint64) {
 	window := cs.MinEpochsForBlobsSidecarsRequest() * cs.SlotsPerEpoch()
-	if slot < window {
+	return pruner.WindowRange(slot, window)
+}
+
+func blockPruneRangeFn(
+	slot uint64, cfg blockstore.Config) (uint64, uint64) {
+	// Archive nodes keep every finalized block to serve historical lookups.
+	if cfg.RetentionMode == pruner.RetentionArchive {
 		return 0, 0
 	}
 
-	return 0, slot - window
+	//#nosec:G701 // the availability window is never negative.
+	return pruner.WindowRange(slot, uint64(cfg.AvailabilityWindow))
 }
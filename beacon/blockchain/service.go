@@ -52,13 +52,11 @@ type Service[
 	// depositContract is the contract interface for interacting with the
 	// deposit contract.
 	depositContract deposit.Contract
+	// withdrawalRequestContract is the contract interface for reading the
+	// withdrawal requests made on the execution layer.
+	withdrawalRequestContract deposit.WithdrawalRequestContract
 	// eth1FollowDistance is the follow distance for Ethereum 1.0 blocks.
 	eth1FollowDistance math.U64
-	// failedBlocksMu protects failedBlocks for concurrent access.
-	failedBlocksMu sync.RWMutex
-	// failedBlocks is a map of blocks that failed to be processed
-	// and should be retried.
-	failedBlocks map[math.U64]struct{}
 	// logger is used for logging messages in the service.
 	logger log.Logger
 	// chainSpec holds the chain specifications.
@@ -67,15 +65,21 @@ type Service[
 	//
 	// execution payloads.
 	executionEngine ExecutionEngine
+	// executionClient is used to read the head of the execution chain.
+	executionClient ExecutionClient
 	// localBuilder is a local builder for constructing new beacon states.
 	localBuilder LocalBuilder
 	// stateProcessor is the state processor for beacon blocks and states.
 	stateProcessor StateProcessor[*transition.Context]
+	// eventPublisher is used to publish the events of finalized blocks.
+	eventPublisher EventPublisher
 	// metrics is the metrics for the service.
 	metrics *chainMetrics
 	// optimisticPayloadBuilds is a flag used when the optimistic payload
 	// builder is enabled.
 	optimisticPayloadBuilds bool
+	// blockStoreCfg is the configuration of the block store retention.
+	blockStoreCfg blockstore.Config
 	// forceStartupSyncOnce is used to force a sync of the startup head.
 	forceStartupSyncOnce *sync.Once
 }
@@ -100,14 +104,18 @@ func NewService[
 		ConsensusSidecarsT,
 	],
 	depositContract deposit.Contract,
+	withdrawalRequestContract deposit.WithdrawalRequestContract,
 	eth1FollowDistance math.U64,
 	logger log.Logger,
 	chainSpec chain.ChainSpec,
 	executionEngine ExecutionEngine,
+	executionClient ExecutionClient,
 	localBuilder LocalBuilder,
 	stateProcessor StateProcessor[*transition.Context],
+	eventPublisher EventPublisher,
 	telemetrySink TelemetrySink,
 	optimisticPayloadBuilds bool,
+	blockStoreCfg blockstore.Config,
 ) *Service[
 	AvailabilityStoreT, DepositStoreT,
 	ConsensusBlockT,
@@ -121,20 +129,23 @@ func NewService[
 		BlockStoreT,
 		GenesisT, ConsensusSidecarsT,
 	]{
-		homeDir:                 homeDir,
-		storageBackend:          storageBackend,
-		blobProcessor:           blobProcessor,
-		depositContract:         depositContract,
-		eth1FollowDistance:      eth1FollowDistance,
-		failedBlocks:            make(map[math.Slot]struct{}),
-		logger:                  logger,
-		chainSpec:               chainSpec,
-		executionEngine:         executionEngine,
-		localBuilder:            localBuilder,
-		stateProcessor:          stateProcessor,
-		metrics:                 newChainMetrics(telemetrySink),
-		optimisticPayloadBuilds: optimisticPayloadBuilds,
-		forceStartupSyncOnce:    new(sync.Once),
+		homeDir:                   homeDir,
+		storageBackend:            storageBackend,
+		blobProcessor:             blobProcessor,
+		depositContract:           depositContract,
+		withdrawalRequestContract: withdrawalRequestContract,
+		eth1FollowDistance:        eth1FollowDistance,
+		logger:                    logger,
+		chainSpec:                 chainSpec,
+		executionEngine:           executionEngine,
+		executionClient:           executionClient,
+		localBuilder:              localBuilder,
+		stateProcessor:            stateProcessor,
+		eventPublisher:            eventPublisher,
+		metrics:                   newChainMetrics(telemetrySink),
+		optimisticPayloadBuilds:   optimisticPayloadBuilds,
+		blockStoreCfg:             blockStoreCfg,
+		forceStartupSyncOnce:      new(sync.Once),
 	}
 }
 
@@ -148,14 +159,17 @@ func (s *Service[
 func (s *Service[
 	_, _, _, _, _, _,
 ]) Start(ctx context.Context) error {
-	// Catchup deposits for failed blocks.
+	// Catchup deposits for failed blocks, including the ones that failed
+	// before the node restarted.
 	go s.depositCatchupFetcher(ctx)
 
 	return nil
 }
 
+// Stop closes the block store, which is written to on finalization, so it
+// must be stopped after consensus.
 func (s *Service[
 	_, _, _, _, _, _,
 ]) Stop() error {
-	return nil
+	return s.storageBackend.BlockStore().Close()
 }
@@ -26,6 +26,7 @@ import (
 
 	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
 	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
+	"github.com/berachain/beacon-kit/node-api/events"
 	"github.com/berachain/beacon-kit/primitives/common"
 	"github.com/berachain/beacon-kit/primitives/constraints"
 	"github.com/berachain/beacon-kit/primitives/crypto"
@@ -59,6 +60,13 @@ type ConsensusBlock interface {
 	// GetConsensusTime returns the timestamp of current consensus request.
 	// It is used to build next payload and to validate currentpayload.
 	GetConsensusTime() math.U64
+
+	// GetEquivocations returns the double-signing evidence included by
+	// consensus in the block.
+	GetEquivocations() []transition.Equivocation
+	// GetVotes returns the participation of validators in the commit of
+	// the previous block.
+	GetVotes() []transition.Vote
 }
 
 type BlobSidecars[T any] interface {
@@ -77,6 +85,19 @@ type ExecutionEngine interface {
 	) (*engineprimitives.PayloadID, *common.ExecutionHash, error)
 }
 
+// ExecutionClient reads the execution chain.
+type ExecutionClient interface {
+	// BlockNumber returns the number of the most recent execution block.
+	BlockNumber(ctx context.Context) (math.U64, error)
+}
+
+// EventPublisher publishes chain events to any interested subscribers, such
+// as the node API.
+type EventPublisher interface {
+	// Publish sends the event data to all the subscribers of the topic.
+	Publish(topic events.Topic, data any)
+}
+
 // ExecutionPayload is the interface for the execution payload.
 type ExecutionPayload interface {
 	ExecutionPayloadHeader
@@ -204,6 +225,10 @@ type TelemetrySink interface {
 	// MeasureSince measures the time since the provided start time,
 	// identified by the provided keys.
 	MeasureSince(key string, start time.Time, args ...string)
+
+	// SetGauge sets a gauge metric to the specified value, identified by the
+	// provided key.
+	SetGauge(key string, value int64, args ...string)
 }
 
 //nolint:revive // its ok
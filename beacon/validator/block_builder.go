@@ -105,23 +105,37 @@ func (s *Service[_]) BuildBlockAndSidecars(
 		return nil, nil, err
 	}
 
-	// Compute the state root for the block.
-	if err = s.computeAndSetStateRoot(
-		ctx,
-		slotData.GetProposerAddress(),
-		slotData.GetConsensusTime(),
-		st,
-		blk,
-	); err != nil {
-		return nil, nil, err
+	// Swap the local payload for the one of an external builder, if the
+	// relay offers a better one in time. The builder block is returned with
+	// its state root set and signed.
+	builderEnvelope, signature, ok := s.useBuilderPayload(
+		ctx, st, blk, forkData, envelope, slotData,
+	)
+	if ok {
+		envelope = builderEnvelope
+	} else {
+		// Compute the state root for the block.
+		if err = s.computeAndSetStateRoot(
+			ctx,
+			slotData.GetProposerAddress(),
+			slotData.GetConsensusTime(),
+			slotData.GetEquivocations(),
+			slotData.GetVotes(),
+			st,
+			blk,
+		); err != nil {
+			return nil, nil, err
+		}
+		if signature, err = s.signBlock(forkData, blk); err != nil {
+			return nil, nil, err
+		}
 	}
 
 	// Produce blob sidecars with new StateRoot
 	sidecars, err = s.blobFactory.BuildSidecars(
 		blk,
 		envelope.GetBlobsBundle(),
-		s.signer,
-		forkData,
+		signature,
 	)
 	if err != nil {
 		return nil, nil, err
@@ -197,6 +211,34 @@ func (s *Service[_]) buildForkData(
 	), nil
 }
 
+// signBlock signs the block, once its state root is set. The block and its
+// header share the same hash tree root, so the signature is the one of the
+// signed block header carried by the sidecars.
+func (s *Service[_]) signBlock(
+	forkData *ctypes.ForkData,
+	blk *ctypes.BeaconBlock,
+) (crypto.BLSSignature, error) {
+	return s.signBlockRoot(forkData, blk.GetSlot(), blk.HashTreeRoot())
+}
+
+// signBlockRoot signs the hash tree root of the block at the given slot,
+// through the slashing protection of the signer if it has one.
+func (s *Service[_]) signBlockRoot(
+	forkData *ctypes.ForkData,
+	slot math.Slot,
+	blockRoot common.Root,
+) (crypto.BLSSignature, error) {
+	domain := forkData.ComputeDomain(s.chainSpec.DomainTypeProposer())
+	signingRoot := (&ctypes.SigningData{
+		ObjectRoot: blockRoot,
+		Domain:     domain,
+	}).HashTreeRoot()
+	if bs, ok := s.signer.(BlockSigner); ok {
+		return bs.SignBlock(slot, domain, signingRoot)
+	}
+	return s.signer.Sign(signingRoot[:])
+}
+
 // buildRandaoReveal builds a randao reveal for the given slot.
 func (s *Service[_]) buildRandaoReveal(
 	forkData *ctypes.ForkData,
@@ -217,10 +259,8 @@ func (s *Service[_]) retrieveExecutionPayload(
 	blk *ctypes.BeaconBlock,
 	slotData types.SlotData,
 ) (ctypes.BuiltExecutionPayloadEnv, error) {
-	//
-	// TODO: Add external block builders to this flow.
-	//
-	// Get the payload for the block.
+	// Get the local payload for the block, which may later be swapped for
+	// the one of an external builder.
 	envelope, err := s.localPayloadBuilder.
 		RetrievePayload(
 			ctx,
@@ -325,6 +365,28 @@ func (s *Service[_]) buildBlockBody(
 	)
 	body.SetDeposits(deposits[depositIndex:])
 
+	// Set the withdrawal requests following the last processed one.
+	if s.chainSpec.SlotToEpoch(blk.GetSlot()) >=
+		s.chainSpec.WithdrawalRequestsForkEpoch() {
+		var requestIndex uint64
+		requestIndex, err = st.GetNextWithdrawalRequestIndex()
+		if err != nil {
+			return err
+		}
+		var requests ctypes.WithdrawalRequests
+		requests, err = s.sb.DepositStore().GetWithdrawalRequestsByIndex(
+			requestIndex, s.chainSpec.MaxWithdrawalRequestsPerBlock(),
+		)
+		if err != nil {
+			return err
+		}
+		s.logger.Info(
+			"Building block body with local withdrawal requests",
+			"start_index", requestIndex, "num_requests", len(requests),
+		)
+		body.SetWithdrawalRequests(requests)
+	}
+
 	// Set the graffiti on the block body.
 	sizedGraffiti := bytes.ExtendToSize([]byte(s.cfg.Graffiti), bytes.B32Size)
 	graffiti, err := bytes.ToBytes32(sizedGraffiti)
@@ -355,6 +417,8 @@ func (s *Service[_]) computeAndSetStateRoot(
 	ctx context.Context,
 	proposerAddress []byte,
 	consensusTime math.U64,
+	equivocations []transition.Equivocation,
+	votes []transition.Vote,
 	st *statedb.StateDB,
 	blk *ctypes.BeaconBlock,
 ) error {
@@ -362,6 +426,8 @@ func (s *Service[_]) computeAndSetStateRoot(
 		ctx,
 		proposerAddress,
 		consensusTime,
+		equivocations,
+		votes,
 		st,
 		blk,
 	)
@@ -382,6 +448,8 @@ func (s *Service[_]) computeStateRoot(
 	ctx context.Context,
 	proposerAddress []byte,
 	consensusTime math.U64,
+	equivocations []transition.Equivocation,
+	votes []transition.Vote,
 	st *statedb.StateDB,
 	blk *ctypes.BeaconBlock,
 ) (common.Root, error) {
@@ -399,6 +467,8 @@ func (s *Service[_]) computeStateRoot(
 			SkipValidateRandao:      true,
 			ProposerAddress:         proposerAddress,
 			ConsensusTime:           consensusTime,
+			Equivocations:           equivocations,
+			Votes:                   votes,
 		},
 		st, blk,
 	); err != nil {
@@ -37,6 +37,13 @@ type Config struct {
 
 	// EnableOptimisticPayloadBuilds is the optimistic block builder.
 	EnableOptimisticPayloadBuilds bool `mapstructure:"enable-optimistic-payload-builds"`
+
+	// KeystoreFile is the EIP-2335 keystore holding the validator key, used
+	// instead of the priv_validator_key_file if set.
+	KeystoreFile string `mapstructure:"keystore-file"`
+
+	// KeystorePasswordFile is the file holding the password of the keystore.
+	KeystorePasswordFile string `mapstructure:"keystore-password-file"`
 }
 
 // DefaultConfig returns the default fork configuration.
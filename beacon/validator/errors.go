@@ -41,4 +41,19 @@ var (
 	// ErrDepositStoreIncomplete is an error for when the deposit store has not returned
 	// the expected amount of deposits. Could be due to pruning when it should not be enabled.
 	ErrDepositStoreIncomplete = errors.New("deposits from deposit store incomplete")
+
+	// ErrBuilderBidTooLow is an error for when the builder bid is not worth
+	// more than the local payload.
+	ErrBuilderBidTooLow = errors.New("builder bid not above local payload value")
+
+	// ErrBuilderBidMismatch is an error for when the builder bid does not
+	// agree with the local payload on a field enforced by the state
+	// transition.
+	ErrBuilderBidMismatch = errors.New("builder bid mismatches local payload")
+
+	// ErrBuilderBlobsMismatch is an error for when the blobs revealed by the
+	// relay do not match the commitments of the bid.
+	ErrBuilderBlobsMismatch = errors.New(
+		"revealed blobs mismatch builder bid commitments",
+	)
 )
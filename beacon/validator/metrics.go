@@ -74,3 +74,27 @@ func (cm *validatorMetrics) failedToRetrievePayload(
 		err.Error(),
 	)
 }
+
+// failedToRetrieveBuilderPayload increments the counter for the number of
+// times the validator fell back to the local payload after a relay failure.
+func (cm *validatorMetrics) failedToRetrieveBuilderPayload(
+	slot math.Slot, err error,
+) {
+	cm.sink.IncrementCounter(
+		"beacon_kit.validator.failed_to_retrieve_builder_payload",
+		"slot",
+		slot.Base10(),
+		"error",
+		err.Error(),
+	)
+}
+
+// usedBuilderPayload increments the counter for the number of times the
+// validator proposed a payload from an external builder.
+func (cm *validatorMetrics) usedBuilderPayload(slot math.Slot) {
+	cm.sink.IncrementCounter(
+		"beacon_kit.validator.used_builder_payload",
+		"slot",
+		slot.Base10(),
+	)
+}
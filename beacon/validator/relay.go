@@ -0,0 +1,260 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package validator
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/consensus/types"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/payload/relay"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/math"
+	statedb "github.com/berachain/beacon-kit/state-transition/core/state"
+)
+
+// registerWithRelay registers the validator with the relay, then again every
+// registration interval, until the context is done.
+func (s *Service[_]) registerWithRelay(ctx context.Context) {
+	ticker := time.NewTicker(s.relayCfg.RegistrationInterval)
+	defer ticker.Stop()
+	for {
+		if err := s.relay.RegisterValidator(ctx); err != nil {
+			s.logger.Error(
+				"Failed to register validator with relay", "error", err,
+			)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// useBuilderPayload swaps the local payload of the block for the one of the
+// best builder bid, if it is worth more. The builder payload is revealed by
+// the relay in exchange of the blinded block signed by the proposer, so the
+// state root is computed from the bid header before signing. On success, the
+// block carries the builder payload and its state root, and is returned along
+// with its signature. The block is left untouched whenever the relay fails to
+// bid or to reveal in time, for the local payload to be used instead.
+func (s *Service[_]) useBuilderPayload(
+	ctx context.Context,
+	st *statedb.StateDB,
+	blk *ctypes.BeaconBlock,
+	forkData *ctypes.ForkData,
+	local ctypes.BuiltExecutionPayloadEnv,
+	slotData types.SlotData,
+) (ctypes.BuiltExecutionPayloadEnv, crypto.BLSSignature, bool) {
+	if !s.relay.Enabled() || local.ShouldOverrideBuilder() {
+		return nil, crypto.BLSSignature{}, false
+	}
+
+	envelope, signature, err := s.retrieveBuilderPayload(
+		ctx, st, blk, forkData, local, slotData,
+	)
+	if err != nil {
+		if !errors.Is(err, relay.ErrNoBid) {
+			s.metrics.failedToRetrieveBuilderPayload(blk.GetSlot(), err)
+		}
+		s.logger.Info(
+			"Using local payload",
+			"slot", blk.GetSlot().Base10(),
+			"reason", err,
+		)
+		return nil, crypto.BLSSignature{}, false
+	}
+
+	s.metrics.usedBuilderPayload(blk.GetSlot())
+	s.logger.Info(
+		"Using builder payload",
+		"slot", blk.GetSlot().Base10(),
+		"block_hash", envelope.GetExecutionPayload().GetBlockHash(),
+		"value", envelope.GetValue().Dec(),
+	)
+	return envelope, signature, true
+}
+
+// retrieveBuilderPayload requests a bid from the relay and, if it beats the
+// local payload, signs the blinded block to have the relay reveal the builder
+// payload, which is then set on the block.
+func (s *Service[_]) retrieveBuilderPayload(
+	ctx context.Context,
+	st *statedb.StateDB,
+	blk *ctypes.BeaconBlock,
+	forkData *ctypes.ForkData,
+	local ctypes.BuiltExecutionPayloadEnv,
+	slotData types.SlotData,
+) (ctypes.BuiltExecutionPayloadEnv, crypto.BLSSignature, error) {
+	localPayload := local.GetExecutionPayload()
+	bid, err := s.relay.GetHeader(
+		ctx,
+		blk.GetSlot(),
+		localPayload.GetParentHash(),
+		s.signer.PublicKey(),
+	)
+	if err != nil {
+		return nil, crypto.BLSSignature{}, err
+	}
+
+	localValue := local.GetValue()
+	if localValue == nil {
+		localValue = math.NewU256(0)
+	}
+	if bid.Value.Cmp(localValue) <= 0 {
+		return nil, crypto.BLSSignature{}, errors.Wrapf(
+			ErrBuilderBidTooLow,
+			"bid %s, local %s", bid.Value.Dec(), localValue.Dec(),
+		)
+	}
+	if err = verifyBuilderBid(bid, localPayload); err != nil {
+		return nil, crypto.BLSSignature{}, err
+	}
+
+	blinded := ctypes.NewBlindedBeaconBlock(blk, bid.Header)
+	blinded.Body.BlobKzgCommitments = bid.BlobKzgCommitments
+	stateRoot, err := s.computeBuilderStateRoot(ctx, st, blk, blinded, slotData)
+	if err != nil {
+		return nil, crypto.BLSSignature{}, err
+	}
+	blinded.StateRoot = stateRoot
+
+	// The blinded block shares the hash tree root of the block with the
+	// revealed payload, so its signature is the one of the block.
+	signature, err := s.signBlockRoot(
+		forkData, blk.GetSlot(), blinded.HashTreeRoot(),
+	)
+	if err != nil {
+		return nil, crypto.BLSSignature{}, fmt.Errorf(
+			"failed signing blinded block: %w", err,
+		)
+	}
+
+	revealed, err := s.relay.SubmitBlindedBlock(
+		ctx,
+		&relay.SignedBlindedBeaconBlock{
+			Message:   blinded,
+			Signature: signature,
+		},
+	)
+	if err != nil {
+		return nil, crypto.BLSSignature{}, err
+	}
+	if _, err = blinded.Unblind(revealed.ExecutionPayload); err != nil {
+		return nil, crypto.BLSSignature{}, err
+	}
+	if !slices.Equal(
+		revealed.BlobsBundle.GetCommitments(), bid.BlobKzgCommitments,
+	) {
+		return nil, crypto.BLSSignature{}, ErrBuilderBlobsMismatch
+	}
+
+	body := blk.GetBody()
+	body.SetExecutionPayload(revealed.ExecutionPayload)
+	body.SetBlobKzgCommitments(revealed.BlobsBundle.GetCommitments())
+	blk.SetStateRoot(stateRoot)
+	return &ctypes.ExecutionPayloadEnvelope[*relay.BlobsBundle]{
+		ExecutionPayload: revealed.ExecutionPayload,
+		BlockValue:       bid.Value,
+		BlobsBundle:      revealed.BlobsBundle,
+	}, signature, nil
+}
+
+// computeBuilderStateRoot computes the state root of the block carrying the
+// payload of the blinded block. The state transition depends on the payload
+// through its header and withdrawals only, and the withdrawals of the bid are
+// checked against the local ones. So the transition is run with the local
+// payload, on a copy of the state to keep it for the local block, before
+// setting the payload header and the body root of the blinded block.
+func (s *Service[_]) computeBuilderStateRoot(
+	ctx context.Context,
+	st *statedb.StateDB,
+	blk *ctypes.BeaconBlock,
+	blinded *ctypes.BlindedBeaconBlock,
+	slotData types.SlotData,
+) (common.Root, error) {
+	st = st.Copy(ctx)
+	if _, err := s.computeStateRoot(
+		ctx,
+		slotData.GetProposerAddress(),
+		slotData.GetConsensusTime(),
+		slotData.GetEquivocations(),
+		slotData.GetVotes(),
+		st,
+		blk,
+	); err != nil {
+		return common.Root{}, err
+	}
+
+	if err := st.SetLatestExecutionPayloadHeader(
+		blinded.Body.ExecutionPayloadHeader,
+	); err != nil {
+		return common.Root{}, err
+	}
+	latestHeader, err := st.GetLatestBlockHeader()
+	if err != nil {
+		return common.Root{}, err
+	}
+	latestHeader.SetBodyRoot(blinded.Body.HashTreeRoot())
+	if err = st.SetLatestBlockHeader(latestHeader); err != nil {
+		return common.Root{}, err
+	}
+	return st.HashTreeRoot(), nil
+}
+
+// verifyBuilderBid checks that the bid agrees with the local payload on the
+// fields the state transition enforces, so that the block stays valid with
+// the builder payload.
+func verifyBuilderBid(
+	bid *relay.BuilderBid,
+	local *ctypes.ExecutionPayload,
+) error {
+	header := bid.Header
+	switch {
+	case header.GetParentHash() != local.GetParentHash():
+		return errors.Wrapf(
+			ErrBuilderBidMismatch, "parent hash %s, local %s",
+			header.GetParentHash(), local.GetParentHash(),
+		)
+	case header.GetTimestamp() != local.GetTimestamp():
+		return errors.Wrapf(
+			ErrBuilderBidMismatch, "timestamp %d, local %d",
+			header.GetTimestamp(), local.GetTimestamp(),
+		)
+	case header.GetNumber() != local.GetNumber():
+		return errors.Wrapf(
+			ErrBuilderBidMismatch, "number %d, local %d",
+			header.GetNumber(), local.GetNumber(),
+		)
+	case header.GetPrevRandao() != local.GetPrevRandao():
+		return errors.Wrap(ErrBuilderBidMismatch, "prev randao")
+	case header.GetWithdrawalsRoot() !=
+		local.GetWithdrawals().HashTreeRoot():
+		return errors.Wrap(ErrBuilderBidMismatch, "withdrawals root")
+	}
+	return nil
+}
@@ -25,6 +25,7 @@ import (
 
 	"github.com/berachain/beacon-kit/chain-spec/chain"
 	"github.com/berachain/beacon-kit/log"
+	"github.com/berachain/beacon-kit/payload/relay"
 	"github.com/berachain/beacon-kit/primitives/crypto"
 	"github.com/berachain/beacon-kit/primitives/transition"
 )
@@ -55,6 +56,11 @@ type Service[
 	// remotePayloadBuilders represents a list of remote block builders, these
 	// builders are connected to other execution clients via the EngineAPI.
 	remotePayloadBuilders []PayloadBuilder
+	// relayCfg is the relay config.
+	relayCfg *relay.Config
+	// relay requests payloads from external block builders, falling back to
+	// the local builder.
+	relay Relay
 	// metrics is a metrics collector.
 	metrics *validatorMetrics
 }
@@ -72,6 +78,8 @@ func NewService[
 	blobFactory BlobFactory,
 	localPayloadBuilder PayloadBuilder,
 	remotePayloadBuilders []PayloadBuilder,
+	relayCfg *relay.Config,
+	relay Relay,
 	ts TelemetrySink,
 ) *Service[DepositStoreT] {
 	return &Service[DepositStoreT]{
@@ -84,6 +92,8 @@ func NewService[
 		blobFactory:           blobFactory,
 		localPayloadBuilder:   localPayloadBuilder,
 		remotePayloadBuilders: remotePayloadBuilders,
+		relayCfg:              relayCfg,
+		relay:                 relay,
 		metrics:               newValidatorMetrics(ts),
 	}
 }
@@ -94,8 +104,11 @@ func (s *Service[_]) Name() string {
 }
 
 func (s *Service[_]) Start(
-	_ context.Context,
+	ctx context.Context,
 ) error {
+	if s.relay.Enabled() {
+		go s.registerWithRelay(ctx)
+	}
 	return nil
 }
 
@@ -27,6 +27,7 @@ import (
 	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
 	"github.com/berachain/beacon-kit/consensus/types"
 	datypes "github.com/berachain/beacon-kit/da/types"
+	"github.com/berachain/beacon-kit/payload/relay"
 	"github.com/berachain/beacon-kit/primitives/common"
 	"github.com/berachain/beacon-kit/primitives/constraints"
 	"github.com/berachain/beacon-kit/primitives/crypto"
@@ -70,6 +71,9 @@ type BeaconBlockBody interface {
 	SetEth1Data(*ctypes.Eth1Data)
 	// SetDeposits sets the deposits of the beacon block body.
 	SetDeposits([]*ctypes.Deposit)
+	// SetWithdrawalRequests sets the withdrawal requests of the beacon block
+	// body.
+	SetWithdrawalRequests(ctypes.WithdrawalRequests)
 	// SetExecutionPayload sets the execution data of the beacon block body.
 	SetExecutionPayload(*ctypes.ExecutionPayload)
 	// SetGraffiti sets the graffiti of the beacon block body.
@@ -103,14 +107,23 @@ type BeaconState interface {
 	GetGenesisValidatorsRoot() (common.Root, error)
 }
 
+// BlockSigner is a signer guarding against signing conflicting beacon blocks.
+type BlockSigner interface {
+	// SignBlock signs the signing root of the beacon block at the given slot
+	// in the given domain, unless it conflicts with a block signed before.
+	SignBlock(
+		slot math.Slot, domain common.Domain, signingRoot common.Root,
+	) (crypto.BLSSignature, error)
+}
+
 // BlobFactory represents a blob factory interface.
 type BlobFactory interface {
-	// BuildSidecars builds sidecars for a given block and blobs bundle.
+	// BuildSidecars builds sidecars for a given block and blobs bundle,
+	// carrying the header of the block along with its signature.
 	BuildSidecars(
 		blk *ctypes.BeaconBlock,
 		blobs ctypes.BlobsBundle,
-		signer crypto.BLSSigner,
-		forkData *ctypes.ForkData,
+		signature crypto.BLSSignature,
 	) (datypes.BlobSidecars, error)
 }
 
@@ -121,6 +134,12 @@ type DepositStore interface {
 		startIndex uint64,
 		numView uint64,
 	) (ctypes.Deposits, error)
+	// GetWithdrawalRequestsByIndex returns `numView` expected withdrawal
+	// requests.
+	GetWithdrawalRequestsByIndex(
+		startIndex uint64,
+		numView uint64,
+	) (ctypes.WithdrawalRequests, error)
 }
 
 // ForkData represents the fork data interface.
@@ -161,6 +180,28 @@ type PayloadBuilder interface {
 	) (ctypes.BuiltExecutionPayloadEnv, error)
 }
 
+// Relay represents a relay to external block builders.
+type Relay interface {
+	// Enabled returns whether payloads are requested from the relay.
+	Enabled() bool
+	// RegisterValidator registers the validator with the relay.
+	RegisterValidator(ctx context.Context) error
+	// GetHeader requests the best bid for the payload of the given slot
+	// built on top of the given parent.
+	GetHeader(
+		ctx context.Context,
+		slot math.Slot,
+		parentHash common.ExecutionHash,
+		pubkey crypto.BLSPubkey,
+	) (*relay.BuilderBid, error)
+	// SubmitBlindedBlock submits the signed blinded block to the relay,
+	// which reveals the payload committed to in exchange.
+	SubmitBlindedBlock(
+		ctx context.Context,
+		blk *relay.SignedBlindedBeaconBlock,
+	) (*relay.ExecutionPayloadAndBlobsBundle, error)
+}
+
 // SlotData represents the slot data interface.
 type SlotData interface {
 	// GetSlot returns the slot of the incoming slot.
@@ -175,6 +216,12 @@ type SlotData interface {
 	// GetConsensusTime returns the timestamp of current consensus request.
 	// It is used to build next payload and to validate currentpayload.
 	GetConsensusTime() math.U64
+	// GetEquivocations returns the double-signing evidence to be included by
+	// consensus in the block.
+	GetEquivocations() []transition.Equivocation
+	// GetVotes returns the participation of validators in the commit of
+	// the previous block.
+	GetVotes() []transition.Vote
 }
 
 // StateProcessor defines the interface for processing the state.
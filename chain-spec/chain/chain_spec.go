@@ -115,6 +115,14 @@ type Spec[
 	// block.
 	MaxDepositsPerBlock() uint64
 
+	// WithdrawalRequestContractAddress returns the withdrawal request
+	// contract address.
+	WithdrawalRequestContractAddress() common.ExecutionAddress
+
+	// MaxWithdrawalRequestsPerBlock returns the maximum number of withdrawal
+	// requests processed per block.
+	MaxWithdrawalRequestsPerBlock() uint64
+
 	// DepositEth1ChainID returns the chain ID of the deposit contract.
 	DepositEth1ChainID() uint64
 
@@ -131,6 +139,18 @@ type Spec[
 	// ElectraForkEpoch returns the epoch at which the Electra fork takes
 	// effect.
 	ElectraForkEpoch() EpochT
+	// SlashingForkEpoch returns the epoch at which validators start being
+	// slashed for double-signing evidence reported by consensus.
+	SlashingForkEpoch() EpochT
+	// RewardsForkEpoch returns the epoch at which validators start being
+	// rewarded and penalized based on their consensus voting participation.
+	RewardsForkEpoch() EpochT
+	// WithdrawalRequestsForkEpoch returns the epoch at which withdrawal
+	// requests made on the execution layer start being processed.
+	WithdrawalRequestsForkEpoch() EpochT
+	// ChurnLimitForkEpoch returns the epoch at which validator activations
+	// and exits start being limited by the churn limits.
+	ChurnLimitForkEpoch() EpochT
 
 	// State list lengths
 
@@ -153,10 +173,18 @@ type Spec[
 	// InactivityPenaltyQuotient returns the inactivity penalty quotient.
 	InactivityPenaltyQuotient() uint64
 
+	// BaseRewardPerIncrement returns the per-epoch reward, in Gwei, for each
+	// effective balance increment of a fully participating validator.
+	BaseRewardPerIncrement() uint64
+
 	// ProportionalSlashingMultiplier returns the multiplier for calculating
 	// slashing penalties.
 	ProportionalSlashingMultiplier() uint64
 
+	// MinSlashingPenaltyQuotient returns the quotient of the effective balance
+	// taken as the initial penalty of a slashed validator.
+	MinSlashingPenaltyQuotient() uint64
+
 	// Capella Values
 
 	// MaxWithdrawalsPerPayload returns the maximum number of withdrawals per
@@ -207,12 +235,24 @@ type Spec[
 	// slot.
 	GetCometBFTConfigForSlot(slot SlotT) CometBFTConfigT
 
+	// Values returns the scalar chain spec parameters keyed by their
+	// UPPER_SNAKE_CASE name, formatted as beacon API strings.
+	Values() map[string]string
+
 	// Berachain Values
 
 	// ValidatorSetCap retrieves the maximum number of
 	// validators allowed in the active set.
 	ValidatorSetCap() uint64
 
+	// ActivationChurnLimit returns the maximum number of validators activated
+	// per epoch, starting from ChurnLimitForkEpoch.
+	ActivationChurnLimit() uint64
+
+	// ExitChurnLimit returns the maximum number of validators exiting per
+	// epoch, starting from ChurnLimitForkEpoch.
+	ExitChurnLimit() uint64
+
 	// EVMInflationAddress returns the address on the EVM which will receive
 	// the inflation amount of native EVM balance through a withdrawal every
 	// block.
@@ -266,6 +306,28 @@ func (c *chainSpec[
 		return ErrInvalidValidatorSetCap
 	}
 
+	if c.MinSlashingPenaltyQuotient() == 0 {
+		return ErrZeroMinSlashingPenaltyQuotient
+	}
+
+	if c.InactivityPenaltyQuotient() == 0 {
+		return ErrZeroInactivityPenaltyQuotient
+	}
+
+	if c.ActivationChurnLimit() == 0 || c.ExitChurnLimit() == 0 {
+		return ErrZeroChurnLimit
+	}
+
+	// The inactivity scores are only part of the Electra beacon state.
+	if c.RewardsForkEpoch() < c.ElectraForkEpoch() {
+		return ErrRewardsForkBeforeElectra
+	}
+
+	// Withdrawal requests are only part of the Electra block body and state.
+	if c.WithdrawalRequestsForkEpoch() < c.ElectraForkEpoch() {
+		return ErrWithdrawalRequestsForkBeforeElectra
+	}
+
 	// EVM Inflation values can be zero or non-zero, no validation needed.
 
 	// TODO: Add more validation rules here.
@@ -415,6 +477,22 @@ func (c chainSpec[
 	return c.Data.MaxDepositsPerBlock
 }
 
+// WithdrawalRequestContractAddress returns the address of the withdrawal
+// request contract.
+func (c chainSpec[
+	DomainTypeT, EpochT, SlotT, CometBFTConfigT,
+]) WithdrawalRequestContractAddress() common.ExecutionAddress {
+	return c.Data.WithdrawalRequestContractAddress
+}
+
+// MaxWithdrawalRequestsPerBlock returns the maximum number of withdrawal
+// requests processed per block.
+func (c chainSpec[
+	DomainTypeT, EpochT, SlotT, CometBFTConfigT,
+]) MaxWithdrawalRequestsPerBlock() uint64 {
+	return c.Data.MaxWithdrawalRequestsPerBlock
+}
+
 // DepositEth1ChainID returns the chain ID of the execution chain.
 func (c chainSpec[
 	DomainTypeT, EpochT, SlotT, CometBFTConfigT,
@@ -451,6 +529,38 @@ func (c chainSpec[
 	return c.Data.ElectraForkEpoch
 }
 
+// SlashingForkEpoch returns the epoch at which validators start being slashed
+// for double-signing evidence reported by consensus.
+func (c chainSpec[
+	DomainTypeT, EpochT, SlotT, CometBFTConfigT,
+]) SlashingForkEpoch() EpochT {
+	return c.Data.SlashingForkEpoch
+}
+
+// RewardsForkEpoch returns the epoch at which validators start being rewarded
+// and penalized based on their consensus voting participation.
+func (c chainSpec[
+	DomainTypeT, EpochT, SlotT, CometBFTConfigT,
+]) RewardsForkEpoch() EpochT {
+	return c.Data.RewardsForkEpoch
+}
+
+// WithdrawalRequestsForkEpoch returns the epoch at which withdrawal requests
+// made on the execution layer start being processed.
+func (c chainSpec[
+	DomainTypeT, EpochT, SlotT, CometBFTConfigT,
+]) WithdrawalRequestsForkEpoch() EpochT {
+	return c.Data.WithdrawalRequestsForkEpoch
+}
+
+// ChurnLimitForkEpoch returns the epoch at which validator activations and
+// exits start being limited by the churn limits.
+func (c chainSpec[
+	DomainTypeT, EpochT, SlotT, CometBFTConfigT,
+]) ChurnLimitForkEpoch() EpochT {
+	return c.Data.ChurnLimitForkEpoch
+}
+
 // EpochsPerHistoricalVector returns the number of epochs per historical vector.
 func (c chainSpec[
 	DomainTypeT, EpochT, SlotT, CometBFTConfigT,
@@ -486,6 +596,14 @@ func (c chainSpec[
 	return c.Data.InactivityPenaltyQuotient
 }
 
+// BaseRewardPerIncrement returns the per-epoch reward per effective balance
+// increment.
+func (c chainSpec[
+	DomainTypeT, EpochT, SlotT, CometBFTConfigT,
+]) BaseRewardPerIncrement() uint64 {
+	return c.Data.BaseRewardPerIncrement
+}
+
 // ProportionalSlashingMultiplier returns the proportional slashing multiplier.
 func (c chainSpec[
 	DomainTypeT, EpochT, SlotT, CometBFTConfigT,
@@ -493,6 +611,14 @@ func (c chainSpec[
 	return c.Data.ProportionalSlashingMultiplier
 }
 
+// MinSlashingPenaltyQuotient returns the quotient of the effective balance
+// taken as the initial penalty of a slashed validator.
+func (c chainSpec[
+	DomainTypeT, EpochT, SlotT, CometBFTConfigT,
+]) MinSlashingPenaltyQuotient() uint64 {
+	return c.Data.MinSlashingPenaltyQuotient
+}
+
 // MaxWithdrawalsPerPayload returns the maximum number of withdrawals per
 // payload.
 func (c chainSpec[
@@ -566,6 +692,22 @@ func (c chainSpec[
 	return c.Data.ValidatorSetCap
 }
 
+// ActivationChurnLimit returns the maximum number of validators activated per
+// epoch, starting from ChurnLimitForkEpoch.
+func (c chainSpec[
+	DomainTypeT, EpochT, SlotT, CometBFTConfigT,
+]) ActivationChurnLimit() uint64 {
+	return c.Data.ActivationChurnLimit
+}
+
+// ExitChurnLimit returns the maximum number of validators exiting per epoch,
+// starting from ChurnLimitForkEpoch.
+func (c chainSpec[
+	DomainTypeT, EpochT, SlotT, CometBFTConfigT,
+]) ExitChurnLimit() uint64 {
+	return c.Data.ExitChurnLimit
+}
+
 // EVMInflationAddress returns the address on the EVM which will receive the
 // inflation amount of native EVM balance through a withdrawal every block.
 func (c chainSpec[
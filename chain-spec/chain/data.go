@@ -92,6 +92,12 @@ type SpecData[
 	// MaxDepositsPerBlock specifies the maximum number of deposit operations
 	// allowed per block.
 	MaxDepositsPerBlock uint64 `mapstructure:"max-deposits-per-block"`
+	// WithdrawalRequestContractAddress is the address of the withdrawal
+	// request contract.
+	WithdrawalRequestContractAddress common.ExecutionAddress `mapstructure:"withdrawal-request-contract-address"`
+	// MaxWithdrawalRequestsPerBlock specifies the maximum number of
+	// withdrawal requests processed per block.
+	MaxWithdrawalRequestsPerBlock uint64 `mapstructure:"max-withdrawal-requests-per-block"`
 	// DepositEth1ChainID is the chain ID of the execution client.
 	DepositEth1ChainID uint64 `mapstructure:"deposit-eth1-chain-id"`
 	// Eth1FollowDistance is the distance between the eth1 chain and the beacon
@@ -106,6 +112,18 @@ type SpecData[
 	DenebPlusForkEpoch EpochT `mapstructure:"deneb-plus-fork-epoch"`
 	// ElectraForkEpoch is the epoch at which the Electra fork is activated.
 	ElectraForkEpoch EpochT `mapstructure:"electra-fork-epoch"`
+	// SlashingForkEpoch is the epoch at which validators start being slashed
+	// for double-signing evidence reported by consensus.
+	SlashingForkEpoch EpochT `mapstructure:"slashing-fork-epoch"`
+	// RewardsForkEpoch is the epoch at which validators start being rewarded
+	// and penalized based on their consensus voting participation.
+	RewardsForkEpoch EpochT `mapstructure:"rewards-fork-epoch"`
+	// WithdrawalRequestsForkEpoch is the epoch at which withdrawal requests
+	// made on the execution layer start being processed.
+	WithdrawalRequestsForkEpoch EpochT `mapstructure:"withdrawal-requests-fork-epoch"`
+	// ChurnLimitForkEpoch is the epoch at which validator activations and
+	// exits start being limited by ActivationChurnLimit and ExitChurnLimit.
+	ChurnLimitForkEpoch EpochT `mapstructure:"churn-limit-fork-epoch"`
 
 	// State list lengths
 	//
@@ -124,9 +142,16 @@ type SpecData[
 	//
 	// InactivityPenaltyQuotient is the inactivity penalty quotient.
 	InactivityPenaltyQuotient uint64 `mapstructure:"inactivity-penalty-quotient"`
+	// BaseRewardPerIncrement is the reward, in Gwei, credited each epoch per
+	// effective balance increment to validators with no missed votes. Zero
+	// disables consensus-layer rewards.
+	BaseRewardPerIncrement uint64 `mapstructure:"base-reward-per-increment"`
 	// ProportionalSlashingMultiplier is the slashing multiplier relative to the
 	// base penalty.
 	ProportionalSlashingMultiplier uint64 `mapstructure:"proportional-slashing-multiplier"`
+	// MinSlashingPenaltyQuotient is the quotient of the effective balance
+	// taken as the initial penalty of a slashed validator.
+	MinSlashingPenaltyQuotient uint64 `mapstructure:"min-slashing-penalty-quotient"`
 
 	// Capella Values
 	//
@@ -169,6 +194,12 @@ type SpecData[
 	// for a given epoch
 	// Note: ValidatorSetCap must be smaller than ValidatorRegistryLimit.
 	ValidatorSetCap uint64 `mapstructure:"validator-set-cap-size"`
+	// ActivationChurnLimit is the maximum number of validators activated per
+	// epoch, starting from ChurnLimitForkEpoch.
+	ActivationChurnLimit uint64 `mapstructure:"activation-churn-limit"`
+	// ExitChurnLimit is the maximum number of validators exiting per epoch,
+	// starting from ChurnLimitForkEpoch.
+	ExitChurnLimit uint64 `mapstructure:"exit-churn-limit"`
 	// EVMInflationAddress is the address on the EVM which will receive the
 	// inflation amount of native EVM balance through a withdrawal every block.
 	EVMInflationAddress common.ExecutionAddress `mapstructure:"evm-inflation-address"`
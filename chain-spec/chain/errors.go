@@ -35,4 +35,37 @@ var (
 	ErrInvalidValidatorSetCap = errors.New(
 		"validator set cap must be less than the validator registry limit",
 	)
+
+	// ErrZeroMinSlashingPenaltyQuotient is returned when the min slashing
+	// penalty quotient is zero.
+	ErrZeroMinSlashingPenaltyQuotient = errors.New(
+		"min slashing penalty quotient must be greater than 0",
+	)
+
+	// ErrZeroInactivityPenaltyQuotient is returned when the inactivity
+	// penalty quotient is zero.
+	ErrZeroInactivityPenaltyQuotient = errors.New(
+		"inactivity penalty quotient must be greater than 0",
+	)
+
+	// ErrZeroChurnLimit is returned when the activation or exit churn limit
+	// is zero.
+	ErrZeroChurnLimit = errors.New(
+		"activation and exit churn limits must be greater than 0",
+	)
+
+	// ErrRewardsForkBeforeElectra is returned when the rewards fork is
+	// activated before the Electra fork, whose beacon state holds the
+	// inactivity scores.
+	ErrRewardsForkBeforeElectra = errors.New(
+		"rewards fork epoch must not be before the electra fork epoch",
+	)
+
+	// ErrWithdrawalRequestsForkBeforeElectra is returned when withdrawal
+	// requests are enabled before the Electra fork, whose block body and
+	// beacon state carry them.
+	ErrWithdrawalRequestsForkBeforeElectra = errors.New(
+		"withdrawal requests fork epoch must not be before the electra " +
+			"fork epoch",
+	)
 )
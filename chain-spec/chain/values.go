@@ -0,0 +1,85 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package chain
+
+import (
+	"encoding"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/berachain/beacon-kit/primitives/encoding/hex"
+)
+
+// specTag is the struct tag used to name the fields of SpecData.
+const specTag = "mapstructure"
+
+// Values returns every scalar parameter of the chain spec keyed by its
+// UPPER_SNAKE_CASE name, with values formatted as in the beacon API: integers
+// as decimal strings and byte arrays as 0x-prefixed hex. Nested values, such
+// as the CometBFT config, are not included.
+func (c chainSpec[
+	DomainTypeT, EpochT, SlotT, CometBFTConfigT,
+]) Values() map[string]string {
+	v := reflect.ValueOf(c.Data)
+	t := v.Type()
+	values := make(map[string]string, t.NumField())
+	for i := range t.NumField() {
+		tag := t.Field(i).Tag.Get(specTag)
+		if tag == "" {
+			continue
+		}
+		formatted, ok := formatSpecValue(v.Field(i))
+		if !ok {
+			continue
+		}
+		key := strings.ToUpper(strings.ReplaceAll(tag, "-", "_"))
+		values[key] = formatted
+	}
+	return values
+}
+
+// formatSpecValue formats a single SpecData field, reporting false if the
+// field is not a scalar value.
+func formatSpecValue(field reflect.Value) (string, bool) {
+	if m, ok := field.Interface().(encoding.TextMarshaler); ok {
+		text, err := m.MarshalText()
+		if err != nil {
+			return "", false
+		}
+		return string(text), true
+	}
+
+	switch field.Kind() {
+	case reflect.Uint, reflect.Uint8, reflect.Uint16,
+		reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(field.Uint(), 10), true
+	case reflect.Array:
+		if field.Type().Elem().Kind() != reflect.Uint8 {
+			return "", false
+		}
+		b := make([]byte, field.Len())
+		reflect.Copy(reflect.ValueOf(b), field)
+		return hex.EncodeBytes(b), true
+	default:
+		return "", false
+	}
+}
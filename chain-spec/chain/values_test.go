@@ -0,0 +1,70 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package chain_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/chain-spec/chain"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/stretchr/testify/require"
+)
+
+// TestValues tests that the spec values are keyed and formatted in the beacon
+// API format.
+func TestValues(t *testing.T) {
+	cs, err := chain.NewChainSpec(
+		chain.SpecData[
+			domainType, epoch, slot, cometBFTConfig,
+		]{
+			SlotsPerEpoch:              32,
+			MaxWithdrawalsPerPayload:   16,
+			MinSlashingPenaltyQuotient: 128,
+			InactivityPenaltyQuotient:  1 << 24,
+			ActivationChurnLimit:       4,
+			ExitChurnLimit:             4,
+			DomainTypeDeposit:          domainType{0x03, 0x00, 0x00, 0x00},
+			DepositContractAddress: common.NewExecutionAddressFromHex(
+				"0x4242424242424242424242424242424242424242",
+			),
+			DepositEth1ChainID:          80084,
+			ElectraForkEpoch:            10,
+			RewardsForkEpoch:            10,
+			WithdrawalRequestsForkEpoch: 10,
+		},
+	)
+	require.NoError(t, err)
+
+	values := cs.Values()
+	require.Equal(t, "32", values["SLOTS_PER_EPOCH"])
+	require.Equal(t, "16", values["MAX_WITHDRAWALS_PER_PAYLOAD"])
+	require.Equal(t, "0x03000000", values["DOMAIN_TYPE_DEPOSIT"])
+	require.Equal(t,
+		"0x4242424242424242424242424242424242424242",
+		values["DEPOSIT_CONTRACT_ADDRESS"],
+	)
+	require.Equal(t, "80084", values["DEPOSIT_ETH1_CHAIN_ID"])
+	require.Equal(t, "10", values["ELECTRA_FORK_EPOCH"])
+	require.Equal(t, "0", values["EJECTION_BALANCE"])
+
+	// Nested values are not part of the spec values.
+	require.NotContains(t, values, "COMET_BFT_CONFIG")
+}
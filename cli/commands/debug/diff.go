@@ -0,0 +1,284 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package debug
+
+import (
+	"encoding"
+	"fmt"
+	"math/bits"
+	"reflect"
+	"strconv"
+	"strings"
+
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/version"
+	fastssz "github.com/ferranbt/fastssz"
+)
+
+const (
+	// lengthPathPart is the path part that selects the length of a list.
+	lengthPathPart = "__len__"
+
+	// absentValue is reported for list items missing from one of the states.
+	absentValue = "<absent>"
+
+	// denebStateFields is the number of fields of the Deneb beacon state. The
+	// fields after them are only part of the Electra beacon state.
+	denebStateFields = 16
+)
+
+// FieldDiff is a field that differs between two beacon states.
+type FieldDiff struct {
+	// Path is the path of the field in the beacon state, using the spec field
+	// names, e.g. `validators/12/effective_balance`.
+	Path string
+	// A and B are the values of the field in each state. Containers and lists
+	// have no value, their differing fields and items are reported instead.
+	A, B string
+	// RootA and RootB are the roots of the subtree of the field in each state.
+	// They are zero for list items and lengths, which are not subtrees.
+	RootA, RootB common.Root
+}
+
+// treeNode is implemented by the SSZ containers of the beacon state.
+type treeNode interface {
+	GetTree() (*fastssz.Node, error)
+}
+
+// hashTreeRooter is implemented by the SSZ containers of the beacon state.
+type hashTreeRooter interface {
+	HashTreeRoot() common.Root
+}
+
+// DiffStates walks the SSZ trees of the two beacon states and returns every
+// field that differs between them. Subtrees with equal roots are skipped, so
+// only the path down to the differing fields is visited.
+func DiffStates(a, b *ctypes.BeaconState) ([]FieldDiff, error) {
+	electra := a.Version() >= version.Electra
+	if electra != (b.Version() >= version.Electra) {
+		return nil, errors.Wrapf(
+			ErrStateLayoutMismatch, "%s and %s",
+			version.Name(a.Version()), version.Name(b.Version()),
+		)
+	}
+
+	numFields := denebStateFields
+	if electra {
+		numFields = 0
+	}
+	var diffs []FieldDiff
+	err := diffContainer(&diffs, "", a, b, numFields)
+	return diffs, err
+}
+
+// diffContainer compares the fields of two SSZ containers by the roots of
+// their subtrees, descending into the fields whose roots differ. Only the
+// first numFields exported fields are compared, or all of them if it is 0.
+func diffContainer(
+	diffs *[]FieldDiff, path string, a, b treeNode, numFields int,
+) error {
+	treeA, err := a.GetTree()
+	if err != nil {
+		return err
+	}
+	treeB, err := b.GetTree()
+	if err != nil {
+		return err
+	}
+
+	va := reflect.ValueOf(a).Elem()
+	vb := reflect.ValueOf(b).Elem()
+	fields := sszFields(va.Type(), numFields)
+	// The fields are the leaves of the container tree, padded to a power of
+	// two, so field i is at generalized index width + i.
+	//#nosec:G701 // containers have a handful of fields.
+	width := 1 << bits.Len(uint(len(fields)-1))
+	for leaf, i := range fields {
+		rootA, rootB, rErr := nodeRoots(treeA, treeB, width+leaf)
+		if rErr != nil {
+			return rErr
+		}
+		if rootA == rootB {
+			continue
+		}
+
+		fieldPath := joinPath(path, fieldName(va.Type().Field(i)))
+		if err = diffField(
+			diffs, fieldPath, va.Field(i), vb.Field(i), rootA, rootB,
+		); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// diffField reports a field whose subtree roots differ, descending into
+// containers and lists.
+func diffField(
+	diffs *[]FieldDiff,
+	path string,
+	a, b reflect.Value,
+	rootA, rootB common.Root,
+) error {
+	d := FieldDiff{Path: path, RootA: rootA, RootB: rootB}
+	if isList(a) {
+		*diffs = append(*diffs, d)
+		return diffList(diffs, path, a, b)
+	}
+	if nodeA, ok := a.Interface().(treeNode); ok {
+		*diffs = append(*diffs, d)
+		//nolint:errcheck // same type as a.
+		return diffContainer(diffs, path, nodeA, b.Interface().(treeNode), 0)
+	}
+	d.A, d.B = formatValue(a), formatValue(b)
+	*diffs = append(*diffs, d)
+	return nil
+}
+
+// diffList reports the length and the items that differ between two lists.
+func diffList(diffs *[]FieldDiff, path string, a, b reflect.Value) error {
+	if a.Len() != b.Len() {
+		*diffs = append(*diffs, FieldDiff{
+			Path: joinPath(path, lengthPathPart),
+			A:    strconv.Itoa(a.Len()),
+			B:    strconv.Itoa(b.Len()),
+		})
+	}
+
+	for i := range max(a.Len(), b.Len()) {
+		itemPath := joinPath(path, strconv.Itoa(i))
+		switch {
+		case i >= a.Len():
+			*diffs = append(*diffs, FieldDiff{
+				Path: itemPath, A: absentValue, B: formatValue(b.Index(i)),
+			})
+		case i >= b.Len():
+			*diffs = append(*diffs, FieldDiff{
+				Path: itemPath, A: formatValue(a.Index(i)), B: absentValue,
+			})
+		default:
+			if err := diffItem(diffs, itemPath, a.Index(i), b.Index(i)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// diffItem reports a list item that differs between two lists, descending
+// into containers.
+func diffItem(diffs *[]FieldDiff, path string, a, b reflect.Value) error {
+	if rooterA, ok := a.Interface().(hashTreeRooter); ok {
+		//nolint:errcheck // same type as a.
+		rooterB := b.Interface().(hashTreeRooter)
+		rootA, rootB := rooterA.HashTreeRoot(), rooterB.HashTreeRoot()
+		if rootA == rootB {
+			return nil
+		}
+		return diffField(diffs, path, a, b, rootA, rootB)
+	}
+	if reflect.DeepEqual(a.Interface(), b.Interface()) {
+		return nil
+	}
+	*diffs = append(*diffs, FieldDiff{
+		Path: path, A: formatValue(a), B: formatValue(b),
+	})
+	return nil
+}
+
+// nodeRoots returns the roots of the nodes at the given generalized index of
+// both trees.
+func nodeRoots(
+	treeA, treeB *fastssz.Node, gIndex int,
+) (common.Root, common.Root, error) {
+	nodeA, err := treeA.Get(gIndex)
+	if err != nil {
+		return common.Root{}, common.Root{}, err
+	}
+	nodeB, err := treeB.Get(gIndex)
+	if err != nil {
+		return common.Root{}, common.Root{}, err
+	}
+	return common.NewRootFromBytes(nodeA.Hash()),
+		common.NewRootFromBytes(nodeB.Hash()), nil
+}
+
+// sszFields returns the indices of the exported fields of the struct type,
+// which are the fields of its SSZ container, keeping only the first numFields
+// of them unless it is 0.
+func sszFields(t reflect.Type, numFields int) []int {
+	fields := make([]int, 0, t.NumField())
+	for i := range t.NumField() {
+		if t.Field(i).IsExported() {
+			fields = append(fields, i)
+		}
+	}
+	if numFields > 0 && numFields < len(fields) {
+		fields = fields[:numFields]
+	}
+	return fields
+}
+
+// isList returns whether the value is an SSZ list. Byte slices are encoded as
+// a single value instead.
+func isList(v reflect.Value) bool {
+	return v.Kind() == reflect.Slice && v.Type().Elem().Kind() != reflect.Uint8
+}
+
+// fieldName returns the spec name of the struct field, as used by its JSON
+// encoding.
+func fieldName(f reflect.StructField) string {
+	if name, _, _ := strings.Cut(f.Tag.Get("json"), ","); name != "" {
+		return name
+	}
+	return f.Name
+}
+
+// formatValue formats a field value for display. Integers are formatted in
+// decimal, byte arrays as hex.
+func formatValue(v reflect.Value) string {
+	switch v.Kind() {
+	case reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(v.Uint(), 10)
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool())
+	default:
+	}
+	if m, ok := v.Interface().(encoding.TextMarshaler); ok {
+		if text, err := m.MarshalText(); err == nil {
+			return string(text)
+		}
+	}
+	if v.Kind() == reflect.Array || v.Kind() == reflect.Slice {
+		return fmt.Sprintf("%#x", v.Interface())
+	}
+	return fmt.Sprint(v.Interface())
+}
+
+// joinPath appends the part to the path.
+func joinPath(path, part string) string {
+	if path == "" {
+		return part
+	}
+	return path + "/" + part
+}
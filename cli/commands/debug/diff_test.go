@@ -0,0 +1,146 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package debug_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/cli/commands/debug"
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/version"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestState(
+	t *testing.T, forkVersion uint32, numValidators int,
+) *ctypes.BeaconState {
+	t.Helper()
+	vals := make(ctypes.Validators, numValidators)
+	balances := make([]uint64, numValidators)
+	for i := range vals {
+		vals[i] = &ctypes.Validator{
+			Pubkey:           [48]byte{byte(i)},
+			EffectiveBalance: 32e9,
+		}
+		balances[i] = 32e9
+	}
+	st, err := (&ctypes.BeaconState{}).New(
+		forkVersion,
+		common.Root{0x01},
+		10,
+		(&ctypes.Fork{}).Empty(),
+		(&ctypes.BeaconBlockHeader{}).Empty(),
+		[]common.Root{{0x02}, {0x03}},
+		[]common.Root{{0x04}},
+		(&ctypes.Eth1Data{}).Empty(),
+		0,
+		(&ctypes.ExecutionPayloadHeader{}).Empty(),
+		vals,
+		balances,
+		[]common.Bytes32{},
+		0,
+		0,
+		[]math.Gwei{},
+		0,
+	)
+	require.NoError(t, err)
+	return st
+}
+
+func TestDiffStatesIdentical(t *testing.T) {
+	diffs, err := debug.DiffStates(
+		newTestState(t, version.Deneb, 4), newTestState(t, version.Deneb, 4),
+	)
+	require.NoError(t, err)
+	require.Empty(t, diffs)
+}
+
+func TestDiffStates(t *testing.T) {
+	a := newTestState(t, version.Deneb, 4)
+	b := newTestState(t, version.Deneb, 5)
+	b.Slot = 11
+	b.NextWithdrawalIndex = 7
+	b.Validators[2].EffectiveBalance = 31e9
+	b.Balances[1] = 31e9
+	b.BlockRoots[1] = common.Root{0xff}
+	b.LatestExecutionPayloadHeader.Number = 100
+
+	diffs, err := debug.DiffStates(a, b)
+	require.NoError(t, err)
+
+	byPath := make(map[string]debug.FieldDiff, len(diffs))
+	for _, d := range diffs {
+		byPath[d.Path] = d
+	}
+	require.Len(t, byPath, len(diffs))
+
+	// Basic fields are reported with both values.
+	require.Equal(t, "10", byPath["slot"].A)
+	require.Equal(t, "11", byPath["slot"].B)
+	require.Equal(t, "7", byPath["next_withdrawal_index"].B)
+	require.Equal(
+		t, "31000000000", byPath["validators/2/effectiveBalance"].B,
+	)
+	require.Equal(t, "31000000000", byPath["balances/1"].B)
+	require.Equal(t, common.Root{0xff}.String(), byPath["block_roots/1"].B)
+	require.Equal(
+		t, "100", byPath["latest_execution_payload_header/blockNumber"].B,
+	)
+
+	// Lists report their length and the items missing from one side.
+	require.Equal(t, "4", byPath["validators/__len__"].A)
+	require.Equal(t, "5", byPath["validators/__len__"].B)
+	require.Contains(t, byPath, "validators/4")
+	require.Contains(t, byPath, "balances/4")
+
+	// Containers and lists are reported with their subtree roots.
+	header := byPath["latest_execution_payload_header"]
+	require.Equal(t, a.LatestExecutionPayloadHeader.HashTreeRoot(), header.RootA)
+	require.Equal(t, b.LatestExecutionPayloadHeader.HashTreeRoot(), header.RootB)
+	require.Equal(t, a.Validators[2].HashTreeRoot(), byPath["validators/2"].RootA)
+	require.NotEqual(t, byPath["validators"].RootA, byPath["validators"].RootB)
+
+	// Unchanged fields are not reported.
+	require.NotContains(t, byPath, "genesis_validators_root")
+	require.NotContains(t, byPath, "validators/0")
+	require.NotContains(t, byPath, "block_roots/0")
+	require.NotContains(t, byPath, "eth1_data")
+}
+
+func TestDiffStatesElectra(t *testing.T) {
+	a := newTestState(t, version.Electra, 2)
+	b := newTestState(t, version.Electra, 2)
+	a.InactivityScores = []uint64{0, 0}
+	b.InactivityScores = []uint64{0, 5}
+
+	diffs, err := debug.DiffStates(a, b)
+	require.NoError(t, err)
+	require.Len(t, diffs, 2)
+	require.Equal(t, "inactivity_scores", diffs[0].Path)
+	require.Equal(t, "inactivity_scores/1", diffs[1].Path)
+	require.Equal(t, "5", diffs[1].B)
+
+	// States of forks with different layouts are not compared.
+	_, err = debug.DiffStates(newTestState(t, version.Deneb, 2), b)
+	require.ErrorIs(t, err, debug.ErrStateLayoutMismatch)
+}
@@ -0,0 +1,105 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package debug
+
+import (
+	"github.com/berachain/beacon-kit/chain-spec/chain"
+	clicontext "github.com/berachain/beacon-kit/cli/context"
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/primitives/encoding/json"
+	"github.com/berachain/beacon-kit/storage/db"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+)
+
+// NewStateCmd creates a command that writes the beacon state committed at a
+// height to a file, reading it straight from the application database. The
+// node must be stopped, as the database cannot be opened twice.
+func NewStateCmd(chainSpec chain.ChainSpec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "state [output-file]",
+		Short: "Writes the beacon state at a height to a file",
+		Long: `Writes the beacon state committed at the given height to a file,
+without starting the node. The state is read from the application database
+in the home directory and encoded as JSON or SSZ. Useful to diff the state
+against another node's after an app hash mismatch.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg := clicontext.GetConfigFromCmd(cmd)
+			h, err := cmd.Flags().GetInt64(height)
+			if err != nil {
+				return err
+			}
+			f, err := cmd.Flags().GetString(format)
+			if err != nil {
+				return err
+			}
+
+			appDB, err := db.OpenDB(cfg.RootDir, dbm.PebbleDBBackend)
+			if err != nil {
+				return err
+			}
+			defer appDB.Close()
+
+			st, h, err := loadState(appDB, h, chainSpec)
+			if err != nil {
+				return err
+			}
+			bz, err := encodeState(st, f)
+			if err != nil {
+				return err
+			}
+			if err = afero.WriteFile(
+				afero.NewOsFs(), args[0], bz, 0o600,
+			); err != nil {
+				return errors.Wrap(err, "failed to write state")
+			}
+
+			cmd.Printf(
+				"Wrote beacon state at height %d (slot %d) to %s\n",
+				h, st.Slot, args[0],
+			)
+			return nil
+		},
+	}
+
+	cmd.Flags().Int64(
+		height, defaultHeight, "height of the state, 0 for the latest",
+	)
+	cmd.Flags().String(
+		format, defaultFormat, "encoding of the state, json or ssz",
+	)
+	return cmd
+}
+
+// encodeState encodes the beacon state in the given format.
+func encodeState(st *ctypes.BeaconState, f string) ([]byte, error) {
+	switch f {
+	case formatJSON:
+		return json.MarshalIndent(st, "", "  ")
+	case formatSSZ:
+		return st.MarshalSSZ()
+	default:
+		return nil, errors.Wrap(ErrInvalidFormat, f)
+	}
+}
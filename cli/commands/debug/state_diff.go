@@ -0,0 +1,129 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package debug
+
+import (
+	"github.com/berachain/beacon-kit/chain-spec/chain"
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/storage/db"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+)
+
+// NewStateDiffCmd creates a command that compares two beacon states field by
+// field, to investigate app hash mismatches between nodes.
+func NewStateDiffCmd(chainSpec chain.ChainSpec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "state-diff [home-dir|state.ssz] [home-dir|state.ssz]",
+		Short: "Compares two beacon states field by field",
+		Long: `Compares two beacon states field by field by walking their SSZ trees.
+Each state is read either from the application database of a node home
+directory at the given height, or from an SSZ dump written by the state
+command. Every differing field is reported with both values and the roots of
+its subtree in each state. The nodes must be stopped.`,
+		Args: cobra.ExactArgs(2), //nolint:mnd // two states.
+		RunE: func(cmd *cobra.Command, args []string) error {
+			h, err := cmd.Flags().GetInt64(height)
+			if err != nil {
+				return err
+			}
+
+			stA, err := readState(args[0], h, chainSpec)
+			if err != nil {
+				return errors.Wrapf(err, "failed to read state %s", args[0])
+			}
+			stB, err := readState(args[1], h, chainSpec)
+			if err != nil {
+				return errors.Wrapf(err, "failed to read state %s", args[1])
+			}
+
+			diffs, err := DiffStates(stA, stB)
+			if err != nil {
+				return err
+			}
+			printDiffs(cmd, stA, stB, diffs)
+			return nil
+		},
+	}
+
+	cmd.Flags().Int64(
+		height, defaultHeight,
+		"height of the states read from home directories, 0 for the latest",
+	)
+	return cmd
+}
+
+// readState reads the beacon state from the given SSZ dump, or from the
+// application database of the given home directory at the given height.
+func readState(
+	path string, h int64, chainSpec chain.ChainSpec,
+) (*ctypes.BeaconState, error) {
+	fs := afero.NewOsFs()
+	isDir, err := afero.IsDir(fs, path)
+	if err != nil {
+		return nil, err
+	}
+
+	if !isDir {
+		var bz []byte
+		if bz, err = afero.ReadFile(fs, path); err != nil {
+			return nil, err
+		}
+		st := &ctypes.BeaconState{}
+		return st, st.UnmarshalSSZ(bz)
+	}
+
+	appDB, err := db.OpenDB(path, dbm.PebbleDBBackend)
+	if err != nil {
+		return nil, err
+	}
+	defer appDB.Close()
+
+	st, _, err := loadState(appDB, h, chainSpec)
+	return st, err
+}
+
+// printDiffs prints the differing fields of the two states.
+func printDiffs(
+	cmd *cobra.Command, stA, stB *ctypes.BeaconState, diffs []FieldDiff,
+) {
+	cmd.Printf(
+		"a: slot %d, state root %s\nb: slot %d, state root %s\n",
+		stA.Slot, stA.HashTreeRoot(), stB.Slot, stB.HashTreeRoot(),
+	)
+	if len(diffs) == 0 {
+		cmd.Println("States are identical")
+		return
+	}
+
+	cmd.Printf("%d differing fields\n", len(diffs))
+	for _, d := range diffs {
+		cmd.Printf("\n%s\n", d.Path)
+		if d.A != "" || d.B != "" {
+			cmd.Printf("  a: %s\n  b: %s\n", d.A, d.B)
+		}
+		if !d.RootA.Equals(d.RootB) {
+			cmd.Printf("  root a: %s\n  root b: %s\n", d.RootA, d.RootB)
+		}
+	}
+}
@@ -0,0 +1,76 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package debug
+
+import (
+	sdklog "cosmossdk.io/log"
+	"cosmossdk.io/store"
+	storemetrics "cosmossdk.io/store/metrics"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/berachain/beacon-kit/chain-spec/chain"
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/node-core/components"
+	statedb "github.com/berachain/beacon-kit/state-transition/core/state"
+	dbm "github.com/cosmos/cosmos-db"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// loadState reads the beacon state committed at the given height from the
+// application database, mounting the beacon store the same way the node does.
+// A height of 0 reads the latest committed state. Returns the state along with
+// the height it was read at.
+func loadState(
+	db dbm.DB, height int64, chainSpec chain.ChainSpec,
+) (*ctypes.BeaconState, int64, error) {
+	storeKey := components.ProvideKVStoreKey()
+	cms := store.NewCommitMultiStore(
+		db, sdklog.NewNopLogger(), storemetrics.NewNoOpMetrics(),
+	)
+	cms.MountStoreWithDB(storeKey, storetypes.StoreTypeIAVL, nil)
+	if err := cms.LoadLatestVersion(); err != nil {
+		return nil, height, errors.Wrap(err, "failed to load latest version")
+	}
+
+	latest := cms.LatestVersion()
+	if latest == 0 {
+		return nil, height, ErrNoCommittedState
+	}
+	if height == 0 {
+		height = latest
+	}
+	cacheMS, err := cms.CacheMultiStoreWithVersion(height)
+	if err != nil {
+		return nil, height, errors.Wrapf(
+			err, "failed to load state at height %d (latest height: %d)",
+			height, latest,
+		)
+	}
+
+	ctx := sdk.NewContext(cacheMS, true, sdklog.NewNopLogger())
+	kvStore := components.ProvideKVStore(components.KVStoreInput{
+		KVStoreService: components.NewKVStoreService(storeKey),
+	})
+	var st *statedb.StateDB
+	bs, err := st.NewFromDB(kvStore.WithContext(ctx), chainSpec).
+		GetMarshallable()
+	return bs, height, err
+}
@@ -0,0 +1,60 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package debug
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/config/spec"
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadStateNoCommittedState(t *testing.T) {
+	chainSpec, err := spec.DevnetChainSpec()
+	require.NoError(t, err)
+
+	_, _, err = loadState(dbm.NewMemDB(), 0, chainSpec)
+	require.ErrorIs(t, err, ErrNoCommittedState)
+}
+
+func TestEncodeState(t *testing.T) {
+	st := &ctypes.BeaconState{
+		Fork:                         &ctypes.Fork{},
+		LatestBlockHeader:            &ctypes.BeaconBlockHeader{},
+		Eth1Data:                     &ctypes.Eth1Data{},
+		LatestExecutionPayloadHeader: &ctypes.ExecutionPayloadHeader{},
+	}
+
+	expected, err := st.MarshalSSZ()
+	require.NoError(t, err)
+	bz, err := encodeState(st, formatSSZ)
+	require.NoError(t, err)
+	require.Equal(t, expected, bz)
+
+	bz, err = encodeState(st, formatJSON)
+	require.NoError(t, err)
+	require.Contains(t, string(bz), `"genesis_validators_root"`)
+
+	_, err = encodeState(st, "yaml")
+	require.ErrorIs(t, err, ErrInvalidFormat)
+}
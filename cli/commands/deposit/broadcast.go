@@ -0,0 +1,225 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package deposit
+
+import (
+	"bytes"
+	"context"
+	"math/big"
+	"strings"
+
+	"cosmossdk.io/log"
+	"github.com/berachain/beacon-kit/chain-spec/chain"
+	"github.com/berachain/beacon-kit/cli/utils/parser"
+	"github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/errors"
+	gethprimitives "github.com/berachain/beacon-kit/geth-primitives"
+	"github.com/berachain/beacon-kit/geth-primitives/bind"
+	gethcrypto "github.com/berachain/beacon-kit/geth-primitives/crypto"
+	"github.com/berachain/beacon-kit/geth-primitives/deposit"
+	"github.com/berachain/beacon-kit/geth-primitives/ethclient"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/spf13/cobra"
+)
+
+// receiptStatusSuccessful is the status of a receipt of a transaction that
+// did not revert.
+const receiptStatusSuccessful = 1
+
+// broadcastDepositTx signs the deposit transaction with the private key flag,
+// sends it to the deposit contract through the execution layer RPC and waits
+// for its receipt. It then checks that the contract emitted the Deposit event
+// for the expected pubkey and amount.
+func broadcastDepositTx(
+	cmd *cobra.Command,
+	chainSpec chain.ChainSpec,
+	logger log.Logger,
+	depositMsg *types.DepositMessage,
+	signature crypto.BLSSignature,
+) error {
+	txOpts, operatorAddr, err := getTransactOpts(cmd, chainSpec)
+	if err != nil {
+		return err
+	}
+	rpcURL, err := cmd.Flags().GetString(elRPCURL)
+	if err != nil {
+		return err
+	}
+	timeout, err := cmd.Flags().GetDuration(receiptTimeout)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(cmd.Context(), timeout)
+	defer cancel()
+
+	client, err := ethclient.DialContext(ctx, rpcURL)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	return sendDepositTx(
+		ctx, client, chainSpec, logger,
+		txOpts, operatorAddr, depositMsg, signature,
+	)
+}
+
+// depositBackend is the execution layer client the deposit transaction is
+// sent through.
+type depositBackend interface {
+	bind.ContractBackend
+	bind.DeployBackend
+	// ChainID returns the chain ID of the execution layer.
+	ChainID(ctx context.Context) (*big.Int, error)
+}
+
+// sendDepositTx sends the deposit transaction to the deposit contract and
+// waits for its receipt, which must hold the Deposit event of the expected
+// pubkey and amount.
+func sendDepositTx(
+	ctx context.Context,
+	client depositBackend,
+	chainSpec chain.ChainSpec,
+	logger log.Logger,
+	txOpts *bind.TransactOpts,
+	operatorAddr gethprimitives.ExecutionAddress,
+	depositMsg *types.DepositMessage,
+	signature crypto.BLSSignature,
+) error {
+	// Make sure we do not send the deposit to another network.
+	chainID, err := client.ChainID(ctx)
+	if err != nil {
+		return err
+	}
+	if !chainID.IsUint64() ||
+		chainID.Uint64() != chainSpec.DepositEth1ChainID() {
+		return errors.Wrapf(
+			ErrChainIDMismatch, "expected %d, got %s",
+			chainSpec.DepositEth1ChainID(), chainID,
+		)
+	}
+
+	contractAddr := gethprimitives.ExecutionAddress(
+		chainSpec.DepositContractAddress(),
+	)
+	depositContract, err := deposit.NewDepositContract(contractAddr, client)
+	if err != nil {
+		return err
+	}
+
+	txOpts.Context = ctx
+	txOpts.Value = depositMsg.Amount.ToWei().ToBig()
+	tx, err := depositContract.Deposit(
+		txOpts,
+		depositMsg.Pubkey[:],
+		depositMsg.Credentials[:],
+		signature[:],
+		operatorAddr,
+	)
+	if err != nil {
+		return err
+	}
+	logger.Info(
+		"Deposit transaction sent, waiting for receipt",
+		"tx_hash", tx.Hash().Hex(),
+	)
+
+	receipt, err := bind.WaitMined(ctx, client, tx)
+	if err != nil {
+		return err
+	}
+	if receipt == nil {
+		return ErrDepositReceiptEmpty
+	}
+	if receipt.Status != receiptStatusSuccessful {
+		return errors.Wrapf(
+			ErrDepositTransactionFailed, "tx hash %s", tx.Hash().Hex(),
+		)
+	}
+
+	// Look for the event of our deposit among the receipt logs.
+	for _, l := range receipt.Logs {
+		if l == nil || l.Address != contractAddr {
+			continue
+		}
+		event, errParse := depositContract.ParseDeposit(*l)
+		if errParse != nil {
+			// Other events of the contract, e.g. OperatorUpdated.
+			continue
+		}
+		if bytes.Equal(event.Pubkey, depositMsg.Pubkey[:]) &&
+			event.Amount == depositMsg.Amount.Unwrap() {
+			logger.Info(
+				"Deposit included",
+				"tx_hash", tx.Hash().Hex(),
+				"block", receipt.BlockNumber,
+				"deposit_index", event.Index,
+			)
+			return nil
+		}
+	}
+	return errors.Wrapf(
+		ErrDepositEventNotFound, "tx hash %s", tx.Hash().Hex(),
+	)
+}
+
+// getTransactOpts returns the options to sign the deposit transaction with
+// the private key flag, along with the operator of the validator.
+func getTransactOpts(
+	cmd *cobra.Command,
+	chainSpec chain.ChainSpec,
+) (*bind.TransactOpts, gethprimitives.ExecutionAddress, error) {
+	var operatorAddr gethprimitives.ExecutionAddress
+	privKeyHex, err := cmd.Flags().GetString(privateKey)
+	if err != nil {
+		return nil, operatorAddr, err
+	}
+	privKeyHex = strings.TrimPrefix(privKeyHex, "0x")
+	if privKeyHex == "" {
+		return nil, operatorAddr, ErrPrivateKeyRequired
+	}
+	privKey, err := gethcrypto.HexToECDSA(privKeyHex)
+	if err != nil {
+		return nil, operatorAddr, err
+	}
+
+	txOpts, err := bind.NewKeyedTransactorWithChainID(
+		privKey, new(big.Int).SetUint64(chainSpec.DepositEth1ChainID()),
+	)
+	if err != nil {
+		return nil, operatorAddr, err
+	}
+
+	// The operator defaults to the depositor.
+	operatorHex, err := cmd.Flags().GetString(operator)
+	if err != nil {
+		return nil, operatorAddr, err
+	}
+	if operatorHex == "" {
+		return txOpts, txOpts.From, nil
+	}
+	addr, err := parser.ConvertWithdrawalAddress(operatorHex)
+	if err != nil {
+		return nil, operatorAddr, err
+	}
+	return txOpts, gethprimitives.ExecutionAddress(addr), nil
+}
@@ -0,0 +1,323 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package deposit
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"cosmossdk.io/log"
+	"github.com/berachain/beacon-kit/chain-spec/chain"
+	"github.com/berachain/beacon-kit/config/spec"
+	"github.com/berachain/beacon-kit/consensus-types/types"
+	gethprimitives "github.com/berachain/beacon-kit/geth-primitives"
+	"github.com/berachain/beacon-kit/geth-primitives/bind"
+	gethcrypto "github.com/berachain/beacon-kit/geth-primitives/crypto"
+	"github.com/berachain/beacon-kit/geth-primitives/deposit"
+	bkcommon "github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+	"github.com/stretchr/testify/require"
+)
+
+const testPrivateKey = "fffdbb37105441e14b0ee6330d855d8504ff39e705c3afa8f859ac9865f99306"
+
+// fakeEL is an in-memory execution layer which mines every transaction sent
+// to it right away, into the receipt built by mine.
+type fakeEL struct {
+	chainID  *big.Int
+	mine     func(tx *ethtypes.Transaction) *ethtypes.Receipt
+	sent     []*ethtypes.Transaction
+	receipts map[common.Hash]*ethtypes.Receipt
+}
+
+func newFakeEL(
+	chainID uint64,
+	mine func(tx *ethtypes.Transaction) *ethtypes.Receipt,
+) *fakeEL {
+	return &fakeEL{
+		chainID:  new(big.Int).SetUint64(chainID),
+		mine:     mine,
+		receipts: make(map[common.Hash]*ethtypes.Receipt),
+	}
+}
+
+func (el *fakeEL) ChainID(context.Context) (*big.Int, error) {
+	return el.chainID, nil
+}
+
+func (*fakeEL) CodeAt(
+	context.Context, common.Address, *big.Int,
+) ([]byte, error) {
+	return []byte{0x60}, nil
+}
+
+func (*fakeEL) PendingCodeAt(context.Context, common.Address) ([]byte, error) {
+	return []byte{0x60}, nil
+}
+
+func (*fakeEL) CallContract(
+	context.Context, ethereum.CallMsg, *big.Int,
+) ([]byte, error) {
+	return nil, nil
+}
+
+func (el *fakeEL) HeaderByNumber(
+	context.Context, *big.Int,
+) (*ethtypes.Header, error) {
+	return &ethtypes.Header{
+		Number:  big.NewInt(int64(len(el.sent))),
+		BaseFee: big.NewInt(1),
+	}, nil
+}
+
+func (el *fakeEL) PendingNonceAt(
+	context.Context, common.Address,
+) (uint64, error) {
+	return uint64(len(el.sent)), nil
+}
+
+func (*fakeEL) SuggestGasPrice(context.Context) (*big.Int, error) {
+	return big.NewInt(1), nil
+}
+
+func (*fakeEL) SuggestGasTipCap(context.Context) (*big.Int, error) {
+	return big.NewInt(1), nil
+}
+
+func (*fakeEL) EstimateGas(context.Context, ethereum.CallMsg) (uint64, error) {
+	return 100_000, nil
+}
+
+func (el *fakeEL) SendTransaction(
+	_ context.Context, tx *ethtypes.Transaction,
+) error {
+	el.sent = append(el.sent, tx)
+	receipt := el.mine(tx)
+	receipt.TxHash = tx.Hash()
+	receipt.BlockNumber = big.NewInt(int64(len(el.sent)))
+	el.receipts[tx.Hash()] = receipt
+	return nil
+}
+
+func (el *fakeEL) TransactionReceipt(
+	_ context.Context, txHash common.Hash,
+) (*ethtypes.Receipt, error) {
+	receipt, ok := el.receipts[txHash]
+	if !ok {
+		return nil, ethereum.NotFound
+	}
+	return receipt, nil
+}
+
+func (*fakeEL) FilterLogs(
+	context.Context, ethereum.FilterQuery,
+) ([]ethtypes.Log, error) {
+	return nil, nil
+}
+
+func (*fakeEL) SubscribeFilterLogs(
+	context.Context, ethereum.FilterQuery, chan<- ethtypes.Log,
+) (ethereum.Subscription, error) {
+	return event.NewSubscription(
+		func(<-chan struct{}) error { return nil },
+	), nil
+}
+
+// depositLog returns the Deposit event of the deposit contract for the given
+// pubkey and amount.
+func depositLog(
+	t *testing.T,
+	contract common.Address,
+	pubkey crypto.BLSPubkey,
+	amount math.Gwei,
+) *ethtypes.Log {
+	t.Helper()
+	contractABI, err := deposit.DepositContractMetaData.GetAbi()
+	require.NoError(t, err)
+	ev := contractABI.Events["Deposit"]
+	data, err := ev.Inputs.Pack(
+		pubkey[:], make([]byte, 32), amount.Unwrap(), make([]byte, 96),
+		uint64(7),
+	)
+	require.NoError(t, err)
+	return &ethtypes.Log{
+		Address: contract,
+		Topics:  []common.Hash{ev.ID},
+		Data:    data,
+	}
+}
+
+func TestSendDepositTx(t *testing.T) {
+	cs, err := spec.DevnetChainSpec()
+	require.NoError(t, err)
+	var (
+		contract   = common.Address(cs.DepositContractAddress())
+		depositMsg = &types.DepositMessage{
+			Pubkey: crypto.BLSPubkey{0x01},
+			Credentials: types.NewCredentialsFromExecutionAddress(
+				bkcommon.ExecutionAddress{0x02},
+			),
+			Amount: 32e9,
+		}
+		signature = crypto.BLSSignature{0x03}
+		operator  = gethprimitives.ExecutionAddress{0x04}
+	)
+
+	receiptWith := func(
+		status uint64, logs ...*ethtypes.Log,
+	) func(*ethtypes.Transaction) *ethtypes.Receipt {
+		return func(*ethtypes.Transaction) *ethtypes.Receipt {
+			return &ethtypes.Receipt{Status: status, Logs: logs}
+		}
+	}
+	tests := []struct {
+		name        string
+		chainID     uint64
+		mine        func(*ethtypes.Transaction) *ethtypes.Receipt
+		expectedErr error
+	}{
+		{
+			name:    "deposit included",
+			chainID: cs.DepositEth1ChainID(),
+			mine: receiptWith(
+				ethtypes.ReceiptStatusSuccessful,
+				depositLog(t, contract, depositMsg.Pubkey, depositMsg.Amount),
+			),
+		},
+		{
+			name:        "chain ID mismatch",
+			chainID:     cs.DepositEth1ChainID() + 1,
+			expectedErr: ErrChainIDMismatch,
+		},
+		{
+			name:        "transaction reverted",
+			chainID:     cs.DepositEth1ChainID(),
+			mine:        receiptWith(ethtypes.ReceiptStatusFailed),
+			expectedErr: ErrDepositTransactionFailed,
+		},
+		{
+			name:        "deposit event missing",
+			chainID:     cs.DepositEth1ChainID(),
+			mine:        receiptWith(ethtypes.ReceiptStatusSuccessful),
+			expectedErr: ErrDepositEventNotFound,
+		},
+		{
+			name:    "deposit event of another pubkey",
+			chainID: cs.DepositEth1ChainID(),
+			mine: receiptWith(
+				ethtypes.ReceiptStatusSuccessful,
+				depositLog(
+					t, contract, crypto.BLSPubkey{0x05}, depositMsg.Amount,
+				),
+			),
+			expectedErr: ErrDepositEventNotFound,
+		},
+		{
+			name:    "deposit event of another amount",
+			chainID: cs.DepositEth1ChainID(),
+			mine: receiptWith(
+				ethtypes.ReceiptStatusSuccessful,
+				depositLog(t, contract, depositMsg.Pubkey, 1e9),
+			),
+			expectedErr: ErrDepositEventNotFound,
+		},
+		{
+			name:    "deposit event of another contract",
+			chainID: cs.DepositEth1ChainID(),
+			mine: receiptWith(
+				ethtypes.ReceiptStatusSuccessful,
+				depositLog(
+					t, common.Address{0x06},
+					depositMsg.Pubkey, depositMsg.Amount,
+				),
+			),
+			expectedErr: ErrDepositEventNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			el := newFakeEL(tt.chainID, tt.mine)
+			txOpts := testTransactOpts(t, cs)
+
+			err := sendDepositTx(
+				context.Background(), el, cs, log.NewNopLogger(),
+				txOpts, operator, depositMsg, signature,
+			)
+			if tt.expectedErr != nil {
+				require.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				require.NoError(t, err)
+			}
+			if tt.mine == nil {
+				// Nothing is sent to another network.
+				require.Empty(t, el.sent)
+				return
+			}
+
+			// The deposit is sent to the deposit contract with its amount.
+			require.Len(t, el.sent, 1)
+			tx := el.sent[0]
+			require.Equal(t, contract, *tx.To())
+			require.Equal(t, depositMsg.Amount.ToWei().ToBig(), tx.Value())
+			require.Equal(
+				t, new(big.Int).SetUint64(cs.DepositEth1ChainID()), tx.ChainId(),
+			)
+			sender, err := ethtypes.Sender(
+				ethtypes.LatestSignerForChainID(tx.ChainId()), tx,
+			)
+			require.NoError(t, err)
+			require.Equal(t, txOpts.From, sender)
+
+			contractABI, err := deposit.DepositContractMetaData.GetAbi()
+			require.NoError(t, err)
+			method, err := contractABI.MethodById(tx.Data()[:4])
+			require.NoError(t, err)
+			require.Equal(t, "deposit", method.Name)
+			args, err := method.Inputs.Unpack(tx.Data()[4:])
+			require.NoError(t, err)
+			require.Equal(t, []any{
+				depositMsg.Pubkey[:],
+				depositMsg.Credentials[:],
+				signature[:],
+				common.Address(operator),
+			}, args)
+		})
+	}
+}
+
+// testTransactOpts returns the options signing transactions with the test
+// private key for the chain of the given spec.
+func testTransactOpts(t *testing.T, cs chain.ChainSpec) *bind.TransactOpts {
+	t.Helper()
+	privKey, err := gethcrypto.HexToECDSA(testPrivateKey)
+	require.NoError(t, err)
+	txOpts, err := bind.NewKeyedTransactorWithChainID(
+		privKey, new(big.Int).SetUint64(cs.DepositEth1ChainID()),
+	)
+	require.NoError(t, err)
+	return txOpts
+}
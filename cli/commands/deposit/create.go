@@ -25,6 +25,7 @@ import (
 
 	"cosmossdk.io/log"
 	"github.com/berachain/beacon-kit/chain-spec/chain"
+	"github.com/berachain/beacon-kit/cli/commands/keys"
 	clicontext "github.com/berachain/beacon-kit/cli/context"
 	"github.com/berachain/beacon-kit/cli/utils/parser"
 	"github.com/berachain/beacon-kit/consensus-types/types"
@@ -49,13 +50,27 @@ func NewCreateValidator(
 		RunE: createValidatorCmd(chainSpec),
 	}
 
+	cmd.Flags().BoolP(
+		broadcastDeposit, broadcastDepositShorthand,
+		defaultBroadcastDeposit, broadcastDepositMsg,
+	)
 	cmd.Flags().String(privateKey, defaultPrivateKey, privateKeyMsg)
+	cmd.Flags().String(elRPCURL, defaultELRPCURL, elRPCURLMsg)
+	cmd.Flags().String(operator, defaultOperator, operatorMsg)
+	cmd.Flags().Duration(
+		receiptTimeout, defaultReceiptTimeout, receiptTimeoutMsg,
+	)
 	cmd.Flags().BoolP(
 		overrideNodeKey, overrideNodeKeyShorthand,
 		defaultOverrideNodeKey, overrideNodeKeyMsg,
 	)
 	cmd.Flags().
 		String(valPrivateKey, defaultValidatorPrivateKey, valPrivateKeyMsg)
+	cmd.Flags().String(keystoreFile, defaultKeystoreFile, keystoreFileMsg)
+	cmd.Flags().String(
+		keystorePasswordFile, defaultKeystorePasswordFile,
+		keystorePasswordFileMsg,
+	)
 
 	return cmd
 }
@@ -130,11 +145,18 @@ func createValidatorCmd(
 			"signature", signature.String(),
 		)
 
-		return nil
+		broadcast, err := cmd.Flags().GetBool(broadcastDeposit)
+		if err != nil || !broadcast {
+			return err
+		}
+		return broadcastDepositTx(
+			cmd, chainSpec, logger, depositMsg, signature,
+		)
 	}
 }
 
-// getBLSSigner returns a BLS signer based on the override commands key flag.
+// getBLSSigner returns a BLS signer based on the override commands key flag
+// or the keystore flag, defaulting to the node key.
 func getBLSSigner(
 	cmd *cobra.Command,
 ) (crypto.BLSSigner, error) {
@@ -158,6 +180,11 @@ func getBLSSigner(
 		if err != nil {
 			return nil, err
 		}
+	} else {
+		legacyKey, err = loadKeystoreKey(cmd)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	return components.ProvideBlsSigner(
@@ -167,3 +194,16 @@ func getBLSSigner(
 		},
 	)
 }
+
+// loadKeystoreKey decrypts the key of the keystore flag, if set.
+func loadKeystoreKey(cmd *cobra.Command) (components.LegacyKey, error) {
+	file, err := cmd.Flags().GetString(keystoreFile)
+	if err != nil || file == "" {
+		return components.LegacyKey{}, err
+	}
+	password, err := keys.ReadPassword(cmd, keystorePasswordFile, false)
+	if err != nil {
+		return components.LegacyKey{}, err
+	}
+	return signer.LoadKeystoreKey(file, password)
+}
@@ -42,4 +42,19 @@ var (
 	// ErrPrivateKeyEmpty is returned when the private key is empty.
 	ErrPrivateKeyEmpty = errors.New(
 		"private key is empty")
+
+	// ErrChainIDMismatch is returned when the chain ID of the execution
+	// layer differs from the one of the chain spec.
+	ErrChainIDMismatch = errors.New(
+		"execution layer chain ID does not match chain spec")
+
+	// ErrDepositTransactionFailed is returned when the deposit transaction
+	// is mined but reverted.
+	ErrDepositTransactionFailed = errors.New(
+		"deposit transaction failed")
+
+	// ErrDepositEventNotFound is returned when the deposit transaction
+	// receipt does not hold the expected deposit event.
+	ErrDepositEventNotFound = errors.New(
+		"deposit event not found in receipt")
 )
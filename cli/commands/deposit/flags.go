@@ -20,36 +20,87 @@
 
 package deposit
 
+import "time"
+
 const (
+	// broadcastDeposit is the flag for broadcasting the deposit transaction.
+	broadcastDeposit = "broadcast"
+
 	// privateKey is the flag for the private key to sign the deposit message.
 	privateKey = "private-key"
 
+	// elRPCURL is the flag for the execution layer RPC URL the deposit
+	// transaction is broadcast to.
+	elRPCURL = "el-rpc-url"
+
+	// operator is the flag for the operator of the validator.
+	operator = "operator"
+
+	// receiptTimeout is the flag for how long to wait for the deposit
+	// transaction receipt.
+	receiptTimeout = "receipt-timeout"
+
 	// overrideNodeKey is the flag for overriding the node key.
 	overrideNodeKey = "override-node-key"
 
 	// validatorPrivateKey is the flag for the validator private key.
 	valPrivateKey = "validator-private-key"
+
+	// keystoreFile is the flag for the keystore of the validator key.
+	keystoreFile = "keystore"
+
+	// keystorePasswordFile is the flag for the file holding the password of
+	// the keystore.
+	keystorePasswordFile = "keystore-password-file"
 )
 
 const (
+	// broadcastDepositShorthand is the shorthand flag for the
+	// broadcastDeposit flag.
+	broadcastDepositShorthand = "b"
+
 	// overrideNodeKeyShorthand is the shorthand flag for the overrideNodeKey
 	// flag.
 	overrideNodeKeyShorthand = "o"
 )
 
 const (
+	// defaultBroadcastDeposit is the default value for the broadcastDeposit
+	// flag.
+	defaultBroadcastDeposit = false
+
 	// defaultPrivateKey is the default value for the privateKey flag.
 	defaultPrivateKey = ""
 
+	// defaultELRPCURL is the default value for the elRPCURL flag.
+	defaultELRPCURL = "http://localhost:8545"
+
+	// defaultOperator is the default value for the operator flag.
+	defaultOperator = ""
+
+	// defaultReceiptTimeout is the default value for the receiptTimeout flag.
+	defaultReceiptTimeout = 2 * time.Minute
+
 	// defaultOverrideNodeKey is the default value for the overrideNodeKey flag.
 	defaultOverrideNodeKey = false
 
 	// defaultValidatorPrivateKey is the default value for the
 	// validatorPrivateKey flag.
 	defaultValidatorPrivateKey = ""
+
+	// defaultKeystoreFile is the default value for the keystoreFile flag.
+	defaultKeystoreFile = ""
+
+	// defaultKeystorePasswordFile is the default value for the
+	// keystorePasswordFile flag.
+	defaultKeystorePasswordFile = ""
 )
 
 const (
+	// broadcastDepositMsg is the usage description for the broadcastDeposit
+	// flag.
+	broadcastDepositMsg = "broadcast the deposit transaction"
+
 	// privateKeyFlagMsg is the usage description for the privateKey flag.
 	privateKeyMsg = `private key to sign and pay for the deposit message. 
 	This is required if the broadcast flag is set.`
@@ -58,8 +109,27 @@ const (
 	// flag.
 	overrideNodeKeyMsg = "override the node private key"
 
+	// elRPCURLMsg is the usage description for the elRPCURL flag.
+	elRPCURLMsg = "execution layer RPC URL to broadcast the deposit to"
+
+	// operatorMsg is the usage description for the operator flag.
+	operatorMsg = `operator address of the validator, set on its first deposit.
+	Defaults to the address of the private key.`
+
+	// receiptTimeoutMsg is the usage description for the receiptTimeout flag.
+	receiptTimeoutMsg = "how long to wait for the deposit transaction receipt"
+
 	// valPrivateKeyMsg is the usage description for the
 	// valPrivateKey flag.
 	valPrivateKeyMsg = `validator private key. This is required if the 
 	override-node-key flag is set.`
+
+	// keystoreFileMsg is the usage description for the keystoreFile flag.
+	keystoreFileMsg = `EIP-2335 keystore of the validator key, used instead of
+	the node key.`
+
+	// keystorePasswordFileMsg is the usage description for the
+	// keystorePasswordFile flag.
+	keystorePasswordFileMsg = `file holding the password of the keystore. The
+	password is prompted for if not set.`
 )
@@ -58,7 +58,7 @@ func AddGenesisDepositCmd(cs chain.ChainSpec) *cobra.Command {
 		RunE: func(cmd *cobra.Command, args []string) error {
 			config := context.GetConfigFromCmd(cmd)
 
-			_, valPubKey, err := genutil.InitializeNodeValidatorFiles(
+			_, _, err := genutil.InitializeNodeValidatorFiles(
 				config, crypto.CometBLSType,
 			)
 			if err != nil {
@@ -125,7 +125,7 @@ func AddGenesisDepositCmd(cs chain.ChainSpec) *cobra.Command {
 			outputDocument, _ := cmd.Flags().GetString(flags.FlagOutputDocument)
 			if outputDocument == "" {
 				outputDocument, err = makeOutputFilepath(config.RootDir,
-					crypto.BLSPubkey(valPubKey.Bytes()).String())
+					depositMsg.Pubkey.String())
 				if err != nil {
 					return errors.Wrap(err, "failed to create output file path")
 				}
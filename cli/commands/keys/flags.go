@@ -0,0 +1,54 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keys
+
+const (
+	// FlagKeystoreDir is the flag for the directory of the keystores.
+	FlagKeystoreDir = "keystore-dir"
+
+	// FlagPasswordFile is the flag for the file holding the keystore
+	// password.
+	FlagPasswordFile = "password-file"
+
+	// flagHex is the flag for importing a hex-encoded key read from the
+	// standard input.
+	flagHex = "hex"
+)
+
+const (
+	// defaultKeystoreDir is the default directory of the keystores, relative
+	// to the home directory.
+	defaultKeystoreDir = "config/keystores"
+)
+
+const (
+	// keystoreDirMsg is the usage description for the keystoreDir flag.
+	keystoreDirMsg = `directory of the keystores. Defaults to config/keystores
+	in the home directory.`
+
+	// passwordFileMsg is the usage description for the passwordFile flag.
+	passwordFileMsg = `file holding the keystore password. The password is
+	prompted for if not set.`
+
+	// hexMsg is the usage description for the hex flag.
+	hexMsg = `import a hex-encoded private key read from the standard input,
+	instead of the priv_validator_key_file.`
+)
@@ -0,0 +1,297 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keys
+
+import (
+	"bufio"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	clicontext "github.com/berachain/beacon-kit/cli/context"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/node-core/components/signer"
+	"github.com/berachain/beacon-kit/primitives/crypto/keystore"
+	"github.com/cometbft/cometbft/crypto/bls12381"
+	cmtjson "github.com/cometbft/cometbft/libs/json"
+	"github.com/cometbft/cometbft/privval"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/input"
+	"github.com/spf13/cobra"
+)
+
+// Commands creates a new command for managing the EIP-2335 keystores of
+// validator keys.
+func Commands() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "keys",
+		Short:                      "Validator keystore subcommands",
+		DisableFlagParsing:         false,
+		SuggestionsMinimumDistance: 2, //nolint:mnd // from sdk.
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.PersistentFlags().String(FlagKeystoreDir, "", keystoreDirMsg)
+	cmd.AddCommand(
+		NewGenerateCommand(),
+		NewImportCommand(),
+		NewListCommand(),
+		NewExportCommand(),
+	)
+
+	return cmd
+}
+
+// NewGenerateCommand creates a new command for generating a validator key.
+func NewGenerateCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "generate",
+		Short: "Generates a new validator key in an encrypted keystore",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			pk, err := bls12381.GenPrivKey()
+			if err != nil {
+				return err
+			}
+			return saveKeystore(cmd, signer.LegacyKey(pk.Bytes()))
+		},
+	}
+	cmd.Flags().String(FlagPasswordFile, "", passwordFileMsg)
+	return cmd
+}
+
+// NewImportCommand creates a new command for importing a validator key into
+// an encrypted keystore.
+func NewImportCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import [key-file]",
+		Short: "Imports a validator key into an encrypted keystore",
+		Long: `Imports a validator key into an encrypted keystore. The key is read
+from the given CometBFT key file, which defaults to the priv_validator_key_file
+of the node, or as hex from the standard input if the hex flag is set. The
+plain-text key file should be deleted once imported.`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			key, err := readKeyToImport(cmd, args)
+			if err != nil {
+				return err
+			}
+			return saveKeystore(cmd, key)
+		},
+	}
+	cmd.Flags().String(FlagPasswordFile, "", passwordFileMsg)
+	cmd.Flags().Bool(flagHex, false, hexMsg)
+	return cmd
+}
+
+// NewListCommand creates a new command for listing the keystores.
+func NewListCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "Lists the public keys of the keystores",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			dir, err := keystoreDir(cmd)
+			if err != nil {
+				return err
+			}
+			files, err := filepath.Glob(filepath.Join(dir, "*.json"))
+			if err != nil {
+				return err
+			}
+			for _, file := range files {
+				ks, loadErr := keystore.Load(file)
+				if loadErr != nil {
+					cmd.PrintErrln(loadErr)
+					continue
+				}
+				cmd.Printf("0x%s\t%s\n", ks.Pubkey, file)
+			}
+			return nil
+		},
+	}
+}
+
+// NewExportCommand creates a new command for exporting a validator key out of
+// its keystore.
+func NewExportCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export <pubkey|keystore-file>",
+		Short: "Exports the hex-encoded validator key of a keystore",
+		Long: `Decrypts the keystore of the given public key, or the given
+keystore file, and prints the validator key as hex. The key is printed in
+plain text: handle it with care.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			file, err := keystoreFile(cmd, args[0])
+			if err != nil {
+				return err
+			}
+			password, err := ReadPassword(cmd, FlagPasswordFile, false)
+			if err != nil {
+				return err
+			}
+			key, err := signer.LoadKeystoreKey(file, password)
+			if err != nil {
+				return err
+			}
+			cmd.Println(hex.EncodeToString(key[:]))
+			return nil
+		},
+	}
+	cmd.Flags().String(FlagPasswordFile, "", passwordFileMsg)
+	return cmd
+}
+
+// ReadPassword reads the keystore password from the file of the given flag
+// if set, or prompts for it otherwise. A new password is prompted for twice.
+func ReadPassword(
+	cmd *cobra.Command,
+	passwordFileFlag string,
+	isNew bool,
+) (string, error) {
+	passwordFile, err := cmd.Flags().GetString(passwordFileFlag)
+	if err != nil {
+		return "", err
+	}
+	if passwordFile != "" {
+		return keystore.ReadPasswordFile(passwordFile)
+	}
+
+	buf := bufio.NewReader(cmd.InOrStdin())
+	if !isNew {
+		return input.GetSecretString("Enter keystore password: ", buf)
+	}
+	password, err := input.GetPassword("Enter new keystore password: ", buf)
+	if err != nil {
+		return "", err
+	}
+	confirmation, err := input.GetPassword("Repeat password: ", buf)
+	if err != nil {
+		return "", err
+	}
+	if password != confirmation {
+		return "", ErrPasswordMismatch
+	}
+	return password, nil
+}
+
+// saveKeystore encrypts the key into a new keystore in the keystore directory.
+func saveKeystore(cmd *cobra.Command, key signer.LegacyKey) error {
+	dir, err := keystoreDir(cmd)
+	if err != nil {
+		return err
+	}
+	password, err := ReadPassword(cmd, FlagPasswordFile, true)
+	if err != nil {
+		return err
+	}
+	ks, err := signer.NewKeystore(key, password)
+	if err != nil {
+		return err
+	}
+	file := filepath.Join(dir, keystoreFileName(ks.Pubkey))
+	if err = ks.Save(file); err != nil {
+		return err
+	}
+	cmd.Printf(
+		"Successfully wrote keystore of 0x%s to: %s\n", ks.Pubkey, file,
+	)
+	return nil
+}
+
+// readKeyToImport reads the key from the standard input or the key file.
+func readKeyToImport(
+	cmd *cobra.Command,
+	args []string,
+) (signer.LegacyKey, error) {
+	fromHex, err := cmd.Flags().GetBool(flagHex)
+	if err != nil {
+		return signer.LegacyKey{}, err
+	}
+	if fromHex {
+		var keyHex string
+		keyHex, err = input.GetSecretString(
+			"Enter hex-encoded private key: ",
+			bufio.NewReader(cmd.InOrStdin()),
+		)
+		if err != nil {
+			return signer.LegacyKey{}, err
+		}
+		return signer.LegacyKeyFromString(
+			strings.TrimPrefix(strings.TrimSpace(keyHex), "0x"),
+		)
+	}
+
+	keyFile := clicontext.GetConfigFromCmd(cmd).PrivValidatorKeyFile()
+	if len(args) > 0 {
+		keyFile = args[0]
+	}
+	bz, err := os.ReadFile(filepath.Clean(keyFile))
+	if err != nil {
+		return signer.LegacyKey{}, err
+	}
+	var pvKey privval.FilePVKey
+	if err = cmtjson.Unmarshal(bz, &pvKey); err != nil {
+		return signer.LegacyKey{}, errors.Wrapf(err, "reading %s", keyFile)
+	}
+	return signer.LegacyKeyFromString(hex.EncodeToString(pvKey.PrivKey.Bytes()))
+}
+
+// keystoreDir returns the keystore directory of the command flag, which
+// defaults to the one in the home directory.
+func keystoreDir(cmd *cobra.Command) (string, error) {
+	dir, err := cmd.Flags().GetString(FlagKeystoreDir)
+	if err != nil || dir != "" {
+		return dir, err
+	}
+	return filepath.Join(
+		clicontext.GetConfigFromCmd(cmd).RootDir, defaultKeystoreDir,
+	), nil
+}
+
+// keystoreFile returns the given keystore file, or the one of the given
+// public key in the keystore directory.
+func keystoreFile(cmd *cobra.Command, pubkeyOrFile string) (string, error) {
+	if _, err := os.Stat(pubkeyOrFile); err == nil {
+		return pubkeyOrFile, nil
+	}
+	dir, err := keystoreDir(cmd)
+	if err != nil {
+		return "", err
+	}
+	file := filepath.Join(
+		dir,
+		keystoreFileName(strings.TrimPrefix(pubkeyOrFile, "0x")),
+	)
+	if _, err = os.Stat(file); err != nil {
+		return "", errors.Wrap(ErrKeystoreNotFound, pubkeyOrFile)
+	}
+	return file, nil
+}
+
+// keystoreFileName returns the name of the keystore file of the hex-encoded
+// public key.
+func keystoreFileName(pubkey string) string {
+	return fmt.Sprintf("keystore-%s.json", pubkey)
+}
@@ -0,0 +1,120 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keys_test
+
+import (
+	"bytes"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/berachain/beacon-kit/cli/commands/keys"
+	"github.com/berachain/beacon-kit/primitives/crypto/keystore"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testPubkey = "9612d7a727c9d0a22e185a1c768478dfe919cada9266988cb32359c1" +
+		"1f2b7b27f4ae4040902382ae2910c15e2b420d07"
+	testSecret = "000000000019d6689c085ae165831e934ff763ae46a2a6c172b3f1b6" +
+		"0a8ce26f"
+)
+
+// writeKeystore writes a keystore of the test key in the directory and
+// returns the file holding its password.
+func writeKeystore(t *testing.T, dir string) string {
+	t.Helper()
+	secret, err := hex.DecodeString(testSecret)
+	require.NoError(t, err)
+	pubkey, err := hex.DecodeString(testPubkey)
+	require.NoError(t, err)
+
+	ks, err := keystore.Encrypt(secret, pubkey, "password")
+	require.NoError(t, err)
+	require.NoError(t, ks.Save(
+		filepath.Join(dir, "keystore-"+testPubkey+".json"),
+	))
+
+	passwordFile := filepath.Join(t.TempDir(), "password.txt")
+	require.NoError(t, os.WriteFile(passwordFile, []byte("password\n"), 0o600))
+	return passwordFile
+}
+
+func execute(t *testing.T, args ...string) (string, error) {
+	t.Helper()
+	cmd := keys.Commands()
+	out := new(bytes.Buffer)
+	cmd.SetOut(out)
+	cmd.SetErr(out)
+	cmd.SetArgs(args)
+	err := cmd.Execute()
+	return out.String(), err
+}
+
+func TestListKeystores(t *testing.T) {
+	dir := t.TempDir()
+	writeKeystore(t, dir)
+	require.NoError(
+		t, os.WriteFile(filepath.Join(dir, "other.json"), []byte("{"), 0o600),
+	)
+
+	out, err := execute(t, "list", "--keystore-dir", dir)
+	require.NoError(t, err)
+	require.Contains(t, out, "0x"+testPubkey)
+	require.Contains(t, out, "other.json")
+}
+
+func TestExportKeystore(t *testing.T) {
+	dir := t.TempDir()
+	passwordFile := writeKeystore(t, dir)
+
+	out, err := execute(
+		t, "export", "0x"+testPubkey,
+		"--keystore-dir", dir, "--password-file", passwordFile,
+	)
+	require.NoError(t, err)
+	require.Equal(t, testSecret, strings.TrimSpace(out))
+
+	_, err = execute(
+		t, "export", "0x1234",
+		"--keystore-dir", dir, "--password-file", passwordFile,
+	)
+	require.ErrorIs(t, err, keys.ErrKeystoreNotFound)
+
+	wrongPasswordFile := filepath.Join(t.TempDir(), "wrong.txt")
+	require.NoError(
+		t, os.WriteFile(wrongPasswordFile, []byte("wrong"), 0o600),
+	)
+	_, err = execute(
+		t, "export", filepath.Join(dir, "keystore-"+testPubkey+".json"),
+		"--password-file", wrongPasswordFile,
+	)
+	require.ErrorIs(t, err, keystore.ErrInvalidPassword)
+}
+
+func TestReadNewPasswordMismatch(t *testing.T) {
+	cmd := keys.NewGenerateCommand()
+	cmd.SetIn(strings.NewReader("password1\npassword2\n"))
+	_, err := keys.ReadPassword(cmd, keys.FlagPasswordFile, true)
+	require.ErrorIs(t, err, keys.ErrPasswordMismatch)
+}
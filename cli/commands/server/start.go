@@ -32,6 +32,7 @@ import (
 	"github.com/berachain/beacon-kit/storage/db"
 	cmtcmd "github.com/cometbft/cometbft/cmd/cometbft/commands"
 	dbm "github.com/cosmos/cosmos-db"
+	"github.com/spf13/cast"
 	"github.com/spf13/cobra"
 )
 
@@ -49,6 +50,10 @@ const (
 	FlagMinRetainBlocks     = "min-retain-blocks"
 	FlagIAVLCacheSize       = "iavl-cache-size"
 	FlagDisableIAVLFastNode = "iavl-disable-fastnode"
+
+	// State sync-related flags.
+	FlagStateSyncSnapshotInterval   = "state-sync.snapshot-interval"
+	FlagStateSyncSnapshotKeepRecent = "state-sync.snapshot-keep-recent"
 )
 
 // StartCmdOptions defines options that can be customized in
@@ -112,10 +117,16 @@ custom: allow pruning options to be manually specified through 'pruning-keep-rec
 			}
 
 			v := clicontext.GetViperFromCmd(cmd)
-			_, err := GetPruningOptionsFromFlags(v)
+			pruningOpts, err := GetPruningOptionsFromFlags(v)
 			if err != nil {
 				return err
 			}
+			if pruningOpts.Strategy == pruningtypes.PruningEverything &&
+				cast.ToUint64(v.Get(FlagStateSyncSnapshotInterval)) > 0 {
+				return errors.New(
+					"cannot enable state sync snapshots with 'everything' pruning setting",
+				)
+			}
 
 			// Open the Database
 			db, err := db.OpenDB(cfg.RootDir, dbm.PebbleDBBackend)
@@ -183,6 +194,16 @@ func addStartNodeFlags[
 			"Minimum block height offset during ABCI commit to prune CometBFT blocks")
 	cmd.Flags().
 		Bool(FlagDisableIAVLFastNode, false, "Disable fast node for IAVL tree")
+	cmd.Flags().
+		Uint64(
+			FlagStateSyncSnapshotInterval,
+			0,
+			"State sync snapshot interval (0 to disable)")
+	cmd.Flags().
+		Uint32(
+			FlagStateSyncSnapshotKeepRecent,
+			2, //nolint:mnd // default number of snapshots to keep.
+			"Number of state sync snapshots to keep (0 to keep all)")
 
 	// add support for all CometBFT-specific command line options
 	cmtcmd.AddNodeFlags(cmd)
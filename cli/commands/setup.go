@@ -22,10 +22,12 @@ package commands
 
 import (
 	"github.com/berachain/beacon-kit/chain-spec/chain"
+	"github.com/berachain/beacon-kit/cli/commands/debug"
 	"github.com/berachain/beacon-kit/cli/commands/deposit"
 	"github.com/berachain/beacon-kit/cli/commands/genesis"
 	"github.com/berachain/beacon-kit/cli/commands/initialize"
 	"github.com/berachain/beacon-kit/cli/commands/jwt"
+	"github.com/berachain/beacon-kit/cli/commands/keys"
 	"github.com/berachain/beacon-kit/cli/commands/server"
 	servertypes "github.com/berachain/beacon-kit/cli/commands/server/types"
 	"github.com/berachain/beacon-kit/cli/flags"
@@ -54,10 +56,14 @@ func DefaultRootCommandSetup[
 		initialize.InitCmd(mm),
 		// `genesis`
 		genesis.Commands(chainSpec),
+		// `debug`
+		debug.Commands(chainSpec),
 		// `deposit`
 		deposit.Commands(chainSpec),
 		// `jwt`
 		jwt.Commands(),
+		// `keys`
+		keys.Commands(),
 		// `rollback`
 		server.NewRollbackCmd(appCreator),
 		// `start`
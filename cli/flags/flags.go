@@ -29,6 +29,7 @@ const (
 	// Beacon Kit Root Flag.
 	beaconKitRoot      = "beacon-kit."
 	BeaconKitAcceptTos = beaconKitRoot + "accept-tos"
+	ChainSpecFile      = beaconKitRoot + "chain-spec-file"
 
 	// Builder Config.
 	builderRoot              = beaconKitRoot + "payload-builder."
@@ -43,10 +44,11 @@ const (
 	// Engine Config.
 	engineRoot              = beaconKitRoot + "engine."
 	RPCDialURL              = engineRoot + "rpc-dial-url"
+	RPCFallbackDialURLs     = engineRoot + "rpc-fallback-dial-urls"
 	RPCRetries              = engineRoot + "rpc-retries"
 	RPCTimeout              = engineRoot + "rpc-timeout"
 	RPCStartupCheckInterval = engineRoot + "rpc-startup-check-interval"
-	RPCHealthCheckInteval   = engineRoot + "rpc-health-check-interval"
+	RPCHealthCheckInterval  = engineRoot + "rpc-health-check-interval"
 	RPCJWTRefreshInterval   = engineRoot + "rpc-jwt-refresh-interval"
 	JWTSecretPath           = engineRoot + "jwt-secret-path"
 
@@ -66,17 +68,44 @@ const (
 	BlockStoreServiceEnabled            = blockStoreServiceRoot + "enabled"
 	BlockStoreServiceAvailabilityWindow = blockStoreServiceRoot +
 		"availability-window"
+	BlockStoreServiceRetentionMode = blockStoreServiceRoot +
+		"retention-mode"
 
 	// Node API Config.
 	nodeAPIRoot    = beaconKitRoot + "node-api."
 	NodeAPIEnabled = nodeAPIRoot + "enabled"
 	NodeAPIAddress = nodeAPIRoot + "address"
 	NodeAPILogging = nodeAPIRoot + "logging"
+
+	NodeAPITLSCertFile = nodeAPIRoot + "tls.cert-file"
+	NodeAPITLSKeyFile  = nodeAPIRoot + "tls.key-file"
+
+	NodeAPIAuthMode          = nodeAPIRoot + "auth.mode"
+	NodeAPIAuthBearerTokens  = nodeAPIRoot + "auth.bearer-tokens"
+	NodeAPIAuthJWTSecretPath = nodeAPIRoot + "auth.jwt-secret-path"
+
+	NodeAPIRateLimitRequestsPerSecond = nodeAPIRoot +
+		"rate-limit.requests-per-second"
+	NodeAPIRateLimitBurst     = nodeAPIRoot + "rate-limit.burst"
+	NodeAPIRateLimitExpiresIn = nodeAPIRoot + "rate-limit.expires-in"
+
+	NodeAPICORSAllowedOrigins = nodeAPIRoot + "cors.allowed-origins"
+	NodeAPICORSAllowedMethods = nodeAPIRoot + "cors.allowed-methods"
+	NodeAPICORSAllowedHeaders = nodeAPIRoot + "cors.allowed-headers"
+
+	NodeAPIRoutesAllow = nodeAPIRoot + "routes.allow"
+	NodeAPIRoutesDeny  = nodeAPIRoot + "routes.deny"
 )
 
 // AddBeaconKitFlags implements servertypes.ModuleInitFlags interface.
 func AddBeaconKitFlags(startCmd *cobra.Command) {
 	defaultCfg := config.DefaultConfig()
+	startCmd.Flags().String(
+		ChainSpecFile,
+		"",
+		"path to a TOML or YAML chain spec file, overriding the "+
+			"CHAIN_SPEC preset",
+	)
 	startCmd.Flags().String(
 		JWTSecretPath,
 		defaultCfg.Engine.JWTSecretPath,
@@ -85,6 +114,10 @@ func AddBeaconKitFlags(startCmd *cobra.Command) {
 	startCmd.Flags().String(
 		RPCDialURL, defaultCfg.Engine.RPCDialURL.String(), "rpc dial url",
 	)
+	startCmd.Flags().StringSlice(
+		RPCFallbackDialURLs, []string{},
+		"rpc dial urls of standby execution clients",
+	)
 	startCmd.Flags().Uint64(
 		RPCRetries, defaultCfg.Engine.RPCRetries, "rpc retries",
 	)
@@ -96,6 +129,11 @@ func AddBeaconKitFlags(startCmd *cobra.Command) {
 		defaultCfg.Engine.RPCStartupCheckInterval,
 		"rpc startup check interval",
 	)
+	startCmd.Flags().Duration(
+		RPCHealthCheckInterval,
+		defaultCfg.Engine.RPCHealthCheckInterval,
+		"rpc health check interval",
+	)
 	startCmd.Flags().Duration(
 		RPCJWTRefreshInterval,
 		defaultCfg.Engine.RPCJWTRefreshInterval,
@@ -141,6 +179,11 @@ func AddBeaconKitFlags(startCmd *cobra.Command) {
 		defaultCfg.BlockStoreService.AvailabilityWindow,
 		"block service availability window",
 	)
+	startCmd.Flags().String(
+		BlockStoreServiceRetentionMode,
+		defaultCfg.BlockStoreService.RetentionMode,
+		"block service retention mode (archive or window)",
+	)
 	startCmd.Flags().Bool(
 		NodeAPIEnabled,
 		defaultCfg.NodeAPI.Enabled,
@@ -156,4 +199,69 @@ func AddBeaconKitFlags(startCmd *cobra.Command) {
 		defaultCfg.NodeAPI.Logging,
 		"node api logging",
 	)
+	startCmd.Flags().String(
+		NodeAPITLSCertFile,
+		defaultCfg.NodeAPI.TLS.CertFile,
+		"node api tls certificate file",
+	)
+	startCmd.Flags().String(
+		NodeAPITLSKeyFile,
+		defaultCfg.NodeAPI.TLS.KeyFile,
+		"node api tls key file",
+	)
+	startCmd.Flags().String(
+		NodeAPIAuthMode,
+		defaultCfg.NodeAPI.Auth.Mode,
+		"node api auth mode (none, bearer or jwt)",
+	)
+	startCmd.Flags().StringSlice(
+		NodeAPIAuthBearerTokens,
+		defaultCfg.NodeAPI.Auth.BearerTokens,
+		"node api bearer tokens",
+	)
+	startCmd.Flags().String(
+		NodeAPIAuthJWTSecretPath,
+		defaultCfg.NodeAPI.Auth.JWTSecretPath,
+		"node api jwt secret path",
+	)
+	startCmd.Flags().Float64(
+		NodeAPIRateLimitRequestsPerSecond,
+		defaultCfg.NodeAPI.RateLimit.RequestsPerSecond,
+		"node api requests per second per ip",
+	)
+	startCmd.Flags().Int(
+		NodeAPIRateLimitBurst,
+		defaultCfg.NodeAPI.RateLimit.Burst,
+		"node api rate limit burst",
+	)
+	startCmd.Flags().Duration(
+		NodeAPIRateLimitExpiresIn,
+		defaultCfg.NodeAPI.RateLimit.ExpiresIn,
+		"node api rate limit expiry",
+	)
+	startCmd.Flags().StringSlice(
+		NodeAPICORSAllowedOrigins,
+		defaultCfg.NodeAPI.CORS.AllowedOrigins,
+		"node api cors allowed origins",
+	)
+	startCmd.Flags().StringSlice(
+		NodeAPICORSAllowedMethods,
+		defaultCfg.NodeAPI.CORS.AllowedMethods,
+		"node api cors allowed methods",
+	)
+	startCmd.Flags().StringSlice(
+		NodeAPICORSAllowedHeaders,
+		defaultCfg.NodeAPI.CORS.AllowedHeaders,
+		"node api cors allowed headers",
+	)
+	startCmd.Flags().StringSlice(
+		NodeAPIRoutesAllow,
+		defaultCfg.NodeAPI.Routes.Allow,
+		"node api allowed route patterns",
+	)
+	startCmd.Flags().StringSlice(
+		NodeAPIRoutesDeny,
+		defaultCfg.NodeAPI.Routes.Deny,
+		"node api denied route patterns",
+	)
 }
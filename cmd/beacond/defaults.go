@@ -29,6 +29,7 @@ func DefaultComponents() []any {
 		components.ProvideAttributesFactory[*Logger],
 		components.ProvideAvailibilityStore[*Logger],
 		components.ProvideDepositContract,
+		components.ProvideWithdrawalRequestContract,
 		components.ProvideBlockStore[*Logger],
 		components.ProvideBlsSigner,
 		components.ProvideBlobProcessor[
@@ -44,7 +45,7 @@ func DefaultComponents() []any {
 			*KVStore, *Logger, *StorageBackend, *BlockStore,
 		],
 		components.ProvideNode,
-		components.ProvideChainSpec,
+		components.ProvideChainSpec[*Logger],
 		components.ProvideConfig,
 		components.ProvideServerConfig,
 		// components.ProvideConsensusEngine[
@@ -53,11 +54,14 @@ func DefaultComponents() []any {
 		// ],
 		components.ProvideDepositStore[*Logger],
 		components.ProvideEngineClient[*Logger],
+		components.ProvideEventBus,
 		components.ProvideExecutionEngine[*Logger],
 		components.ProvideJWTSecret,
 		components.ProvideLocalBuilder[
 			*KVStore, *Logger,
 		],
+		components.ProvidePrivValidator[*Logger],
+		components.ProvideRelayClient,
 		components.ProvideReportingService[*Logger],
 		components.ProvideCometBFTService[*Logger],
 		components.ProvideServiceRegistry[
@@ -110,11 +114,17 @@ func DefaultComponents() []any {
 		components.ProvideNodeAPIBeaconHandler[
 			*CometBFTService, NodeAPIContext,
 		],
-		components.ProvideNodeAPIBuilderHandler[NodeAPIContext],
+		components.ProvideNodeAPIBuilderHandler[
+			*CometBFTService, NodeAPIContext,
+		],
 		components.ProvideNodeAPIConfigHandler[NodeAPIContext],
-		components.ProvideNodeAPIDebugHandler[NodeAPIContext],
+		components.ProvideNodeAPIDebugHandler[
+			*CometBFTService, NodeAPIContext,
+		],
 		components.ProvideNodeAPIEventsHandler[NodeAPIContext],
-		components.ProvideNodeAPINodeHandler[NodeAPIContext],
+		components.ProvideNodeAPINodeHandler[
+			*CometBFTService, NodeAPIContext,
+		],
 		components.ProvideNodeAPIProofHandler[
 			*KVStore, *CometBFTService, NodeAPIContext,
 		],
@@ -65,7 +65,7 @@ func run() error {
 				clicomponents.DefaultClientComponents(),
 				// TODO: remove these, and eventually pull cfg and chainspec
 				// from built node
-				nodecomponents.ProvideChainSpec,
+				nodecomponents.ProvideChainSpec[*Logger],
 			),
 		),
 		// Set the NodeBuilderFunc to the NodeBuilder Build.
@@ -31,6 +31,7 @@ import (
 	blockstore "github.com/berachain/beacon-kit/node-api/block_store"
 	"github.com/berachain/beacon-kit/node-api/server"
 	"github.com/berachain/beacon-kit/payload/builder"
+	"github.com/berachain/beacon-kit/payload/relay"
 	"github.com/mitchellh/mapstructure"
 	"github.com/spf13/viper"
 )
@@ -47,6 +48,7 @@ func DefaultConfig() *Config {
 		Logger:            log.DefaultConfig(),
 		KZG:               kzg.DefaultConfig(),
 		PayloadBuilder:    builder.DefaultConfig(),
+		Relay:             relay.DefaultConfig(),
 		Validator:         validator.DefaultConfig(),
 		BlockStoreService: blockstore.DefaultConfig(),
 		NodeAPI:           server.DefaultConfig(),
@@ -63,6 +65,8 @@ type Config struct {
 	KZG kzg.Config `mapstructure:"kzg"`
 	// PayloadBuilder is the configuration for the local build payload timeout.
 	PayloadBuilder builder.Config `mapstructure:"payload-builder"`
+	// Relay is the configuration for the relay to external block builders.
+	Relay relay.Config `mapstructure:"relay"`
 	// Validator is the configuration for the validator client.
 	Validator validator.Config `mapstructure:"validator"`
 	// BlockStoreService is the configuration for the block store service.
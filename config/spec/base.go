@@ -32,6 +32,11 @@ const (
 	// Default DepositContractAddress is the default address of the pre-deployed
 	// beacon deposit contract.
 	DefaultDepositContractAddress = "0x4242424242424242424242424242424242424242"
+
+	// DefaultWithdrawalRequestContractAddress is the default address of the
+	// pre-deployed withdrawal request contract. It is not the EIP-7002
+	// system contract address, as the contract has a different interface.
+	DefaultWithdrawalRequestContractAddress = "0x4343434343434343434343434343434343434343"
 )
 
 // BaseSpec returns a chain spec with default values.
@@ -98,6 +103,9 @@ func BaseSpec() chain.SpecData[
 		DepositContractAddress: common.NewExecutionAddressFromHex(
 			DefaultDepositContractAddress,
 		),
+		WithdrawalRequestContractAddress: common.NewExecutionAddressFromHex(
+			DefaultWithdrawalRequestContractAddress,
+		),
 		DepositEth1ChainID:        1,
 		Eth1FollowDistance:        1,
 		TargetSecondsPerEth1Block: 3,
@@ -105,6 +113,11 @@ func BaseSpec() chain.SpecData[
 		// Fork-related values.
 		DenebPlusForkEpoch: 9999999999999998,
 		ElectraForkEpoch:   9999999999999999,
+		SlashingForkEpoch:  9999999999999999,
+		RewardsForkEpoch:   9999999999999999,
+
+		WithdrawalRequestsForkEpoch: 9999999999999999,
+		ChurnLimitForkEpoch:         9999999999999999,
 
 		// State list length constants.
 		EpochsPerHistoricalVector: 8,
@@ -113,10 +126,16 @@ func BaseSpec() chain.SpecData[
 		ValidatorRegistryLimit:    1099511627776,
 
 		// Max operations per block constants.
-		MaxDepositsPerBlock: 16,
+		MaxDepositsPerBlock:           16,
+		MaxWithdrawalRequestsPerBlock: 16,
 
 		// Slashing
 		ProportionalSlashingMultiplier: 1,
+		MinSlashingPenaltyQuotient:     128,
+
+		// Rewards and penalties.
+		InactivityPenaltyQuotient: 1 << 24,
+		BaseRewardPerIncrement:    0,
 
 		// Capella values.
 		MaxWithdrawalsPerPayload:                    16,
@@ -135,6 +154,8 @@ func BaseSpec() chain.SpecData[
 		CometValues: cmtConsensusParams,
 
 		// Berachain Values
-		ValidatorSetCap: 256,
+		ValidatorSetCap:      256,
+		ActivationChurnLimit: 4,
+		ExitChurnLimit:       4,
 	}
 }
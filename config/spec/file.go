@@ -0,0 +1,93 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package spec
+
+import (
+	"crypto/sha256"
+	"slices"
+	"strings"
+
+	"github.com/berachain/beacon-kit/chain-spec/chain"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/mitchellh/mapstructure"
+	"github.com/spf13/viper"
+)
+
+// cometBFTConfigKey is the SpecData key of the CometBFT consensus params,
+// which cannot be set from a spec file.
+const cometBFTConfigKey = "comet-bft-config"
+
+// ErrCometBFTConfigInSpecFile is returned when a spec file sets the CometBFT
+// consensus params.
+var ErrCometBFTConfigInSpecFile = errors.New(
+	"comet-bft-config cannot be set from a chain spec file",
+)
+
+// LoadChainSpec reads the chain spec from the TOML or YAML file at the given
+// path, chosen by its extension. Keys use the names of the SpecData
+// mapstructure tags and override the values of BaseSpec, so a file only needs
+// to list the parameters that differ from it. Unknown keys are rejected.
+func LoadChainSpec(path string) (chain.Spec[
+	common.DomainType,
+	math.Epoch,
+	math.Slot,
+	any,
+], error) {
+	v := viper.New()
+	v.SetConfigFile(path)
+	if err := v.ReadInConfig(); err != nil {
+		return nil, errors.Wrapf(err, "failed to read chain spec file %s", path)
+	}
+	if v.IsSet(cometBFTConfigKey) {
+		return nil, ErrCometBFTConfigInSpecFile
+	}
+
+	specData := BaseSpec()
+	if err := v.UnmarshalExact(&specData,
+		viper.DecodeHook(mapstructure.TextUnmarshallerHookFunc()),
+	); err != nil {
+		return nil, errors.Wrapf(err, "failed to decode chain spec file %s", path)
+	}
+	return chain.NewChainSpec(specData)
+}
+
+// Hash returns the canonical hash of a chain spec: the SHA-256 digest of its
+// values, written as sorted KEY=VALUE lines. Nodes running with identical
+// parameters report the same hash regardless of how their spec was loaded.
+func Hash(cs chain.ChainSpec) common.Root {
+	values := cs.Values()
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+
+	var sb strings.Builder
+	for _, key := range keys {
+		sb.WriteString(key)
+		sb.WriteByte('=')
+		sb.WriteString(values[key])
+		sb.WriteByte('\n')
+	}
+	return sha256.Sum256([]byte(sb.String()))
+}
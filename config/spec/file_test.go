@@ -0,0 +1,113 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package spec_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/berachain/beacon-kit/chain-spec/chain"
+	"github.com/berachain/beacon-kit/config/spec"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/stretchr/testify/require"
+)
+
+func writeSpecFile(t *testing.T, name, contents string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	require.NoError(t, os.WriteFile(path, []byte(contents), 0o600))
+	return path
+}
+
+func TestLoadChainSpec(t *testing.T) {
+	tomlPath := writeSpecFile(t, "spec.toml", `
+slots-per-epoch = 16
+validator-set-cap-size = 64
+deposit-eth1-chain-id = 1337
+evm-inflation-address = "0x6942069420694206942069420694206942069420"
+evm-inflation-per-block = 5000000000
+electra-fork-epoch = 100
+domain-type-deposit = "0x03000000"
+`)
+	yamlPath := writeSpecFile(t, "spec.yaml", `
+slots-per-epoch: 16
+validator-set-cap-size: 64
+deposit-eth1-chain-id: 1337
+evm-inflation-address: "0x6942069420694206942069420694206942069420"
+evm-inflation-per-block: 5000000000
+electra-fork-epoch: 100
+domain-type-deposit: "0x03000000"
+`)
+
+	fromTOML, err := spec.LoadChainSpec(tomlPath)
+	require.NoError(t, err)
+	require.Equal(t, uint64(16), fromTOML.SlotsPerEpoch())
+	require.Equal(t, uint64(64), fromTOML.ValidatorSetCap())
+	require.Equal(t, uint64(1337), fromTOML.DepositEth1ChainID())
+	require.Equal(t, uint64(5e9), fromTOML.EVMInflationPerBlock())
+	require.Equal(t,
+		common.NewExecutionAddressFromHex(
+			"0x6942069420694206942069420694206942069420",
+		),
+		fromTOML.EVMInflationAddress(),
+	)
+	require.Equal(t, uint64(100), fromTOML.ElectraForkEpoch().Unwrap())
+	require.Equal(t,
+		common.DomainType{0x03, 0x00, 0x00, 0x00},
+		fromTOML.DomainTypeDeposit(),
+	)
+
+	// Parameters missing from the file keep their base values.
+	require.Equal(t,
+		spec.BaseSpec().MaxBlobsPerBlock, fromTOML.MaxBlobsPerBlock(),
+	)
+
+	// The same parameters produce the same hash in either format.
+	fromYAML, err := spec.LoadChainSpec(yamlPath)
+	require.NoError(t, err)
+	require.Equal(t, spec.Hash(fromTOML), spec.Hash(fromYAML))
+
+	devnet, err := spec.DevnetChainSpec()
+	require.NoError(t, err)
+	require.NotEqual(t, spec.Hash(devnet), spec.Hash(fromTOML))
+}
+
+func TestLoadChainSpecErrors(t *testing.T) {
+	_, err := spec.LoadChainSpec(
+		writeSpecFile(t, "unknown.toml", "slots-per-epok = 16\n"),
+	)
+	require.Error(t, err)
+
+	_, err = spec.LoadChainSpec(
+		writeSpecFile(t, "comet.toml", "[comet-bft-config]\nfoo = 1\n"),
+	)
+	require.ErrorIs(t, err, spec.ErrCometBFTConfigInSpecFile)
+
+	// Validation of chain.NewChainSpec applies to loaded specs.
+	_, err = spec.LoadChainSpec(
+		writeSpecFile(t, "invalid.toml", "max-withdrawals-per-payload = 1\n"),
+	)
+	require.ErrorIs(t, err, chain.ErrInsufficientMaxWithdrawalsPerPayload)
+
+	_, err = spec.LoadChainSpec(filepath.Join(t.TempDir(), "missing.toml"))
+	require.Error(t, err)
+}
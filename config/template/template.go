@@ -26,9 +26,15 @@ const TomlTemplate = `
 ###############################################################################
 
 [beacon-kit.engine]
-# HTTP url of the execution client JSON-RPC endpoint.
+# URL of the execution client JSON-RPC endpoint. The scheme selects the
+# transport: "http(s)://", "ws(s)://" for a persistent WebSocket connection, or
+# "ipc:///path/to/geth.ipc" for a Unix socket on the same host.
 rpc-dial-url = "{{ .BeaconKit.Engine.RPCDialURL }}"
 
+# URLs of standby execution client JSON-RPC endpoints, in order of
+# preference. Calls fail over to them when the endpoint above is unavailable.
+rpc-fallback-dial-urls = [{{ range $i, $v := .BeaconKit.Engine.RPCFallbackDialURLs }}{{ if $i }}, {{ end }}"{{ $v }}"{{ end }}]
+
 # Number of retries before shutting down consensus client.
 rpc-retries = "{{.BeaconKit.Engine.RPCRetries}}"
 
@@ -38,6 +44,10 @@ rpc-timeout = "{{ .BeaconKit.Engine.RPCTimeout }}"
 # Interval for the startup check.
 rpc-startup-check-interval = "{{ .BeaconKit.Engine.RPCStartupCheckInterval }}"
 
+# Interval for the health check of the execution client endpoints, when
+# standby endpoints are configured.
+rpc-health-check-interval = "{{ .BeaconKit.Engine.RPCHealthCheckInterval }}"
+
 # Interval for the JWT refresh.
 rpc-jwt-refresh-interval = "{{ .BeaconKit.Engine.RPCJWTRefreshInterval }}"
 
@@ -76,6 +86,26 @@ suggested-fee-recipient = "{{.BeaconKit.PayloadBuilder.SuggestedFeeRecipient}}"
 # timeout_proposal in the CometBFT configuration.
 payload-timeout = "{{ .BeaconKit.PayloadBuilder.PayloadTimeout }}"
 
+[beacon-kit.relay]
+# Enabled determines if payloads are requested from external block builders
+# through the relay, the local payload being proposed when more valuable or
+# when the relay fails to respond in time.
+enabled = {{ .BeaconKit.Relay.Enabled }}
+
+# URL of the relay. If set as the user of the URL, the relay public key is
+# checked against the key signing bids, e.g. "https://0xabc...@relay.example".
+url = "{{ .BeaconKit.Relay.URL }}"
+
+# The timeout for requests to the relay. It must leave time to build the block
+# within timeout_proposal in the CometBFT configuration.
+timeout = "{{ .BeaconKit.Relay.Timeout }}"
+
+# The gas limit the validator registers with the relay.
+gas-limit = {{ .BeaconKit.Relay.GasLimit }}
+
+# The interval between registrations of the validator with the relay.
+registration-interval = "{{ .BeaconKit.Relay.RegistrationInterval }}"
+
 [beacon-kit.validator]
 # Graffiti string that will be included in the graffiti field of the beacon block.
 graffiti = "{{.BeaconKit.Validator.Graffiti}}"
@@ -84,13 +114,25 @@ graffiti = "{{.BeaconKit.Validator.Graffiti}}"
 # process-proposal to allow for the execution client to have more time to assemble the block.
 enable-optimistic-payload-builds = "{{.BeaconKit.Validator.EnableOptimisticPayloadBuilds}}"
 
+# KeystoreFile is the EIP-2335 keystore holding the validator key. If set, it is used instead
+# of the plain-text priv_validator_key_file. Relative paths are relative to the home directory.
+keystore-file = "{{.BeaconKit.Validator.KeystoreFile}}"
+
+# KeystorePasswordFile is the file holding the password of the keystore.
+keystore-password-file = "{{.BeaconKit.Validator.KeystorePasswordFile}}"
+
 [beacon-kit.block-store-service]
 # Enabled determines if the block store service is enabled.
 enabled = "{{ .BeaconKit.BlockStoreService.Enabled }}"
 
-# AvailabilityWindow is the number of slots to keep in the store.
+# AvailabilityWindow is the number of slots for which blocks, headers and their
+# root and timestamp indices are kept when the retention mode is window.
 availability-window = "{{ .BeaconKit.BlockStoreService.AvailabilityWindow }}"
 
+# RetentionMode is either "archive", which keeps every finalized block, or
+# "window", which prunes blocks older than the availability window.
+retention-mode = "{{ .BeaconKit.BlockStoreService.RetentionMode }}"
+
 [beacon-kit.node-api]
 # Enabled determines if the node API is enabled.
 enabled = "{{ .BeaconKit.NodeAPI.Enabled }}"
@@ -100,4 +142,40 @@ address = "{{ .BeaconKit.NodeAPI.Address }}"
 
 # Logging determines if the node API logging is enabled.
 logging = "{{ .BeaconKit.NodeAPI.Logging }}"
+
+[beacon-kit.node-api.tls]
+# Paths to the PEM encoded certificate and key. TLS is enabled when both are set.
+cert-file = "{{ .BeaconKit.NodeAPI.TLS.CertFile }}"
+key-file = "{{ .BeaconKit.NodeAPI.TLS.KeyFile }}"
+
+[beacon-kit.node-api.auth]
+# Mode is the authentication mode of the node API: "none", "bearer" or "jwt".
+# In "bearer" mode requests must send "Authorization: Bearer <token>" with one
+# of bearer-tokens. In "jwt" mode the token must be an HS256 JWT signed with
+# the secret at jwt-secret-path, with an iat claim within 60s of the node clock.
+mode = "{{ .BeaconKit.NodeAPI.Auth.Mode }}"
+bearer-tokens = [{{ range $i, $v := .BeaconKit.NodeAPI.Auth.BearerTokens }}{{ if $i }}, {{ end }}"{{ $v }}"{{ end }}]
+jwt-secret-path = "{{ .BeaconKit.NodeAPI.Auth.JWTSecretPath }}"
+
+[beacon-kit.node-api.rate-limit]
+# RequestsPerSecond is the sustained number of requests allowed per client IP.
+# 0 disables rate limiting.
+requests-per-second = "{{ .BeaconKit.NodeAPI.RateLimit.RequestsPerSecond }}"
+
+# Burst is the maximum number of requests allowed at once per client IP.
+burst = "{{ .BeaconKit.NodeAPI.RateLimit.Burst }}"
+
+# ExpiresIn is how long an idle client IP is remembered by the rate limiter.
+expires-in = "{{ .BeaconKit.NodeAPI.RateLimit.ExpiresIn }}"
+
+[beacon-kit.node-api.cors]
+allowed-origins = [{{ range $i, $v := .BeaconKit.NodeAPI.CORS.AllowedOrigins }}{{ if $i }}, {{ end }}"{{ $v }}"{{ end }}]
+allowed-methods = [{{ range $i, $v := .BeaconKit.NodeAPI.CORS.AllowedMethods }}{{ if $i }}, {{ end }}"{{ $v }}"{{ end }}]
+allowed-headers = [{{ range $i, $v := .BeaconKit.NodeAPI.CORS.AllowedHeaders }}{{ if $i }}, {{ end }}"{{ $v }}"{{ end }}]
+
+[beacon-kit.node-api.routes]
+# Allow and deny lists of route patterns, e.g. "/eth/v1/debug/*". A trailing "*"
+# matches any suffix. Deny takes precedence, and an empty allow list allows all.
+allow = [{{ range $i, $v := .BeaconKit.NodeAPI.Routes.Allow }}{{ if $i }}, {{ end }}"{{ $v }}"{{ end }}]
+deny = [{{ range $i, $v := .BeaconKit.NodeAPI.Routes.Deny }}{{ if $i }}, {{ end }}"{{ $v }}"{{ end }}]
 `
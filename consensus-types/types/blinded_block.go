@@ -0,0 +1,295 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"encoding/binary"
+
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/eip4844"
+	"github.com/berachain/beacon-kit/primitives/encoding/ssz/constants"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/version"
+	"github.com/karalabe/ssz"
+)
+
+// BlindedBeaconBlock is a beacon block whose execution payload is replaced by
+// its header, as exchanged with external block builders. It has the same hash
+// tree root as the beacon block it blinds, so that signatures over either of
+// them are interchangeable.
+type BlindedBeaconBlock struct {
+	// Slot represents the position of the block in the chain.
+	Slot math.Slot `json:"slot"`
+	// ProposerIndex is the index of the validator who proposed the block.
+	ProposerIndex math.ValidatorIndex `json:"proposer_index"`
+	// ParentRoot is the hash of the parent block
+	ParentRoot common.Root `json:"parent_root"`
+	// StateRoot is the hash of the state at the block.
+	StateRoot common.Root `json:"state_root"`
+	// Body is the blinded body of the block.
+	Body *BlindedBeaconBlockBody `json:"body"`
+}
+
+// BlindedBeaconBlockBody is a beacon block body whose execution payload is
+// replaced by its header.
+type BlindedBeaconBlockBody struct {
+	// forkVersion selects the SSZ layout of the body, as in BeaconBlockBody.
+	forkVersion uint32
+	// RandaoReveal is the reveal of the RANDAO.
+	RandaoReveal crypto.BLSSignature `json:"randao_reveal"`
+	// Eth1Data is the data from the Eth1 chain.
+	Eth1Data *Eth1Data `json:"eth1_data"`
+	// Graffiti is for a fun message or meme.
+	Graffiti common.Bytes32 `json:"graffiti"`
+	// Deposits is the list of deposits included in the body.
+	Deposits []*Deposit `json:"deposits"`
+	// ExecutionPayloadHeader is the header of the execution payload.
+	ExecutionPayloadHeader *ExecutionPayloadHeader `json:"execution_payload_header"`
+	// BlobKzgCommitments is the list of KZG commitments for the EIP-4844 blobs.
+	BlobKzgCommitments []eip4844.KZGCommitment `json:"blob_kzg_commitments"`
+	// WithdrawalRequests is the list of withdrawal requests included in the
+	// body, from Electra on.
+	WithdrawalRequests []*WithdrawalRequest `json:"withdrawal_requests,omitempty"`
+}
+
+// NewBlindedBeaconBlock returns the blinded block made of the given block and
+// execution payload header. The execution payload of the block, if any, is
+// ignored.
+func NewBlindedBeaconBlock(
+	blk *BeaconBlock,
+	header *ExecutionPayloadHeader,
+) *BlindedBeaconBlock {
+	return &BlindedBeaconBlock{
+		Slot:          blk.Slot,
+		ProposerIndex: blk.ProposerIndex,
+		ParentRoot:    blk.ParentRoot,
+		StateRoot:     blk.StateRoot,
+		Body: &BlindedBeaconBlockBody{
+			forkVersion:            blk.Body.forkVersion,
+			RandaoReveal:           blk.Body.RandaoReveal,
+			Eth1Data:               blk.Body.Eth1Data,
+			Graffiti:               blk.Body.Graffiti,
+			Deposits:               blk.Body.Deposits,
+			ExecutionPayloadHeader: header,
+			BlobKzgCommitments:     blk.Body.BlobKzgCommitments,
+			WithdrawalRequests:     blk.Body.WithdrawalRequests,
+		},
+	}
+}
+
+// Blind returns the blinded version of the block.
+func (b *BeaconBlock) Blind() (*BlindedBeaconBlock, error) {
+	header, err := b.Body.ExecutionPayload.ToHeader()
+	if err != nil {
+		return nil, err
+	}
+	return NewBlindedBeaconBlock(b, header), nil
+}
+
+// Unblind returns the beacon block carrying the given execution payload in
+// place of the header of the blinded block. It errors if the payload does not
+// match the header.
+func (b *BlindedBeaconBlock) Unblind(
+	payload *ExecutionPayload,
+) (*BeaconBlock, error) {
+	if b.Body.ExecutionPayloadHeader == nil {
+		return nil, ErrNilPayloadHeader
+	}
+	if payload.HashTreeRoot() != b.Body.ExecutionPayloadHeader.HashTreeRoot() {
+		return nil, errors.Wrapf(
+			ErrPayloadHeaderMismatch,
+			"block hash %s", payload.GetBlockHash(),
+		)
+	}
+	return &BeaconBlock{
+		Slot:          b.Slot,
+		ProposerIndex: b.ProposerIndex,
+		ParentRoot:    b.ParentRoot,
+		StateRoot:     b.StateRoot,
+		Body: &BeaconBlockBody{
+			forkVersion:        b.Body.forkVersion,
+			RandaoReveal:       b.Body.RandaoReveal,
+			Eth1Data:           b.Body.Eth1Data,
+			Graffiti:           b.Body.Graffiti,
+			Deposits:           b.Body.Deposits,
+			ExecutionPayload:   payload,
+			BlobKzgCommitments: b.Body.BlobKzgCommitments,
+			WithdrawalRequests: b.Body.WithdrawalRequests,
+		},
+	}, nil
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                     SSZ                                    */
+/* -------------------------------------------------------------------------- */
+
+// SizeSSZ returns the size of the BlindedBeaconBlock in SSZ.
+func (b *BlindedBeaconBlock) SizeSSZ(siz *ssz.Sizer, fixed bool) uint32 {
+	//nolint:mnd // same layout as BeaconBlock.
+	var size = uint32(8 + 8 + 32 + 32 + 4)
+	if fixed {
+		return size
+	}
+	size += ssz.SizeDynamicObject(siz, b.Body)
+	return size
+}
+
+// DefineSSZ defines the SSZ serialization of the BlindedBeaconBlock.
+func (b *BlindedBeaconBlock) DefineSSZ(codec *ssz.Codec) {
+	// Define the static data (fields and dynamic offsets)
+	ssz.DefineUint64(codec, &b.Slot)
+	ssz.DefineUint64(codec, &b.ProposerIndex)
+	ssz.DefineStaticBytes(codec, &b.ParentRoot)
+	ssz.DefineStaticBytes(codec, &b.StateRoot)
+	ssz.DefineDynamicObjectOffset(codec, &b.Body)
+
+	// Define the dynamic data (fields)
+	ssz.DefineDynamicObjectContent(codec, &b.Body)
+}
+
+// MarshalSSZ serializes the BlindedBeaconBlock to SSZ-encoded bytes.
+func (b *BlindedBeaconBlock) MarshalSSZ() ([]byte, error) {
+	buf := make([]byte, ssz.Size(b))
+	return buf, ssz.EncodeToBytes(buf, b)
+}
+
+// UnmarshalSSZ deserializes the BlindedBeaconBlock from SSZ-encoded bytes.
+// The layout of the body is inferred from its encoding.
+func (b *BlindedBeaconBlock) UnmarshalSSZ(buf []byte) error {
+	if b.Body == nil {
+		b.Body = &BlindedBeaconBlockBody{}
+	}
+	if len(buf) >= blockBodyOffset+constants.BytesPerLengthOffset {
+		offset := binary.LittleEndian.Uint32(buf[blockBodyOffset:])
+		if uint64(offset) <= uint64(len(buf)) {
+			b.Body.setLayoutFromSSZ(buf[offset:])
+		}
+	}
+	return ssz.DecodeFromBytes(buf, b)
+}
+
+// Version identifies the version of the BlindedBeaconBlock, which follows
+// the layout of its body.
+func (b *BlindedBeaconBlock) Version() uint32 {
+	if b.Body == nil {
+		return version.Deneb
+	}
+	return b.Body.Version()
+}
+
+// HashTreeRoot returns the SSZ hash tree root of the BlindedBeaconBlock.
+func (b *BlindedBeaconBlock) HashTreeRoot() common.Root {
+	return ssz.HashConcurrent(b)
+}
+
+// SizeSSZ returns the size of the BlindedBeaconBlockBody in SSZ.
+func (b *BlindedBeaconBlockBody) SizeSSZ(siz *ssz.Sizer, fixed bool) uint32 {
+	var size uint32 = bodyFixedSizeDeneb
+	if b.isElectra() {
+		size = bodyFixedSizeElectra
+	}
+	if fixed {
+		return size
+	}
+
+	size += ssz.SizeSliceOfStaticObjects(siz, b.Deposits)
+	size += ssz.SizeDynamicObject(siz, b.ExecutionPayloadHeader)
+	size += ssz.SizeSliceOfStaticBytes(siz, b.BlobKzgCommitments)
+	if b.isElectra() {
+		size += ssz.SizeSliceOfStaticObjects(siz, b.WithdrawalRequests)
+	}
+	return size
+}
+
+// DefineSSZ defines the SSZ serialization of the BlindedBeaconBlockBody.
+//
+//nolint:mnd // same limits as BeaconBlockBody.
+func (b *BlindedBeaconBlockBody) DefineSSZ(codec *ssz.Codec) {
+	// Define the static data (fields and dynamic offsets)
+	ssz.DefineStaticBytes(codec, &b.RandaoReveal)
+	ssz.DefineStaticObject(codec, &b.Eth1Data)
+	ssz.DefineStaticBytes(codec, &b.Graffiti)
+	ssz.DefineSliceOfStaticObjectsOffset(codec, &b.Deposits, 16)
+	ssz.DefineDynamicObjectOffset(codec, &b.ExecutionPayloadHeader)
+	ssz.DefineSliceOfStaticBytesOffset(codec, &b.BlobKzgCommitments, 16)
+	if b.isElectra() {
+		ssz.DefineSliceOfStaticObjectsOffset(
+			codec, &b.WithdrawalRequests, MaxWithdrawalRequestsPerBlock,
+		)
+	}
+
+	// Define the dynamic data (fields)
+	ssz.DefineSliceOfStaticObjectsContent(codec, &b.Deposits, 16)
+	ssz.DefineDynamicObjectContent(codec, &b.ExecutionPayloadHeader)
+	ssz.DefineSliceOfStaticBytesContent(codec, &b.BlobKzgCommitments, 16)
+	if b.isElectra() {
+		ssz.DefineSliceOfStaticObjectsContent(
+			codec, &b.WithdrawalRequests, MaxWithdrawalRequestsPerBlock,
+		)
+	}
+}
+
+// MarshalSSZ serializes the BlindedBeaconBlockBody to SSZ-encoded bytes.
+func (b *BlindedBeaconBlockBody) MarshalSSZ() ([]byte, error) {
+	buf := make([]byte, ssz.Size(b))
+	return buf, ssz.EncodeToBytes(buf, b)
+}
+
+// UnmarshalSSZ deserializes the BlindedBeaconBlockBody from SSZ-encoded
+// bytes. The layout is inferred as for BeaconBlockBody.
+func (b *BlindedBeaconBlockBody) UnmarshalSSZ(buf []byte) error {
+	b.setLayoutFromSSZ(buf)
+	return ssz.DecodeFromBytes(buf, b)
+}
+
+// setLayoutFromSSZ selects the layout of the body encoded in buf.
+func (b *BlindedBeaconBlockBody) setLayoutFromSSZ(buf []byte) {
+	electra := isElectraBodySSZ(buf)
+	switch {
+	case electra && !b.isElectra():
+		b.forkVersion = version.Electra
+	case !electra && b.isElectra():
+		b.forkVersion = version.Deneb
+		b.WithdrawalRequests = nil
+	}
+}
+
+// Version returns the fork version of the layout of the
+// BlindedBeaconBlockBody.
+func (b *BlindedBeaconBlockBody) Version() uint32 {
+	if b.isElectra() {
+		return version.Electra
+	}
+	return version.Deneb
+}
+
+// isElectra reports whether the BlindedBeaconBlockBody uses the Electra
+// layout.
+func (b *BlindedBeaconBlockBody) isElectra() bool {
+	return b.forkVersion >= version.Electra
+}
+
+// HashTreeRoot returns the SSZ hash tree root of the BlindedBeaconBlockBody.
+func (b *BlindedBeaconBlockBody) HashTreeRoot() common.Root {
+	return ssz.HashConcurrent(b)
+}
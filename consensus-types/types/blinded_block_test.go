@@ -0,0 +1,89 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/primitives/version"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlindedBeaconBlockHashTreeRoot(t *testing.T) {
+	block := generateValidBeaconBlock()
+	blinded, err := block.Blind()
+	require.NoError(t, err)
+
+	// signatures over the blinded block hold for the full block
+	require.Equal(t, block.HashTreeRoot(), blinded.HashTreeRoot())
+	require.Equal(t, block.Body.HashTreeRoot(), blinded.Body.HashTreeRoot())
+}
+
+func TestBlindedBeaconBlockSSZ(t *testing.T) {
+	blinded, err := generateValidBeaconBlock().Blind()
+	require.NoError(t, err)
+
+	bz, err := blinded.MarshalSSZ()
+	require.NoError(t, err)
+
+	decoded := new(types.BlindedBeaconBlock)
+	require.NoError(t, decoded.UnmarshalSSZ(bz))
+	require.Equal(t, blinded.HashTreeRoot(), decoded.HashTreeRoot())
+}
+
+func TestBlindedBeaconBlockUnblind(t *testing.T) {
+	block := generateValidBeaconBlock()
+	blinded, err := block.Blind()
+	require.NoError(t, err)
+
+	unblinded, err := blinded.Unblind(block.Body.ExecutionPayload)
+	require.NoError(t, err)
+	require.Equal(t, block, unblinded)
+
+	// a payload other than the one committed to is rejected
+	other := generateValidBeaconBlock().Body.ExecutionPayload
+	other.Transactions = other.Transactions[1:]
+	_, err = blinded.Unblind(other)
+	require.ErrorIs(t, err, types.ErrPayloadHeaderMismatch)
+
+	blinded.Body.ExecutionPayloadHeader = nil
+	_, err = blinded.Unblind(block.Body.ExecutionPayload)
+	require.ErrorIs(t, err, types.ErrNilPayloadHeader)
+}
+
+func TestBlindedBeaconBlockElectra(t *testing.T) {
+	block := generateValidElectraBeaconBlock()
+	blinded, err := block.Blind()
+	require.NoError(t, err)
+	require.Equal(t, block.HashTreeRoot(), blinded.HashTreeRoot())
+
+	bz, err := blinded.MarshalSSZ()
+	require.NoError(t, err)
+	decoded := new(types.BlindedBeaconBlock)
+	require.NoError(t, decoded.UnmarshalSSZ(bz))
+	require.Equal(t, version.Electra, decoded.Version())
+	require.Equal(t, blinded.HashTreeRoot(), decoded.HashTreeRoot())
+
+	unblinded, err := decoded.Unblind(block.Body.ExecutionPayload)
+	require.NoError(t, err)
+	require.Equal(t, block, unblinded)
+}
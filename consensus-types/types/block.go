@@ -21,16 +21,22 @@
 package types
 
 import (
+	"encoding/binary"
 	"fmt"
 
 	"github.com/berachain/beacon-kit/errors"
 	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/encoding/ssz/constants"
 	"github.com/berachain/beacon-kit/primitives/math"
 	"github.com/berachain/beacon-kit/primitives/version"
 	fastssz "github.com/ferranbt/fastssz"
 	"github.com/karalabe/ssz"
 )
 
+// blockBodyOffset is the position of the body offset in the BeaconBlock
+// encoding.
+const blockBodyOffset = 8 + 8 + 32 + 32
+
 // BeaconBlock represents a block in the beacon chain during
 // the Deneb fork.
 type BeaconBlock struct {
@@ -59,20 +65,21 @@ func (b *BeaconBlock) NewWithVersion(
 	parentBlockRoot common.Root,
 	forkVersion uint32,
 ) (*BeaconBlock, error) {
-	if forkVersion == version.Deneb {
+	switch forkVersion {
+	case version.Deneb, version.Electra:
 		return &BeaconBlock{
 			Slot:          slot,
 			ProposerIndex: proposerIndex,
 			ParentRoot:    parentBlockRoot,
 			StateRoot:     common.Root{},
-			Body:          &BeaconBlockBody{},
+			Body:          &BeaconBlockBody{forkVersion: forkVersion},
 		}, nil
+	default:
+		return nil, errors.Wrap(
+			ErrForkVersionNotSupported,
+			fmt.Sprintf("fork %d", forkVersion),
+		)
 	}
-
-	return nil, errors.Wrap(
-		ErrForkVersionNotSupported,
-		fmt.Sprintf("fork %d", forkVersion),
-	)
 }
 
 // NewFromSSZ creates a new beacon block from the given SSZ bytes.
@@ -80,9 +87,15 @@ func (b *BeaconBlock) NewFromSSZ(
 	bz []byte,
 	forkVersion uint32,
 ) (*BeaconBlock, error) {
-	if forkVersion == version.Deneb {
-		block := &BeaconBlock{}
-		return block, block.UnmarshalSSZ(bz)
+	switch forkVersion {
+	case version.Deneb, version.Electra:
+		// The body layout is dictated by the fork, not inferred from bz.
+		block := &BeaconBlock{Body: &BeaconBlockBody{}}
+		if forkVersion >= version.Electra {
+			block.Body.forkVersion = forkVersion
+		}
+		return block, ssz.DecodeFromBytes(bz, block)
+	default:
 	}
 
 	// assign err here to appease nilaway
@@ -125,8 +138,18 @@ func (b *BeaconBlock) MarshalSSZ() ([]byte, error) {
 	return buf, ssz.EncodeToBytes(buf, b)
 }
 
-// UnmarshalSSZ unmarshals the BeaconBlock object from SSZ format.
+// UnmarshalSSZ unmarshals the BeaconBlock object from SSZ format. The layout
+// of the body is inferred from its encoding.
 func (b *BeaconBlock) UnmarshalSSZ(buf []byte) error {
+	if b.Body == nil {
+		b.Body = &BeaconBlockBody{}
+	}
+	if len(buf) >= blockBodyOffset+constants.BytesPerLengthOffset {
+		offset := binary.LittleEndian.Uint32(buf[blockBodyOffset:])
+		if uint64(offset) <= uint64(len(buf)) {
+			b.Body.setLayoutFromSSZ(buf[offset:])
+		}
+	}
 	return ssz.DecodeFromBytes(buf, b)
 }
 
@@ -205,9 +228,13 @@ func (b *BeaconBlock) GetStateRoot() common.Root {
 	return b.StateRoot
 }
 
-// Version identifies the version of the BeaconBlock.
+// Version identifies the version of the BeaconBlock, which follows the
+// layout of its body.
 func (b *BeaconBlock) Version() uint32 {
-	return version.Deneb
+	if b.Body == nil {
+		return version.Deneb
+	}
+	return b.Body.Version()
 }
 
 // SetStateRoot sets the state root of the BeaconBlock.
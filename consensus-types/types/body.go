@@ -26,10 +26,13 @@
 package types
 
 import (
+	"encoding/binary"
+
 	"github.com/berachain/beacon-kit/chain-spec/chain"
 	"github.com/berachain/beacon-kit/primitives/common"
 	"github.com/berachain/beacon-kit/primitives/crypto"
 	"github.com/berachain/beacon-kit/primitives/eip4844"
+	"github.com/berachain/beacon-kit/primitives/encoding/ssz/constants"
 	"github.com/berachain/beacon-kit/primitives/math"
 	"github.com/berachain/beacon-kit/primitives/math/log"
 	"github.com/berachain/beacon-kit/primitives/version"
@@ -42,6 +45,10 @@ const (
 	// struct.
 	BodyLengthDeneb uint64 = 6
 
+	// BodyLengthElectra is the number of fields in the Electra block body,
+	// which appends WithdrawalRequests to the Deneb fields.
+	BodyLengthElectra uint64 = 7
+
 	// KZGPositionDeneb is the position of BlobKzgCommitments in the block body.
 	KZGPositionDeneb = BodyLengthDeneb - 1
 
@@ -51,15 +58,24 @@ const (
 
 	// ExtraDataSize is the size of ExtraData in bytes.
 	ExtraDataSize = 32
+
+	// bodyFixedSizeDeneb is the fixed SSZ size of the Deneb block body.
+	bodyFixedSizeDeneb = 96 + 72 + 32 + 4 + 4 + 4
+	// bodyFixedSizeElectra is the fixed SSZ size of the Electra block body.
+	bodyFixedSizeElectra = bodyFixedSizeDeneb + 4
+	// bodyFirstOffset is the position of the first offset in the block body
+	// encoding, which is that of Deposits.
+	bodyFirstOffset = 96 + 72 + 32
 )
 
 // Empty returns a new BeaconBlockBody with empty fields
 // for the given fork version.
 func (b *BeaconBlockBody) Empty(forkVersion uint32) *BeaconBlockBody {
 	switch forkVersion {
-	case version.Deneb:
+	case version.Deneb, version.Electra:
 		return &BeaconBlockBody{
-			Eth1Data: new(Eth1Data),
+			forkVersion: forkVersion,
+			Eth1Data:    new(Eth1Data),
 			ExecutionPayload: &ExecutionPayload{
 				ExtraData: make([]byte, ExtraDataSize),
 			},
@@ -76,7 +92,7 @@ func BlockBodyKZGOffset(
 	cs chain.ChainSpec,
 ) (uint64, error) {
 	switch cs.ActiveForkVersionForSlot(slot) {
-	case version.Deneb:
+	case version.Deneb, version.Electra:
 		return KZGMerkleIndexDeneb * cs.MaxBlobCommitmentsPerBlock(), nil
 	default:
 		return 0, ErrForkVersionNotSupported
@@ -89,7 +105,7 @@ func BlockBodyKZGPosition(
 	forkVersion uint32,
 ) (uint64, error) {
 	switch forkVersion {
-	case version.Deneb:
+	case version.Deneb, version.Electra:
 		return KZGPositionDeneb, nil
 	default:
 		return 0, ErrForkVersionNotSupported
@@ -104,7 +120,7 @@ func KZGCommitmentInclusionProofDepth(
 ) (uint8, error) {
 	const maxUint8 = 255
 	switch cs.ActiveForkVersionForSlot(slot) {
-	case version.Deneb:
+	case version.Deneb, version.Electra:
 		sum := uint64(log.ILog2Floor(uint64(KZGMerkleIndexDeneb))) +
 			uint64(log.ILog2Ceil(cs.MaxBlobCommitmentsPerBlock())) + 1
 		if sum > maxUint8 {
@@ -118,8 +134,11 @@ func KZGCommitmentInclusionProofDepth(
 }
 
 // BeaconBlockBody represents the body of a beacon block in the Deneb
-// chain.
+// chain. WithdrawalRequests is only part of the Electra body; before Electra
+// it is neither encoded nor hashed.
 type BeaconBlockBody struct {
+	// forkVersion selects the SSZ layout of the body.
+	forkVersion uint32
 	// RandaoReveal is the reveal of the RANDAO.
 	RandaoReveal crypto.BLSSignature
 	// Eth1Data is the data from the Eth1 chain.
@@ -132,6 +151,9 @@ type BeaconBlockBody struct {
 	ExecutionPayload *ExecutionPayload
 	// BlobKzgCommitments is the list of KZG commitments for the EIP-4844 blobs.
 	BlobKzgCommitments []eip4844.KZGCommitment
+	// WithdrawalRequests is the list of withdrawal requests included in the
+	// body.
+	WithdrawalRequests []*WithdrawalRequest
 }
 
 /* -------------------------------------------------------------------------- */
@@ -140,7 +162,10 @@ type BeaconBlockBody struct {
 
 // SizeSSZ returns the size of the BeaconBlockBody in SSZ.
 func (b *BeaconBlockBody) SizeSSZ(siz *ssz.Sizer, fixed bool) uint32 {
-	var size uint32 = 96 + 72 + 32 + 4 + 4 + 4
+	var size uint32 = bodyFixedSizeDeneb
+	if b.isElectra() {
+		size = bodyFixedSizeElectra
+	}
 	if fixed {
 		return size
 	}
@@ -148,6 +173,9 @@ func (b *BeaconBlockBody) SizeSSZ(siz *ssz.Sizer, fixed bool) uint32 {
 	size += ssz.SizeSliceOfStaticObjects(siz, b.Deposits)
 	size += ssz.SizeDynamicObject(siz, b.ExecutionPayload)
 	size += ssz.SizeSliceOfStaticBytes(siz, b.BlobKzgCommitments)
+	if b.isElectra() {
+		size += ssz.SizeSliceOfStaticObjects(siz, b.WithdrawalRequests)
+	}
 	return size
 }
 
@@ -162,11 +190,21 @@ func (b *BeaconBlockBody) DefineSSZ(codec *ssz.Codec) {
 	ssz.DefineSliceOfStaticObjectsOffset(codec, &b.Deposits, 16)
 	ssz.DefineDynamicObjectOffset(codec, &b.ExecutionPayload)
 	ssz.DefineSliceOfStaticBytesOffset(codec, &b.BlobKzgCommitments, 16)
+	if b.isElectra() {
+		ssz.DefineSliceOfStaticObjectsOffset(
+			codec, &b.WithdrawalRequests, MaxWithdrawalRequestsPerBlock,
+		)
+	}
 
 	// Define the dynamic data (fields)
 	ssz.DefineSliceOfStaticObjectsContent(codec, &b.Deposits, 16)
 	ssz.DefineDynamicObjectContent(codec, &b.ExecutionPayload)
 	ssz.DefineSliceOfStaticBytesContent(codec, &b.BlobKzgCommitments, 16)
+	if b.isElectra() {
+		ssz.DefineSliceOfStaticObjectsContent(
+			codec, &b.WithdrawalRequests, MaxWithdrawalRequestsPerBlock,
+		)
+	}
 }
 
 // MarshalSSZ serializes the BeaconBlockBody to SSZ-encoded bytes.
@@ -175,11 +213,34 @@ func (b *BeaconBlockBody) MarshalSSZ() ([]byte, error) {
 	return buf, ssz.EncodeToBytes(buf, b)
 }
 
-// UnmarshalSSZ deserializes the BeaconBlockBody from SSZ-encoded bytes.
+// UnmarshalSSZ deserializes the BeaconBlockBody from SSZ-encoded bytes. The
+// layout is told apart by the first offset, which points right past the
+// fixed part.
 func (b *BeaconBlockBody) UnmarshalSSZ(buf []byte) error {
+	b.setLayoutFromSSZ(buf)
 	return ssz.DecodeFromBytes(buf, b)
 }
 
+// setLayoutFromSSZ selects the layout of the body encoded in buf.
+func (b *BeaconBlockBody) setLayoutFromSSZ(buf []byte) {
+	electra := isElectraBodySSZ(buf)
+	switch {
+	case electra && !b.isElectra():
+		b.forkVersion = version.Electra
+	case !electra && b.isElectra():
+		b.forkVersion = version.Deneb
+		b.WithdrawalRequests = nil
+	}
+}
+
+// isElectraBodySSZ reports whether buf encodes a block body in the Electra
+// layout.
+func isElectraBodySSZ(buf []byte) bool {
+	return len(buf) >= bodyFirstOffset+constants.BytesPerLengthOffset &&
+		binary.LittleEndian.Uint32(buf[bodyFirstOffset:]) ==
+			bodyFixedSizeElectra
+}
+
 // HashTreeRoot returns the SSZ hash tree root of the BeaconBlockBody.
 func (b *BeaconBlockBody) HashTreeRoot() common.Root {
 	return ssz.HashConcurrent(b)
@@ -256,6 +317,26 @@ func (b *BeaconBlockBody) HashTreeRootWith(hh fastssz.HashWalker) error {
 		hh.MerkleizeWithMixin(subIndx, numItems, 16)
 	}
 
+	if !b.isElectra() {
+		hh.Merkleize(indx)
+		return nil
+	}
+
+	// Field (6) 'WithdrawalRequests'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(b.WithdrawalRequests))
+		if num > MaxWithdrawalRequestsPerBlock {
+			return fastssz.ErrIncorrectListSize
+		}
+		for _, elem := range b.WithdrawalRequests {
+			if err := elem.HashTreeRootWith(hh); err != nil {
+				return err
+			}
+		}
+		hh.MerkleizeWithMixin(subIndx, num, MaxWithdrawalRequestsPerBlock)
+	}
+
 	hh.Merkleize(indx)
 	return nil
 }
@@ -326,7 +407,7 @@ func (b *BeaconBlockBody) SetSlashingInfo(_ []*SlashingInfo) {
 
 // GetTopLevelRoots returns the top-level roots of the BeaconBlockBody.
 func (b *BeaconBlockBody) GetTopLevelRoots() []common.Root {
-	return []common.Root{
+	roots := []common.Root{
 		common.Root(b.GetRandaoReveal().HashTreeRoot()),
 		b.Eth1Data.HashTreeRoot(),
 		common.Root(b.GetGraffiti().HashTreeRoot()),
@@ -334,13 +415,33 @@ func (b *BeaconBlockBody) GetTopLevelRoots() []common.Root {
 		b.GetExecutionPayload().HashTreeRoot(),
 		{},
 	}
+	if b.isElectra() {
+		roots = append(roots, b.GetWithdrawalRequests().HashTreeRoot())
+	}
+	return roots
 }
 
 // Length returns the number of fields in the BeaconBlockBody struct.
 func (b *BeaconBlockBody) Length() uint64 {
+	if b.isElectra() {
+		return BodyLengthElectra
+	}
 	return BodyLengthDeneb
 }
 
+// Version returns the fork version of the layout of the BeaconBlockBody.
+func (b *BeaconBlockBody) Version() uint32 {
+	if b.isElectra() {
+		return version.Electra
+	}
+	return version.Deneb
+}
+
+// isElectra reports whether the BeaconBlockBody uses the Electra layout.
+func (b *BeaconBlockBody) isElectra() bool {
+	return b.forkVersion >= version.Electra
+}
+
 // GetRandaoReveal returns the RandaoReveal of the Body.
 func (b *BeaconBlockBody) GetRandaoReveal() crypto.BLSSignature {
 	return b.RandaoReveal
@@ -375,3 +476,16 @@ func (b *BeaconBlockBody) GetDeposits() Deposits {
 func (b *BeaconBlockBody) SetDeposits(deposits Deposits) {
 	b.Deposits = deposits
 }
+
+// GetWithdrawalRequests returns the WithdrawalRequests of the
+// BeaconBlockBody.
+func (b *BeaconBlockBody) GetWithdrawalRequests() WithdrawalRequests {
+	return b.WithdrawalRequests
+}
+
+// SetWithdrawalRequests sets the WithdrawalRequests of the BeaconBlockBody.
+func (b *BeaconBlockBody) SetWithdrawalRequests(
+	requests WithdrawalRequests,
+) {
+	b.WithdrawalRequests = requests
+}
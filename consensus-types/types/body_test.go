@@ -160,3 +160,74 @@ func TestBeaconBlockBody_Empty(t *testing.T) {
 	body := blockBody.Empty(version.Deneb)
 	require.NotNil(t, body)
 }
+
+// generateValidElectraBeaconBlock returns the block of
+// generateValidBeaconBlock with an Electra body carrying a withdrawal request.
+func generateValidElectraBeaconBlock() *types.BeaconBlock {
+	blk := generateValidBeaconBlock()
+	body := new(types.BeaconBlockBody).Empty(version.Electra)
+	body.RandaoReveal = blk.Body.RandaoReveal
+	body.Eth1Data = blk.Body.Eth1Data
+	body.Graffiti = blk.Body.Graffiti
+	body.Deposits = blk.Body.Deposits
+	body.ExecutionPayload = blk.Body.ExecutionPayload
+	body.BlobKzgCommitments = blk.Body.BlobKzgCommitments
+	body.WithdrawalRequests = []*types.WithdrawalRequest{
+		types.NewWithdrawalRequest(
+			common.ExecutionAddress{1}, [48]byte{2}, 0, 3,
+		),
+	}
+	blk.Body = body
+	return blk
+}
+
+func TestBeaconBlockBodyElectraLayout(t *testing.T) {
+	deneb := generateValidBeaconBlock()
+	electra := generateValidElectraBeaconBlock()
+	require.Equal(t, version.Deneb, deneb.Version())
+	require.Equal(t, version.Electra, electra.Version())
+	require.Equal(t, types.BodyLengthDeneb, deneb.Body.Length())
+	require.Equal(t, types.BodyLengthElectra, electra.Body.Length())
+	require.Len(t, electra.Body.GetTopLevelRoots(), 7)
+
+	// The withdrawal requests are part of the Electra body only.
+	electra.Body.WithdrawalRequests = nil
+	require.NotEqual(t,
+		deneb.Body.HashTreeRoot(), electra.Body.HashTreeRoot(),
+	)
+	denebBz, err := deneb.Body.MarshalSSZ()
+	require.NoError(t, err)
+	electraBz, err := electra.Body.MarshalSSZ()
+	require.NoError(t, err)
+	require.Len(t, electraBz, len(denebBz)+4)
+}
+
+func TestBeaconBlockElectraFromSSZ(t *testing.T) {
+	originalBlock := generateValidElectraBeaconBlock()
+	sszBlock, err := originalBlock.MarshalSSZ()
+	require.NoError(t, err)
+
+	wrappedBlock, err := new(types.BeaconBlock).NewFromSSZ(
+		sszBlock, version.Electra,
+	)
+	require.NoError(t, err)
+	require.Equal(t, originalBlock, wrappedBlock)
+	require.Equal(t,
+		originalBlock.HashTreeRoot(), wrappedBlock.HashTreeRoot(),
+	)
+
+	// The layout follows the fork, so an Electra block is not a Deneb one.
+	_, err = new(types.BeaconBlock).NewFromSSZ(sszBlock, version.Deneb)
+	require.Error(t, err)
+
+	// A plain decoding infers the layout in either direction.
+	decoded := new(types.BeaconBlock)
+	require.NoError(t, decoded.UnmarshalSSZ(sszBlock))
+	require.Equal(t, originalBlock, decoded)
+
+	denebBz, err := generateValidBeaconBlock().MarshalSSZ()
+	require.NoError(t, err)
+	require.NoError(t, decoded.UnmarshalSSZ(denebBz))
+	require.Equal(t, version.Deneb, decoded.Version())
+	require.Empty(t, decoded.Body.WithdrawalRequests)
+}
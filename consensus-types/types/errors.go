@@ -42,4 +42,10 @@ var (
 
 	// ErrNilPayloadHeader is an error for when the payload header is nil.
 	ErrNilPayloadHeader = errors.New("nil payload header")
+
+	// ErrPayloadHeaderMismatch is an error for when an execution payload does
+	// not match the header of a blinded block.
+	ErrPayloadHeaderMismatch = errors.New(
+		"execution payload does not match blinded block header",
+	)
 )
@@ -21,48 +21,78 @@
 package types
 
 import (
+	"encoding/binary"
+
 	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/encoding/ssz/constants"
 	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/version"
 	fastssz "github.com/ferranbt/fastssz"
 	"github.com/karalabe/ssz"
 )
 
+const (
+	// beaconStateFixedSizeDeneb is the fixed SSZ size of the Deneb
+	// BeaconState.
+	beaconStateFixedSizeDeneb = 300
+	// beaconStateFixedSizeElectra is the fixed SSZ size of the Electra
+	// BeaconState.
+	beaconStateFixedSizeElectra = 316
+	// beaconStateFirstOffset is the position of the first offset in the
+	// BeaconState encoding, which is that of BlockRoots.
+	beaconStateFirstOffset = 168
+)
+
 // BeaconState represents the entire state of the beacon chain.
+//
+// The fields under Inactivity and Withdrawal requests are only part of the
+// Electra state. Before Electra they are neither encoded nor hashed, so the
+// Deneb state keeps its schema and roots.
 type BeaconState struct {
+	// forkVersion selects the SSZ layout of the state.
+	forkVersion uint32
+
 	// Versioning
-	GenesisValidatorsRoot common.Root
-	Slot                  math.Slot
-	Fork                  *Fork
+	GenesisValidatorsRoot common.Root `json:"genesis_validators_root"`
+	Slot                  math.Slot   `json:"slot"`
+	Fork                  *Fork       `json:"fork"`
 
 	// History
-	LatestBlockHeader *BeaconBlockHeader
-	BlockRoots        []common.Root
-	StateRoots        []common.Root
+	LatestBlockHeader *BeaconBlockHeader `json:"latest_block_header"`
+	BlockRoots        []common.Root      `json:"block_roots"`
+	StateRoots        []common.Root      `json:"state_roots"`
 
 	// Eth1
-	Eth1Data                     *Eth1Data
-	Eth1DepositIndex             uint64
-	LatestExecutionPayloadHeader *ExecutionPayloadHeader
+	Eth1Data                     *Eth1Data               `json:"eth1_data"`
+	Eth1DepositIndex             uint64                  `json:"eth1_deposit_index"`
+	LatestExecutionPayloadHeader *ExecutionPayloadHeader `json:"latest_execution_payload_header"`
 
 	// Registry
-	Validators []*Validator
-	Balances   []uint64
+	Validators []*Validator `json:"validators"`
+	Balances   []uint64     `json:"balances"`
 
 	// Randomness
-	RandaoMixes []common.Bytes32
+	RandaoMixes []common.Bytes32 `json:"randao_mixes"`
 
 	// Withdrawals
-	NextWithdrawalIndex          uint64
-	NextWithdrawalValidatorIndex math.ValidatorIndex
+	NextWithdrawalIndex          uint64              `json:"next_withdrawal_index"`
+	NextWithdrawalValidatorIndex math.ValidatorIndex `json:"next_withdrawal_validator_index"`
 
 	// Slashing
-	Slashings     []math.Gwei
-	TotalSlashing math.Gwei
+	Slashings     []math.Gwei `json:"slashings"`
+	TotalSlashing math.Gwei   `json:"total_slashing"`
+
+	// Inactivity
+	InactivityScores []uint64 `json:"inactivity_scores,omitempty"`
+
+	// Withdrawal requests
+	NextWithdrawalRequestIndex uint64   `json:"next_withdrawal_request_index,omitempty"`
+	PendingPartialWithdrawals  []uint64 `json:"pending_partial_withdrawals,omitempty"`
 }
 
 // New creates a new BeaconState.
 func (st *BeaconState) New(
-	_ uint32,
+	forkVersion uint32,
 	genesisValidatorsRoot common.Root,
 	slot math.Slot,
 	fork *Fork,
@@ -81,6 +111,7 @@ func (st *BeaconState) New(
 	totalSlashing math.Gwei,
 ) (*BeaconState, error) {
 	return &BeaconState{
+		forkVersion:                  forkVersion,
 		Slot:                         slot,
 		GenesisValidatorsRoot:        genesisValidatorsRoot,
 		Fork:                         fork,
@@ -100,13 +131,26 @@ func (st *BeaconState) New(
 	}, nil
 }
 
+// Version returns the fork version the BeaconState was created for.
+func (st *BeaconState) Version() uint32 {
+	return st.forkVersion
+}
+
+// isElectra reports whether the BeaconState uses the Electra layout.
+func (st *BeaconState) isElectra() bool {
+	return st.forkVersion >= version.Electra
+}
+
 /* -------------------------------------------------------------------------- */
 /*                                     SSZ                                    */
 /* -------------------------------------------------------------------------- */
 
 // SizeSSZ returns the ssz encoded size in bytes for the BeaconState object.
 func (st *BeaconState) SizeSSZ(siz *ssz.Sizer, fixed bool) uint32 {
-	var size uint32 = 300
+	var size uint32 = beaconStateFixedSizeDeneb
+	if st.isElectra() {
+		size = beaconStateFixedSizeElectra
+	}
 
 	if fixed {
 		return size
@@ -120,6 +164,10 @@ func (st *BeaconState) SizeSSZ(siz *ssz.Sizer, fixed bool) uint32 {
 	size += ssz.SizeSliceOfUint64s(siz, st.Balances)
 	size += ssz.SizeSliceOfStaticBytes(siz, st.RandaoMixes)
 	size += ssz.SizeSliceOfUint64s(siz, st.Slashings)
+	if st.isElectra() {
+		size += ssz.SizeSliceOfUint64s(siz, st.InactivityScores)
+		size += ssz.SizeSliceOfUint64s(siz, st.PendingPartialWithdrawals)
+	}
 
 	return size
 }
@@ -158,6 +206,19 @@ func (st *BeaconState) DefineSSZ(codec *ssz.Codec) {
 	ssz.DefineSliceOfUint64sOffset(codec, &st.Slashings, 1099511627776)
 	ssz.DefineUint64(codec, (*uint64)(&st.TotalSlashing))
 
+	if st.isElectra() {
+		// Inactivity
+		ssz.DefineSliceOfUint64sOffset(
+			codec, &st.InactivityScores, 1099511627776,
+		)
+
+		// Withdrawal requests
+		ssz.DefineUint64(codec, &st.NextWithdrawalRequestIndex)
+		ssz.DefineSliceOfUint64sOffset(
+			codec, &st.PendingPartialWithdrawals, 1099511627776,
+		)
+	}
+
 	// Dynamic content
 	ssz.DefineSliceOfStaticBytesContent(codec, &st.BlockRoots, 8192)
 	ssz.DefineSliceOfStaticBytesContent(codec, &st.StateRoots, 8192)
@@ -166,6 +227,14 @@ func (st *BeaconState) DefineSSZ(codec *ssz.Codec) {
 	ssz.DefineSliceOfUint64sContent(codec, &st.Balances, 1099511627776)
 	ssz.DefineSliceOfStaticBytesContent(codec, &st.RandaoMixes, 65536)
 	ssz.DefineSliceOfUint64sContent(codec, &st.Slashings, 1099511627776)
+	if st.isElectra() {
+		ssz.DefineSliceOfUint64sContent(
+			codec, &st.InactivityScores, 1099511627776,
+		)
+		ssz.DefineSliceOfUint64sContent(
+			codec, &st.PendingPartialWithdrawals, 1099511627776,
+		)
+	}
 }
 
 // MarshalSSZ marshals the BeaconState into SSZ format.
@@ -174,8 +243,23 @@ func (st *BeaconState) MarshalSSZ() ([]byte, error) {
 	return buf, ssz.EncodeToBytes(buf, st)
 }
 
-// UnmarshalSSZ unmarshals the BeaconState from SSZ format.
+// UnmarshalSSZ unmarshals the BeaconState from SSZ format. The layout is
+// told apart by the first offset, which points right past the fixed part.
 func (st *BeaconState) UnmarshalSSZ(buf []byte) error {
+	electra := len(buf) >= beaconStateFirstOffset+
+		constants.BytesPerLengthOffset &&
+		binary.LittleEndian.Uint32(
+			buf[beaconStateFirstOffset:],
+		) == beaconStateFixedSizeElectra
+	switch {
+	case electra && !st.isElectra():
+		st.forkVersion = version.Electra
+	case !electra && st.isElectra():
+		st.forkVersion = version.Deneb
+		st.InactivityScores = nil
+		st.NextWithdrawalRequestIndex = 0
+		st.PendingPartialWithdrawals = nil
+	}
 	return ssz.DecodeFromBytes(buf, st)
 }
 
@@ -343,6 +427,54 @@ func (st *BeaconState) HashTreeRootWith(
 	// Field (15) 'TotalSlashing'
 	hh.PutUint64(uint64(st.TotalSlashing))
 
+	if !st.isElectra() {
+		hh.Merkleize(indx)
+		return nil
+	}
+
+	// Field (16) 'InactivityScores'
+	if size := len(st.InactivityScores); size > 1099511627776 {
+		return fastssz.ErrListTooBigFn(
+			"BeaconState.InactivityScores",
+			size,
+			1099511627776,
+		)
+	}
+	subIndx = hh.Index()
+	for _, i := range st.InactivityScores {
+		hh.AppendUint64(i)
+	}
+	hh.FillUpTo32()
+	numItems = uint64(len(st.InactivityScores))
+	hh.MerkleizeWithMixin(
+		subIndx,
+		numItems,
+		fastssz.CalculateLimit(1099511627776, numItems, 8),
+	)
+
+	// Field (17) 'NextWithdrawalRequestIndex'
+	hh.PutUint64(st.NextWithdrawalRequestIndex)
+
+	// Field (18) 'PendingPartialWithdrawals'
+	if size := len(st.PendingPartialWithdrawals); size > 1099511627776 {
+		return fastssz.ErrListTooBigFn(
+			"BeaconState.PendingPartialWithdrawals",
+			size,
+			1099511627776,
+		)
+	}
+	subIndx = hh.Index()
+	for _, i := range st.PendingPartialWithdrawals {
+		hh.AppendUint64(i)
+	}
+	hh.FillUpTo32()
+	numItems = uint64(len(st.PendingPartialWithdrawals))
+	hh.MerkleizeWithMixin(
+		subIndx,
+		numItems,
+		fastssz.CalculateLimit(1099511627776, numItems, 8),
+	)
+
 	hh.Merkleize(indx)
 	return nil
 }
@@ -33,6 +33,7 @@ import (
 	"github.com/berachain/beacon-kit/consensus-types/types"
 	"github.com/berachain/beacon-kit/primitives/common"
 	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/version"
 	karalabessz "github.com/karalabe/ssz"
 	"github.com/stretchr/testify/require"
 )
@@ -145,6 +146,80 @@ func TestBeaconStateMarshalUnmarshalSSZ(t *testing.T) {
 	require.Positive(t, karalabessz.Size(genState))
 }
 
+// generateValidElectraBeaconState returns the state of
+// generateValidBeaconState in the Electra layout, with the fields added in
+// Electra set.
+func generateValidElectraBeaconState(t *testing.T) *types.BeaconState {
+	t.Helper()
+	st := generateValidBeaconState()
+	electra, err := st.New(
+		version.Electra,
+		st.GenesisValidatorsRoot,
+		st.Slot,
+		st.Fork,
+		st.LatestBlockHeader,
+		st.BlockRoots,
+		st.StateRoots,
+		st.Eth1Data,
+		st.Eth1DepositIndex,
+		st.LatestExecutionPayloadHeader,
+		st.Validators,
+		st.Balances,
+		st.RandaoMixes,
+		st.NextWithdrawalIndex,
+		st.NextWithdrawalValidatorIndex,
+		st.Slashings,
+		st.TotalSlashing,
+	)
+	require.NoError(t, err)
+	electra.InactivityScores = []uint64{0, 4}
+	electra.NextWithdrawalRequestIndex = 9
+	electra.PendingPartialWithdrawals = []uint64{0, 1000000000}
+	return electra
+}
+
+func TestBeaconStateElectraMarshalUnmarshalSSZ(t *testing.T) {
+	genState := generateValidElectraBeaconState(t)
+
+	data, err := genState.MarshalSSZ()
+	require.NoError(t, err)
+
+	// The layout is told apart from the Deneb one when decoding.
+	newState := &types.BeaconState{}
+	require.NoError(t, newState.UnmarshalSSZ(data))
+	require.Equal(t, version.Electra, newState.Version())
+	require.EqualValues(t, genState, newState)
+	require.Equal(t, genState.HashTreeRoot(), newState.HashTreeRoot())
+
+	// The Deneb state leaves the Electra fields out of its encoding and root.
+	denebState := generateValidBeaconState()
+	denebData, err := denebState.MarshalSSZ()
+	require.NoError(t, err)
+	require.Less(t, len(denebData), len(data))
+	require.NotEqual(t, denebState.HashTreeRoot(), genState.HashTreeRoot())
+
+	denebState.InactivityScores = genState.InactivityScores
+	denebState.NextWithdrawalRequestIndex = genState.NextWithdrawalRequestIndex
+	bz, err := denebState.MarshalSSZ()
+	require.NoError(t, err)
+	require.Equal(t, denebData, bz)
+	require.Equal(t,
+		generateValidBeaconState().HashTreeRoot(), denebState.HashTreeRoot(),
+	)
+
+	// Decoding Deneb bytes into an Electra state switches its layout back.
+	require.NoError(t, newState.UnmarshalSSZ(denebData))
+	require.Equal(t, version.Deneb, newState.Version())
+}
+
+func TestBeaconStateElectraHashTreeRootWith(t *testing.T) {
+	st := generateValidElectraBeaconState(t)
+	tree, err := st.GetTree()
+	require.NoError(t, err)
+	root := st.HashTreeRoot()
+	require.Equal(t, root[:], tree.Hash())
+}
+
 func TestHashTreeRoot(t *testing.T) {
 	state := generateValidBeaconState()
 	require.NotPanics(t, func() {
@@ -299,6 +299,11 @@ func (v Validator) HasMaxEffectiveBalance(
 	return v.EffectiveBalance == maxEffectiveBalance
 }
 
+// SetSlashed sets whether the validator has been slashed.
+func (v *Validator) SetSlashed(slashed bool) {
+	v.Slashed = slashed
+}
+
 // SetEffectiveBalance sets the effective balance of the validator.
 func (v *Validator) SetEffectiveBalance(balance math.Gwei) {
 	v.EffectiveBalance = balance
@@ -336,6 +341,11 @@ func (v Validator) GetWithdrawableEpoch() math.Epoch {
 	return v.WithdrawableEpoch
 }
 
+// SetWithdrawalCredentials sets the withdrawal credentials of the validator.
+func (v *Validator) SetWithdrawalCredentials(c WithdrawalCredentials) {
+	v.WithdrawalCredentials = c
+}
+
 // GetWithdrawalCredentials returns the withdrawal credentials of the validator.
 func (v Validator) GetWithdrawalCredentials() WithdrawalCredentials {
 	return v.WithdrawalCredentials
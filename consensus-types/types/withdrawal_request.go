@@ -0,0 +1,226 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/constraints"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/math"
+	fastssz "github.com/ferranbt/fastssz"
+	"github.com/karalabe/ssz"
+)
+
+const (
+	// WithdrawalRequestSize is the size of the SSZ encoding of a
+	// WithdrawalRequest.
+	WithdrawalRequestSize = 104 // 20 + 48 + 8 + 20 + 8
+
+	// FullExitRequestAmount is the amount of a withdrawal request asking for
+	// the validator to exit and be fully withdrawn.
+	FullExitRequestAmount math.Gwei = 0
+
+	// MaxWithdrawalRequestsPerBlock is the SSZ limit of the withdrawal
+	// requests in a block body.
+	MaxWithdrawalRequestsPerBlock = 16
+)
+
+// Compile-time assertions to ensure WithdrawalRequest implements necessary
+// interfaces.
+var (
+	_ ssz.StaticObject                    = (*WithdrawalRequest)(nil)
+	_ constraints.SSZMarshallableRootable = (*WithdrawalRequest)(nil)
+)
+
+// WithdrawalRequest is a request, made on the execution layer through the
+// withdrawal request contract, to withdraw from a validator in the style of
+// EIP-7002, or to change its withdrawal credentials.
+type WithdrawalRequest struct {
+	// SourceAddress is the execution address that made the request.
+	SourceAddress common.ExecutionAddress `json:"source_address"`
+	// ValidatorPubkey is the public key of the validator to withdraw from.
+	ValidatorPubkey crypto.BLSPubkey `json:"validator_pubkey"`
+	// Amount is the amount to withdraw in gwei, FullExitRequestAmount to
+	// exit the validator. It is zero for a credentials change.
+	Amount math.Gwei `json:"amount"`
+	// NewWithdrawalAddress is the execution address the validator withdraws
+	// to after a credentials change, zero for a withdrawal.
+	NewWithdrawalAddress common.ExecutionAddress `json:"new_withdrawal_address"`
+	// Index of the request in the withdrawal request contract.
+	Index uint64 `json:"index"`
+}
+
+// NewWithdrawalRequest creates a new WithdrawalRequest instance.
+func NewWithdrawalRequest(
+	sourceAddress common.ExecutionAddress,
+	validatorPubkey crypto.BLSPubkey,
+	amount math.Gwei,
+	index uint64,
+) *WithdrawalRequest {
+	return &WithdrawalRequest{
+		SourceAddress:   sourceAddress,
+		ValidatorPubkey: validatorPubkey,
+		Amount:          amount,
+		Index:           index,
+	}
+}
+
+// NewCredentialsChangeRequest creates a new WithdrawalRequest instance asking
+// for the withdrawal credentials of the validator to be changed to the given
+// address.
+func NewCredentialsChangeRequest(
+	sourceAddress common.ExecutionAddress,
+	validatorPubkey crypto.BLSPubkey,
+	newWithdrawalAddress common.ExecutionAddress,
+	index uint64,
+) *WithdrawalRequest {
+	return &WithdrawalRequest{
+		SourceAddress:        sourceAddress,
+		ValidatorPubkey:      validatorPubkey,
+		NewWithdrawalAddress: newWithdrawalAddress,
+		Index:                index,
+	}
+}
+
+// Empty creates an empty WithdrawalRequest instance.
+func (w *WithdrawalRequest) Empty() *WithdrawalRequest {
+	return &WithdrawalRequest{}
+}
+
+// IsCredentialsChange returns true if the request asks for the withdrawal
+// credentials of the validator to be changed.
+func (w *WithdrawalRequest) IsCredentialsChange() bool {
+	return w.NewWithdrawalAddress != common.ExecutionAddress{}
+}
+
+// IsFullExit returns true if the request asks for the validator to exit.
+func (w *WithdrawalRequest) IsFullExit() bool {
+	return !w.IsCredentialsChange() && w.Amount == FullExitRequestAmount
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                     SSZ                                    */
+/* -------------------------------------------------------------------------- */
+
+// DefineSSZ defines the SSZ encoding for the WithdrawalRequest object.
+func (w *WithdrawalRequest) DefineSSZ(c *ssz.Codec) {
+	ssz.DefineStaticBytes(c, &w.SourceAddress)
+	ssz.DefineStaticBytes(c, &w.ValidatorPubkey)
+	ssz.DefineUint64(c, &w.Amount)
+	ssz.DefineStaticBytes(c, &w.NewWithdrawalAddress)
+	ssz.DefineUint64(c, &w.Index)
+}
+
+// MarshalSSZ marshals the WithdrawalRequest object to SSZ format.
+func (w *WithdrawalRequest) MarshalSSZ() ([]byte, error) {
+	buf := make([]byte, ssz.Size(w))
+	return buf, ssz.EncodeToBytes(buf, w)
+}
+
+// UnmarshalSSZ unmarshals the WithdrawalRequest object from SSZ format.
+func (w *WithdrawalRequest) UnmarshalSSZ(buf []byte) error {
+	return ssz.DecodeFromBytes(buf, w)
+}
+
+// SizeSSZ returns the SSZ encoded size of the WithdrawalRequest object.
+func (w *WithdrawalRequest) SizeSSZ(*ssz.Sizer) uint32 {
+	return WithdrawalRequestSize
+}
+
+// HashTreeRoot computes the Merkleization of the WithdrawalRequest object.
+func (w *WithdrawalRequest) HashTreeRoot() common.Root {
+	return ssz.HashSequential(w)
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                   FastSSZ                                  */
+/* -------------------------------------------------------------------------- */
+
+// MarshalSSZTo marshals the WithdrawalRequest object into a pre-allocated
+// byte slice.
+func (w *WithdrawalRequest) MarshalSSZTo(dst []byte) ([]byte, error) {
+	bz, err := w.MarshalSSZ()
+	if err != nil {
+		return nil, err
+	}
+	dst = append(dst, bz...)
+	return dst, nil
+}
+
+// HashTreeRootWith ssz hashes the WithdrawalRequest object with a hasher.
+func (w *WithdrawalRequest) HashTreeRootWith(hh fastssz.HashWalker) error {
+	indx := hh.Index()
+
+	// Field (0) 'SourceAddress'
+	hh.PutBytes(w.SourceAddress[:])
+
+	// Field (1) 'ValidatorPubkey'
+	hh.PutBytes(w.ValidatorPubkey[:])
+
+	// Field (2) 'Amount'
+	hh.PutUint64(uint64(w.Amount))
+
+	// Field (3) 'NewWithdrawalAddress'
+	hh.PutBytes(w.NewWithdrawalAddress[:])
+
+	// Field (4) 'Index'
+	hh.PutUint64(w.Index)
+
+	hh.Merkleize(indx)
+	return nil
+}
+
+// GetTree ssz hashes the WithdrawalRequest object.
+func (w *WithdrawalRequest) GetTree() (*fastssz.Node, error) {
+	return fastssz.ProofTree(w)
+}
+
+// WithdrawalRequests is a typealias for a list of WithdrawalRequests.
+type WithdrawalRequests []*WithdrawalRequest
+
+// SizeSSZ returns the SSZ encoded size in bytes for the WithdrawalRequests.
+func (wr WithdrawalRequests) SizeSSZ(siz *ssz.Sizer, _ bool) uint32 {
+	return ssz.SizeSliceOfStaticObjects(siz, ([]*WithdrawalRequest)(wr))
+}
+
+// DefineSSZ defines the SSZ encoding for the WithdrawalRequests object.
+func (wr WithdrawalRequests) DefineSSZ(c *ssz.Codec) {
+	c.DefineDecoder(func(*ssz.Decoder) {
+		ssz.DefineSliceOfStaticObjectsContent(
+			c, (*[]*WithdrawalRequest)(&wr), MaxWithdrawalRequestsPerBlock,
+		)
+	})
+	c.DefineEncoder(func(*ssz.Encoder) {
+		ssz.DefineSliceOfStaticObjectsContent(
+			c, (*[]*WithdrawalRequest)(&wr), MaxWithdrawalRequestsPerBlock,
+		)
+	})
+	c.DefineHasher(func(*ssz.Hasher) {
+		ssz.DefineSliceOfStaticObjectsOffset(
+			c, (*[]*WithdrawalRequest)(&wr), MaxWithdrawalRequestsPerBlock,
+		)
+	})
+}
+
+// HashTreeRoot returns the hash tree root of the WithdrawalRequests.
+func (wr WithdrawalRequests) HashTreeRoot() common.Root {
+	return ssz.HashSequential(wr)
+}
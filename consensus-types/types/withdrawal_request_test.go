@@ -0,0 +1,89 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/math"
+	karalabessz "github.com/karalabe/ssz"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithdrawalRequest_MarshalUnmarshalSSZ(t *testing.T) {
+	original := types.NewWithdrawalRequest(
+		common.ExecutionAddress{0x01, 0x02},
+		crypto.BLSPubkey{0x03, 0x04},
+		math.Gwei(32e9),
+		7,
+	)
+
+	bz, err := original.MarshalSSZ()
+	require.NoError(t, err)
+	require.Len(t, bz, types.WithdrawalRequestSize)
+	require.Equal(t, uint32(types.WithdrawalRequestSize), karalabessz.Size(original))
+
+	var unmarshalled types.WithdrawalRequest
+	require.NoError(t, unmarshalled.UnmarshalSSZ(bz))
+	require.Equal(t, original, &unmarshalled)
+}
+
+func TestWithdrawalRequest_GetTree(t *testing.T) {
+	request := types.NewWithdrawalRequest(
+		common.ExecutionAddress{0x01, 0x02},
+		crypto.BLSPubkey{0x03, 0x04},
+		types.FullExitRequestAmount,
+		7,
+	)
+	require.True(t, request.IsFullExit())
+
+	tree, err := request.GetTree()
+	require.NoError(t, err)
+
+	expectedRoot := request.HashTreeRoot()
+	require.Equal(t, string(expectedRoot[:]), string(tree.Hash()))
+}
+
+func TestWithdrawalRequest_CredentialsChange(t *testing.T) {
+	original := types.NewCredentialsChangeRequest(
+		common.ExecutionAddress{0x01, 0x02},
+		crypto.BLSPubkey{0x03, 0x04},
+		common.ExecutionAddress{0x05, 0x06},
+		7,
+	)
+	require.True(t, original.IsCredentialsChange())
+	require.False(t, original.IsFullExit())
+
+	bz, err := original.MarshalSSZ()
+	require.NoError(t, err)
+
+	var unmarshalled types.WithdrawalRequest
+	require.NoError(t, unmarshalled.UnmarshalSSZ(bz))
+	require.Equal(t, original, &unmarshalled)
+
+	tree, err := original.GetTree()
+	require.NoError(t, err)
+	expectedRoot := original.HashTreeRoot()
+	require.Equal(t, string(expectedRoot[:]), string(tree.Hash()))
+}
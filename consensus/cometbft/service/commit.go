@@ -48,6 +48,9 @@ func (s *Service[LoggerT]) commit(
 
 	s.finalizeBlockState = nil
 
+	// Snapshots are taken asynchronously, from the version just committed.
+	s.snapshotManager.SnapshotIfApplicable(header.Height)
+
 	return &cmtabci.CommitResponse{
 		RetainHeight: retainHeight,
 	}, nil
@@ -119,6 +122,16 @@ func (s *Service[_]) GetBlockRetentionHeight(commitHeight int64) int64 {
 		retentionHeight = commitHeight - cp.Evidence.MaxAgeNumBlocks
 	}
 
+	if s.snapshotManager != nil {
+		snapshotRetentionHeights := s.snapshotManager.
+			GetSnapshotBlockRetentionHeights()
+		if snapshotRetentionHeights > 0 {
+			retentionHeight = minNonZero(
+				retentionHeight, commitHeight-snapshotRetentionHeights,
+			)
+		}
+	}
+
 	//#nosec:G701 // bet.
 	v := commitHeight - int64(s.minRetainBlocks)
 	retentionHeight = minNonZero(retentionHeight, v)
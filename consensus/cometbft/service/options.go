@@ -22,6 +22,8 @@ package cometbft
 
 import (
 	pruningtypes "cosmossdk.io/store/pruning/types"
+	"cosmossdk.io/store/snapshots"
+	snapshottypes "cosmossdk.io/store/snapshots/types"
 	storetypes "cosmossdk.io/store/types"
 	"github.com/berachain/beacon-kit/log"
 )
@@ -81,3 +83,14 @@ func SetChainID[
 ](chainID string) func(*Service[LoggerT]) {
 	return func(s *Service[LoggerT]) { s.chainID = chainID }
 }
+
+// SetSnapshot sets the snapshot store and options, enabling state sync
+// snapshots of the multistore.
+func SetSnapshot[
+	LoggerT log.AdvancedLogger[LoggerT],
+](
+	snapshotStore *snapshots.Store,
+	opts snapshottypes.SnapshotOptions,
+) func(*Service[LoggerT]) {
+	return func(s *Service[LoggerT]) { s.setSnapshot(snapshotStore, opts) }
+}
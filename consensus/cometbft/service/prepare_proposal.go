@@ -63,6 +63,8 @@ func (s *Service[LoggerT]) prepareProposal(
 		nil,
 		req.GetProposerAddress(),
 		req.GetTime(),
+		req.GetMisbehavior(),
+		req.GetLocalLastCommit(),
 	)
 
 	//nolint:contextcheck // TODO: We should look at using the passed context
@@ -25,6 +25,7 @@ import (
 	"errors"
 	"fmt"
 
+	"cosmossdk.io/store/snapshots"
 	storetypes "cosmossdk.io/store/types"
 	"github.com/berachain/beacon-kit/beacon/blockchain"
 	"github.com/berachain/beacon-kit/beacon/validator"
@@ -40,8 +41,8 @@ import (
 	cmtcfg "github.com/cometbft/cometbft/config"
 	"github.com/cometbft/cometbft/node"
 	"github.com/cometbft/cometbft/p2p"
-	pvm "github.com/cometbft/cometbft/privval"
 	"github.com/cometbft/cometbft/proxy"
+	cmttypes "github.com/cometbft/cometbft/types"
 	dbm "github.com/cosmos/cosmos-db"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -57,6 +58,7 @@ type Service[
 ] struct {
 	node          *node.Node
 	cmtCfg        *cmtcfg.Config
+	privVal       cmttypes.PrivValidator
 	telemetrySink TelemetrySink
 
 	logger       LoggerT
@@ -83,6 +85,9 @@ type Service[
 	finalizeBlockState *state
 
 	interBlockCache storetypes.MultiStorePersistentCache
+	// snapshotManager takes and restores state sync snapshots. It is nil
+	// when snapshots are disabled.
+	snapshotManager *snapshots.Manager
 	paramStore      *params.ConsensusParamsStore
 
 	// initialHeight is the initial height at which we start the node
@@ -101,6 +106,7 @@ func NewService[
 	blockchain blockchain.BlockchainI,
 	blockBuilder validator.BlockBuilderI,
 	cmtCfg *cmtcfg.Config,
+	privVal cmttypes.PrivValidator,
 	cs chain.ChainSpec,
 	telemetrySink TelemetrySink,
 	options ...func(*Service[LoggerT]),
@@ -114,6 +120,7 @@ func NewService[
 		Blockchain:    blockchain,
 		BlockBuilder:  blockBuilder,
 		cmtCfg:        cmtCfg,
+		privVal:       privVal,
 		telemetrySink: telemetrySink,
 		paramStore:    params.NewConsensusParamsStore(cs),
 	}
@@ -146,19 +153,15 @@ func (s *Service[_]) Start(
 		return err
 	}
 
-	privVal, err := pvm.LoadOrGenFilePV(
-		cfg.PrivValidatorKeyFile(),
-		cfg.PrivValidatorStateFile(),
-		nil,
-	)
-	if err != nil {
-		return err
-	}
+	// The validator key signer, remote or not, is shared with the beacon
+	// chain. Prevent CometBFT from listening for a remote signer of its own.
+	nodeCfg := *cfg
+	nodeCfg.PrivValidatorListenAddr = ""
 
 	s.node, err = node.NewNode(
 		ctx,
-		cfg,
-		privVal,
+		&nodeCfg,
+		s.privVal,
 		nodeKey,
 		proxy.NewLocalClientCreator(s),
 		GetGenDocProvider(cfg),
@@ -186,6 +189,13 @@ func (s *Service[_]) Stop() error {
 	if err := s.sm.Close(); err != nil {
 		errs = append(errs, err)
 	}
+
+	if s.snapshotManager != nil {
+		s.logger.Info("Closing snapshots/metadata.db")
+		if err := s.snapshotManager.Close(); err != nil {
+			errs = append(errs, err)
+		}
+	}
 	return errors.Join(errs...)
 }
 
@@ -0,0 +1,224 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package cometbft
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/store/snapshots"
+	snapshottypes "cosmossdk.io/store/snapshots/types"
+	servercmtlog "github.com/berachain/beacon-kit/consensus/cometbft/service/log"
+	abci "github.com/cometbft/cometbft/api/cometbft/abci/v1"
+)
+
+// setSnapshot sets the store of the state sync snapshots taken from the
+// CommitMultiStore. Snapshots are disabled if snapshotStore is nil.
+func (s *Service[_]) setSnapshot(
+	snapshotStore *snapshots.Store,
+	opts snapshottypes.SnapshotOptions,
+) {
+	if snapshotStore == nil {
+		s.snapshotManager = nil
+		return
+	}
+	s.sm.CommitMultiStore().SetSnapshotInterval(opts.Interval)
+	s.snapshotManager = snapshots.NewManager(
+		snapshotStore,
+		opts,
+		s.sm.CommitMultiStore(),
+		nil,
+		servercmtlog.WrapSDKLogger(s.logger),
+	)
+}
+
+// RegisterSnapshotExtensions registers snapshotters for the data held outside
+// of the CommitMultiStore that a node restored from a snapshot needs. It is a
+// no-op if snapshots are disabled.
+func (s *Service[_]) RegisterSnapshotExtensions(
+	extensions ...snapshottypes.ExtensionSnapshotter,
+) error {
+	if s.snapshotManager == nil {
+		return nil
+	}
+	return s.snapshotManager.RegisterExtensions(extensions...)
+}
+
+// ListSnapshots implements the ABCI interface. It returns the state sync
+// snapshots available locally.
+func (s *Service[_]) ListSnapshots(
+	context.Context,
+	*abci.ListSnapshotsRequest,
+) (*abci.ListSnapshotsResponse, error) {
+	resp := &abci.ListSnapshotsResponse{Snapshots: []*abci.Snapshot{}}
+	if s.snapshotManager == nil {
+		return resp, nil
+	}
+
+	snapshotList, err := s.snapshotManager.List()
+	if err != nil {
+		s.logger.Error("Failed to list snapshots", "error", err)
+		return nil, err
+	}
+
+	for _, snapshot := range snapshotList {
+		abciSnapshot, errConv := snapshot.ToABCI()
+		if errConv != nil {
+			s.logger.Error("Failed to convert snapshot", "error", errConv)
+			return nil, errConv
+		}
+		resp.Snapshots = append(resp.Snapshots, &abciSnapshot)
+	}
+	return resp, nil
+}
+
+// LoadSnapshotChunk implements the ABCI interface. It returns a chunk of a
+// local snapshot to serve to a node state syncing.
+func (s *Service[_]) LoadSnapshotChunk(
+	_ context.Context,
+	req *abci.LoadSnapshotChunkRequest,
+) (*abci.LoadSnapshotChunkResponse, error) {
+	if s.snapshotManager == nil {
+		return &abci.LoadSnapshotChunkResponse{}, nil
+	}
+
+	chunk, err := s.snapshotManager.LoadChunk(
+		req.Height, req.Format, req.Chunk,
+	)
+	if err != nil {
+		s.logger.Error(
+			"Failed to load snapshot chunk",
+			"height", req.Height,
+			"format", req.Format,
+			"chunk", req.Chunk,
+			"error", err,
+		)
+		return nil, err
+	}
+	return &abci.LoadSnapshotChunkResponse{Chunk: chunk}, nil
+}
+
+// OfferSnapshot implements the ABCI interface. It starts restoring the
+// offered snapshot, whose chunks are then applied by ApplySnapshotChunk.
+func (s *Service[_]) OfferSnapshot(
+	_ context.Context,
+	req *abci.OfferSnapshotRequest,
+) (*abci.OfferSnapshotResponse, error) {
+	if s.snapshotManager == nil {
+		s.logger.Error("Snapshot manager not configured")
+		return &abci.OfferSnapshotResponse{
+			Result: abci.OFFER_SNAPSHOT_RESULT_ABORT,
+		}, nil
+	}
+
+	if req.Snapshot == nil {
+		s.logger.Error("Received nil snapshot")
+		return &abci.OfferSnapshotResponse{
+			Result: abci.OFFER_SNAPSHOT_RESULT_REJECT,
+		}, nil
+	}
+
+	snapshot, err := snapshottypes.SnapshotFromABCI(req.Snapshot)
+	if err != nil {
+		s.logger.Error("Failed to decode snapshot metadata", "error", err)
+		return &abci.OfferSnapshotResponse{
+			Result: abci.OFFER_SNAPSHOT_RESULT_REJECT,
+		}, nil
+	}
+
+	err = s.snapshotManager.Restore(snapshot)
+	switch {
+	case err == nil:
+		return &abci.OfferSnapshotResponse{
+			Result: abci.OFFER_SNAPSHOT_RESULT_ACCEPT,
+		}, nil
+
+	case errors.Is(err, snapshottypes.ErrUnknownFormat):
+		return &abci.OfferSnapshotResponse{
+			Result: abci.OFFER_SNAPSHOT_RESULT_REJECT_FORMAT,
+		}, nil
+
+	case errors.Is(err, snapshottypes.ErrInvalidMetadata):
+		s.logger.Error(
+			"Rejecting invalid snapshot",
+			"height", req.Snapshot.Height,
+			"format", req.Snapshot.Format,
+			"error", err,
+		)
+		return &abci.OfferSnapshotResponse{
+			Result: abci.OFFER_SNAPSHOT_RESULT_REJECT,
+		}, nil
+
+	default:
+		// The stores cannot be reset to retry a different snapshot, so we
+		// ask CometBFT to abort the state sync altogether.
+		s.logger.Error(
+			"Failed to restore snapshot",
+			"height", req.Snapshot.Height,
+			"format", req.Snapshot.Format,
+			"error", err,
+		)
+		return &abci.OfferSnapshotResponse{
+			Result: abci.OFFER_SNAPSHOT_RESULT_ABORT,
+		}, nil
+	}
+}
+
+// ApplySnapshotChunk implements the ABCI interface. It applies a chunk of the
+// snapshot accepted by OfferSnapshot.
+func (s *Service[_]) ApplySnapshotChunk(
+	_ context.Context,
+	req *abci.ApplySnapshotChunkRequest,
+) (*abci.ApplySnapshotChunkResponse, error) {
+	if s.snapshotManager == nil {
+		s.logger.Error("Snapshot manager not configured")
+		return &abci.ApplySnapshotChunkResponse{
+			Result: abci.APPLY_SNAPSHOT_CHUNK_RESULT_ABORT,
+		}, nil
+	}
+
+	_, err := s.snapshotManager.RestoreChunk(req.Chunk)
+	switch {
+	case err == nil:
+		return &abci.ApplySnapshotChunkResponse{
+			Result: abci.APPLY_SNAPSHOT_CHUNK_RESULT_ACCEPT,
+		}, nil
+
+	case errors.Is(err, snapshottypes.ErrChunkHashMismatch):
+		s.logger.Error(
+			"Chunk checksum mismatch, rejecting sender and refetching",
+			"chunk", req.Index,
+			"sender", req.Sender,
+			"error", err,
+		)
+		return &abci.ApplySnapshotChunkResponse{
+			Result:        abci.APPLY_SNAPSHOT_CHUNK_RESULT_RETRY,
+			RefetchChunks: []uint32{req.Index},
+			RejectSenders: []string{req.Sender},
+		}, nil
+
+	default:
+		s.logger.Error("Failed to restore snapshot", "error", err)
+		return &abci.ApplySnapshotChunkResponse{
+			Result: abci.APPLY_SNAPSHOT_CHUNK_RESULT_ABORT,
+		}, nil
+	}
+}
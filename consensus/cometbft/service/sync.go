@@ -0,0 +1,59 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package cometbft
+
+import (
+	"github.com/cometbft/cometbft/p2p"
+	cmttypes "github.com/cometbft/cometbft/types"
+)
+
+// peerHeightReporter is implemented by the consensus state that CometBFT
+// attaches to each of its peers.
+type peerHeightReporter interface {
+	GetHeight() int64
+}
+
+// IsSyncing returns true if the node is catching up with the network, through
+// block sync or state sync, rather than taking part in consensus.
+func (s *Service[_]) IsSyncing() bool {
+	if s.node == nil || !s.node.IsRunning() {
+		return true
+	}
+	return s.node.ConsensusReactor().WaitSync()
+}
+
+// HighestPeerHeight returns the highest committed block height reported by
+// the peers the node is connected to, or 0 if no peer has reported its height
+// yet. Peers report the height they are reaching consensus on, which is one
+// above their latest committed block.
+func (s *Service[_]) HighestPeerHeight() int64 {
+	if s.node == nil {
+		return 0
+	}
+	var highest int64
+	s.node.Switch().Peers().ForEach(func(peer p2p.Peer) {
+		ps, ok := peer.Get(cmttypes.PeerStateKey).(peerHeightReporter)
+		if ok {
+			highest = max(highest, ps.GetHeight()-1)
+		}
+	})
+	return highest
+}
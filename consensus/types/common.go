@@ -20,7 +20,14 @@
 
 package types
 
-import "github.com/berachain/beacon-kit/primitives/math"
+import (
+	"time"
+
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/transition"
+	cmtabci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
+)
 
 type commonConsensusData struct {
 	// use to verify block builder
@@ -28,6 +35,67 @@ type commonConsensusData struct {
 
 	// used to build next block and validate current payload timestamp
 	consensusTime math.U64
+
+	// double-signing evidence included in the block, used to slash validators
+	equivocations []transition.Equivocation
+
+	// participation in the previous block's commit, used to score inactivity
+	votes []transition.Vote
+}
+
+// newCommonConsensusData creates the consensus data shared by proposed and
+// finalized blocks.
+func newCommonConsensusData(
+	proposerAddress []byte,
+	consensusTime time.Time,
+	misbehavior []cmtabci.Misbehavior,
+	votes []transition.Vote,
+) *commonConsensusData {
+	equivocations := make([]transition.Equivocation, 0, len(misbehavior))
+	for _, m := range misbehavior {
+		if m.GetType() != cmtabci.MISBEHAVIOR_TYPE_DUPLICATE_VOTE {
+			continue
+		}
+		equivocations = append(equivocations, transition.Equivocation{
+			Address: m.GetValidator().Address,
+			Height:  m.GetHeight(),
+		})
+	}
+	return &commonConsensusData{
+		proposerAddress: proposerAddress,
+		consensusTime:   math.U64(consensusTime.Unix()),
+		equivocations:   equivocations,
+		votes:           votes,
+	}
+}
+
+// votesFromCommitInfo converts the last commit info of a block into the
+// per-validator participation used by the state transition.
+func votesFromCommitInfo(commit cmtabci.CommitInfo) []transition.Vote {
+	votes := make([]transition.Vote, 0, len(commit.GetVotes()))
+	for _, v := range commit.GetVotes() {
+		votes = append(votes, transition.Vote{
+			Address: v.GetValidator().Address,
+			Missed:  v.GetBlockIdFlag() == cmtproto.BlockIDFlagAbsent,
+		})
+	}
+	return votes
+}
+
+// votesFromExtendedCommitInfo converts the extended last commit info available
+// to the proposer into the per-validator participation used by the state
+// transition.
+func votesFromExtendedCommitInfo(
+	commit cmtabci.ExtendedCommitInfo,
+) []transition.Vote {
+	votes := make([]transition.Vote, 0, len(commit.GetVotes()))
+	for _, v := range commit.GetVotes() {
+		votes = append(votes, transition.Vote{
+			Address: v.GetValidator().Address,
+			Missed:  v.GetBlockIdFlag() == cmtproto.BlockIDFlagAbsent,
+		})
+	}
+	return votes
 }
 
 // GetProposerAddress returns the address of the validator
@@ -41,3 +109,15 @@ func (c *commonConsensusData) GetProposerAddress() []byte {
 func (c *commonConsensusData) GetConsensusTime() math.U64 {
 	return c.consensusTime
 }
+
+// GetEquivocations returns the double-signing evidence included by consensus
+// in the block.
+func (c *commonConsensusData) GetEquivocations() []transition.Equivocation {
+	return c.equivocations
+}
+
+// GetVotes returns the participation of validators in the commit of the
+// previous block.
+func (c *commonConsensusData) GetVotes() []transition.Vote {
+	return c.votes
+}
@@ -24,7 +24,7 @@ import (
 	"time"
 
 	"github.com/berachain/beacon-kit/consensus-types/types"
-	"github.com/berachain/beacon-kit/primitives/math"
+	cmtabci "github.com/cometbft/cometbft/abci/types"
 )
 
 type ConsensusBlock struct {
@@ -39,13 +39,15 @@ func (b *ConsensusBlock) New(
 	beaconBlock *types.BeaconBlock,
 	proposerAddress []byte,
 	consensusTime time.Time,
+	misbehavior []cmtabci.Misbehavior,
+	lastCommit cmtabci.CommitInfo,
 ) *ConsensusBlock {
 	b = &ConsensusBlock{
 		blk: beaconBlock,
-		commonConsensusData: &commonConsensusData{
-			proposerAddress: proposerAddress,
-			consensusTime:   math.U64(consensusTime.Unix()),
-		},
+		commonConsensusData: newCommonConsensusData(
+			proposerAddress, consensusTime, misbehavior,
+			votesFromCommitInfo(lastCommit),
+		),
 	}
 	return b
 }
@@ -25,6 +25,7 @@ import (
 
 	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
 	"github.com/berachain/beacon-kit/primitives/math"
+	cmtabci "github.com/cometbft/cometbft/abci/types"
 )
 
 // SlotData represents the data to be used to propose a block.
@@ -47,15 +48,17 @@ func NewSlotData(
 	slashingInfo []*ctypes.SlashingInfo,
 	proposerAddress []byte,
 	consensusTime time.Time,
+	misbehavior []cmtabci.Misbehavior,
+	lastCommit cmtabci.ExtendedCommitInfo,
 ) *SlotData {
 	return &SlotData{
 		slot:            slot,
 		attestationData: attestationData,
 		slashingInfo:    slashingInfo,
-		commonConsensusData: &commonConsensusData{
-			proposerAddress: proposerAddress,
-			consensusTime:   math.U64(consensusTime.Unix()),
-		},
+		commonConsensusData: newCommonConsensusData(
+			proposerAddress, consensusTime, misbehavior,
+			votesFromExtendedCommitInfo(lastCommit),
+		),
 	}
 }
 
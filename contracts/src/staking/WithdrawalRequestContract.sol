@@ -0,0 +1,126 @@
+// SPDX-License-Identifier: MIT
+pragma solidity 0.8.26;
+
+/**
+ * @title WithdrawalRequestContract
+ * @author Berachain Team
+ * @notice A contract that lets the withdrawal address of a validator request its exit, a partial withdrawal, or a
+ * change of its withdrawal address, in the style of EIP-7002.
+ * @dev Its events are read by the beacon chain, which includes the requests in blocks by index. Withdrawals are
+ * paid out to the withdrawal address through the withdrawal sweep.
+ * @dev The beacon chain only honours requests sent from the execution address in the validator withdrawal
+ * credentials.
+ */
+contract WithdrawalRequestContract {
+    /*´:°•.°+.*•´.*:˚.°*.˚•´.°:°•.°•.*•´.*:˚.°*.˚•´.°:°•.°+.*•´.*:*/
+    /*                        CONSTANTS                           */
+    /*.•°:°.´+˚.*°.˚:*.´•*.+°.•°:´*.´•*.•°.•°:°.´:•˚°.*°.˚:*.´+°.•*/
+
+    /// @dev The amount, in Gwei, signalling a request to fully exit the validator.
+    uint64 public constant FULL_EXIT_REQUEST_AMOUNT = 0;
+
+    /// @dev The maximum number of requests per block, matching the beacon chain
+    /// `max-withdrawal-requests-per-block` value.
+    uint256 public constant MAX_REQUESTS_PER_BLOCK = 16;
+
+    /// @dev The length of the public key, PUBLIC_KEY_LENGTH bytes.
+    uint8 internal constant PUBLIC_KEY_LENGTH = 48;
+
+    /*´:°•.°+.*•´.*:˚.°*.˚•´.°:°•.°•.*•´.*:˚.°*.˚•´.°:°•.°+.*•´.*:*/
+    /*                           STORAGE                          */
+    /*.•°:°.´+˚.*°.˚:*.´•*.+°.•°:´*.´•*.•°.•°:°.´:•˚°.*°.˚:*.´+°.•*/
+
+    /// @dev requestCount represents the number of requests that have been made to the contract.
+    /// @dev The index of the next request will use this value.
+    uint64 public requestCount;
+
+    /// @dev The block of the latest request.
+    uint256 private _lastRequestBlock;
+
+    /// @dev The number of requests made in `_lastRequestBlock`.
+    uint256 private _requestsInBlock;
+
+    /*´:°•.°+.*•´.*:˚.°*.˚•´.°:°•.°•.*•´.*:˚.°*.˚•´.°:°•.°+.*•´.*:*/
+    /*                           ERRORS                           */
+    /*.•°:°.´+˚.*°.˚:*.´•*.+°.•°:´*.´•*.•°.•°:°.´:•˚°.*°.˚:*.´+°.•*/
+
+    /// @dev Error thrown when the public key length is not 48 bytes.
+    error InvalidPubKeyLength();
+
+    /// @dev Error thrown when the block already holds MAX_REQUESTS_PER_BLOCK requests.
+    error TooManyRequests();
+
+    /// @dev Error thrown when the new withdrawal address is the zero address.
+    error ZeroAddress();
+
+    /*´:°•.°+.*•´.*:˚.°*.˚•´.°:°•.°•.*•´.*:˚.°*.˚•´.°:°•.°+.*•´.*:*/
+    /*                           EVENTS                           */
+    /*.•°:°.´+˚.*°.˚:*.´•*.+°.•°:´*.´•*.•°.•°:°.´:•˚°.*°.˚:*.´+°.•*/
+
+    /**
+     * @dev Emitted when a withdrawal request is made.
+     * @param sourceAddress the address that made the request.
+     * @param pubkey the public key of the validator to withdraw from.
+     * @param amount the amount to withdraw in Gwei, FULL_EXIT_REQUEST_AMOUNT to exit the validator.
+     * @param index the index of the request.
+     */
+    event WithdrawalRequest(address indexed sourceAddress, bytes pubkey, uint64 amount, uint64 index);
+
+    /**
+     * @dev Emitted when a withdrawal credentials change is requested.
+     * @param sourceAddress the address that made the request.
+     * @param pubkey the public key of the validator to change the withdrawal credentials of.
+     * @param newWithdrawalAddress the address the validator withdraws to after the change.
+     * @param index the index of the request.
+     */
+    event CredentialsChangeRequest(
+        address indexed sourceAddress, bytes pubkey, address newWithdrawalAddress, uint64 index
+    );
+
+    /*´:°•.°+.*•´.*:˚.°*.˚•´.°:°•.°•.*•´.*:˚.°*.˚•´.°:°•.°+.*•´.*:*/
+    /*                            WRITES                          */
+    /*.•°:°.´+˚.*°.˚:*.´•*.+°.•°:´*.´•*.•°.•°:°.´:•˚°.*°.˚:*.´+°.•*/
+
+    /**
+     * @notice Request a withdrawal from a validator.
+     * @param pubkey is the consensus public key of the validator.
+     * @param amount is the amount to withdraw in Gwei, FULL_EXIT_REQUEST_AMOUNT to exit the validator.
+     * @dev emits the WithdrawalRequest event upon success.
+     */
+    function requestWithdrawal(bytes calldata pubkey, uint64 amount) external {
+        if (pubkey.length != PUBLIC_KEY_LENGTH) revert InvalidPubKeyLength();
+        _countRequest();
+
+        emit WithdrawalRequest(msg.sender, pubkey, amount, requestCount++);
+    }
+
+    /**
+     * @notice Request a change of the withdrawal address of a validator.
+     * @param pubkey is the consensus public key of the validator.
+     * @param newWithdrawalAddress is the address the validator withdraws to after the change.
+     * @dev emits the CredentialsChangeRequest event upon success.
+     */
+    function requestCredentialsChange(bytes calldata pubkey, address newWithdrawalAddress) external {
+        if (pubkey.length != PUBLIC_KEY_LENGTH) revert InvalidPubKeyLength();
+        if (newWithdrawalAddress == address(0)) revert ZeroAddress();
+        _countRequest();
+
+        emit CredentialsChangeRequest(msg.sender, pubkey, newWithdrawalAddress, requestCount++);
+    }
+
+    /*´:°•.°+.*•´.*:˚.°*.˚•´.°:°•.°•.*•´.*:˚.°*.˚•´.°:°•.°+.*•´.*:*/
+    /*                          INTERNAL                          */
+    /*.•°:°.´+˚.*°.˚:*.´•*.+°.•°:´*.´•*.•°.•°:°.´:•˚°.*°.˚:*.´+°.•*/
+
+    /// @dev Counts a request against the per block cap, shared by every kind of request.
+    function _countRequest() internal {
+        if (block.number != _lastRequestBlock) {
+            _lastRequestBlock = block.number;
+            _requestsInBlock = 0;
+        }
+        if (_requestsInBlock >= MAX_REQUESTS_PER_BLOCK) revert TooManyRequests();
+        unchecked {
+            ++_requestsInBlock;
+        }
+    }
+}
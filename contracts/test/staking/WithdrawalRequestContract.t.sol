@@ -0,0 +1,83 @@
+// SPDX-License-Identifier: MIT
+pragma solidity ^0.8.25;
+
+import "forge-std/Test.sol";
+
+import { SoladyTest } from "@solady/test/utils/SoladyTest.sol";
+import { WithdrawalRequestContract } from "@src/staking/WithdrawalRequestContract.sol";
+
+contract WithdrawalRequestContractTest is SoladyTest, StdCheats {
+    /// @dev The withdrawal address of the validator.
+    address internal requester = 0x20f33CE90A13a4b5E7697E3544c3083B8F8A51D4;
+
+    /// @dev The validator public key.
+    bytes internal VALIDATOR_PUBKEY = _create48Byte();
+
+    /// @dev the withdrawal request contract.
+    WithdrawalRequestContract internal withdrawalRequestContract;
+
+    event WithdrawalRequest(address indexed sourceAddress, bytes pubkey, uint64 amount, uint64 index);
+    event CredentialsChangeRequest(
+        address indexed sourceAddress, bytes pubkey, address newWithdrawalAddress, uint64 index
+    );
+
+    function setUp() public virtual {
+        withdrawalRequestContract = new WithdrawalRequestContract();
+    }
+
+    function testFuzz_RequestWrongPubKey(bytes calldata pubKey) public {
+        vm.assume(pubKey.length != 48);
+        vm.expectRevert(WithdrawalRequestContract.InvalidPubKeyLength.selector);
+        vm.prank(requester);
+        withdrawalRequestContract.requestWithdrawal(pubKey, 0);
+    }
+
+    function test_RequestFullExit() public {
+        vm.expectEmit(true, false, false, true, address(withdrawalRequestContract));
+        emit WithdrawalRequest(requester, VALIDATOR_PUBKEY, 0, 0);
+        vm.prank(requester);
+        withdrawalRequestContract.requestWithdrawal(VALIDATOR_PUBKEY, 0);
+        assertEq(withdrawalRequestContract.requestCount(), 1);
+    }
+
+    function test_RequestCredentialsChange() public {
+        address newWithdrawalAddress = address(0x42);
+        vm.startPrank(requester);
+        withdrawalRequestContract.requestWithdrawal(VALIDATOR_PUBKEY, 1 gwei);
+
+        // Credentials changes share the request index with withdrawals.
+        vm.expectEmit(true, false, false, true, address(withdrawalRequestContract));
+        emit CredentialsChangeRequest(requester, VALIDATOR_PUBKEY, newWithdrawalAddress, 1);
+        withdrawalRequestContract.requestCredentialsChange(VALIDATOR_PUBKEY, newWithdrawalAddress);
+        vm.stopPrank();
+        assertEq(withdrawalRequestContract.requestCount(), 2);
+    }
+
+    function test_RequestCredentialsChangeZeroAddress() public {
+        vm.expectRevert(WithdrawalRequestContract.ZeroAddress.selector);
+        vm.prank(requester);
+        withdrawalRequestContract.requestCredentialsChange(VALIDATOR_PUBKEY, address(0));
+    }
+
+    function test_RequestsPerBlockCapped() public {
+        uint256 maxRequests = withdrawalRequestContract.MAX_REQUESTS_PER_BLOCK();
+        vm.startPrank(requester);
+        for (uint256 i; i < maxRequests; ++i) {
+            withdrawalRequestContract.requestWithdrawal(VALIDATOR_PUBKEY, 0);
+        }
+        vm.expectRevert(WithdrawalRequestContract.TooManyRequests.selector);
+        withdrawalRequestContract.requestWithdrawal(VALIDATOR_PUBKEY, 0);
+        vm.expectRevert(WithdrawalRequestContract.TooManyRequests.selector);
+        withdrawalRequestContract.requestCredentialsChange(VALIDATOR_PUBKEY, requester);
+
+        // The cap resets in the next block.
+        vm.roll(block.number + 1);
+        withdrawalRequestContract.requestWithdrawal(VALIDATOR_PUBKEY, 0);
+        vm.stopPrank();
+        assertEq(withdrawalRequestContract.requestCount(), maxRequests + 1);
+    }
+
+    function _create48Byte() internal pure returns (bytes memory) {
+        return abi.encodePacked(bytes32("32"), bytes16("16"));
+    }
+}
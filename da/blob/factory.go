@@ -51,12 +51,12 @@ func NewSidecarFactory(
 	}
 }
 
-// BuildSidecars builds a sidecar.
+// BuildSidecars builds a sidecar, carrying the header of the block along with
+// the given proposer signature of the block.
 func (f *SidecarFactory) BuildSidecars(
 	blk *ctypes.BeaconBlock,
 	bundle ctypes.BlobsBundle,
-	signer crypto.BLSSigner,
-	forkData *ctypes.ForkData,
+	signature crypto.BLSSignature,
 ) (types.BlobSidecars, error) {
 	var (
 		blobs       = bundle.GetBlobs()
@@ -75,23 +75,10 @@ func (f *SidecarFactory) BuildSidecars(
 		startTime, math.U64(numBlobs),
 	)
 
-	// Contrary to the spec, we do not need to sign the full
-	// block, because the header embeds the body's hash tree root
-	// already. We just need a bond between the block signer (already
-	// tied to CometBFT's ProposerAddress) and the sidecars.
-
-	//nolint:errcheck // should be safe
-	domain := any(forkData).(*ctypes.ForkData).ComputeDomain(
-		f.chainSpec.DomainTypeProposer(),
-	)
-	signingRoot := ctypes.ComputeSigningRoot(
-		header,
-		domain,
-	)
-	signature, err := signer.Sign(signingRoot[:])
-	if err != nil {
-		return nil, err
-	}
+	// The signature of the block is the one of its header, since the header
+	// embeds the body's hash tree root already. It provides the bond between
+	// the block signer (already tied to CometBFT's ProposerAddress) and the
+	// sidecars.
 	sigHeader := ctypes.NewSignedBeaconBlockHeader(header, signature)
 
 	// Calculate offsets
@@ -34,4 +34,14 @@ var (
 	ErrAttemptedToVerifyNilSidecars = errors.New(
 		"attempted to verify nil sidecars",
 	)
+
+	// ErrBlobSidecarNotFound is returned when no sidecar has been stored for
+	// a commitment at the requested slot.
+	ErrBlobSidecarNotFound = errors.New("blob sidecar not found")
+
+	// ErrBlobSidecarsPruned is returned when the requested slot is outside
+	// the data availability period, hence its sidecars have been pruned.
+	ErrBlobSidecarsPruned = errors.New(
+		"blob sidecars pruned, slot is outside the data availability period",
+	)
 )
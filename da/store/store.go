@@ -27,6 +27,7 @@ import (
 	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
 	"github.com/berachain/beacon-kit/da/types"
 	"github.com/berachain/beacon-kit/log"
+	"github.com/berachain/beacon-kit/primitives/eip4844"
 	"github.com/berachain/beacon-kit/primitives/math"
 )
 
@@ -70,6 +71,27 @@ func (s *Store) IsDataAvailable(
 	return true
 }
 
+// GetBlobSidecar returns the sidecar stored at the given slot for the given
+// commitment.
+func (s *Store) GetBlobSidecar(
+	slot math.Slot,
+	commitment eip4844.KZGCommitment,
+) (*types.BlobSidecar, error) {
+	ok, err := s.IndexDB.Has(slot.Unwrap(), commitment[:])
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrBlobSidecarNotFound
+	}
+	bz, err := s.IndexDB.Get(slot.Unwrap(), commitment[:])
+	if err != nil {
+		return nil, err
+	}
+	sidecar := &types.BlobSidecar{}
+	return sidecar, sidecar.UnmarshalSSZ(bz)
+}
+
 // Persist ensures the sidecar data remains accessible, utilizing parallel
 // processing for efficiency.
 func (s *Store) Persist(
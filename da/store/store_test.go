@@ -29,6 +29,8 @@ import (
 	"github.com/berachain/beacon-kit/consensus-types/types"
 	"github.com/berachain/beacon-kit/da/store"
 	datypes "github.com/berachain/beacon-kit/da/types"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/eip4844"
 	"github.com/berachain/beacon-kit/storage/filedb"
 	"github.com/stretchr/testify/require"
 )
@@ -81,3 +83,43 @@ func TestStore_PersistRace(t *testing.T) {
 	err = s.Persist(0, sidecars)
 	require.NoError(t, err)
 }
+
+func TestStore_GetBlobSidecar(t *testing.T) {
+	logger := log.NewNopLogger()
+	chainSpec, err := spec.DevnetChainSpec()
+	require.NoError(t, err)
+
+	s := store.New(
+		filedb.NewRangeDB(
+			filedb.NewDB(filedb.WithRootDirectory(t.TempDir()),
+				filedb.WithFileExtension("ssz"),
+				filedb.WithDirectoryPermissions(0700),
+				filedb.WithLogger(logger),
+			),
+		),
+		logger.With("service", "da-store"),
+		chainSpec,
+	)
+
+	sidecars := make(datypes.BlobSidecars, 2)
+	for i := range sidecars {
+		sidecars[i] = &datypes.BlobSidecar{
+			Index:         uint64(i),
+			KzgCommitment: eip4844.KZGCommitment{byte(i + 1)},
+			SignedBeaconBlockHeader: &types.SignedBeaconBlockHeader{
+				Header: &types.BeaconBlockHeader{Slot: 1},
+			},
+			InclusionProof: make([]common.Root, 8),
+		}
+	}
+	require.NoError(t, s.Persist(1, sidecars))
+
+	for _, expected := range sidecars {
+		sidecar, err := s.GetBlobSidecar(1, expected.KzgCommitment)
+		require.NoError(t, err)
+		require.Equal(t, expected, sidecar)
+	}
+
+	_, err = s.GetBlobSidecar(2, sidecars[0].KzgCommitment)
+	require.ErrorIs(t, err, store.ErrBlobSidecarNotFound)
+}
@@ -27,17 +27,20 @@ import (
 	"sync"
 	"time"
 
+	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
 	"github.com/berachain/beacon-kit/errors"
 	ethclient "github.com/berachain/beacon-kit/execution/client/ethclient"
-	ethclientrpc "github.com/berachain/beacon-kit/execution/client/ethclient/rpc"
 	"github.com/berachain/beacon-kit/log"
 	"github.com/berachain/beacon-kit/primitives/math"
 	"github.com/berachain/beacon-kit/primitives/net/jwt"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/core/types"
 )
 
-// EngineClient is a struct that holds a pointer to an Eth1Client.
+// EngineClient is a client to one or more execution client endpoints. Calls
+// are served by the active endpoint, and fail over to the next healthy
+// endpoint if it becomes unavailable.
 type EngineClient struct {
-	*ethclient.Client
 	// cfg is the supplied configuration for the engine client.
 	cfg *Config
 	// logger is the logger for the engine client.
@@ -46,17 +49,32 @@ type EngineClient struct {
 	eth1ChainID *big.Int
 	// clientMetrics is the metrics for the engine client.
 	metrics *clientMetrics
-	// capabilities is a map of capabilities that the execution client has.
-	capabilities map[string]struct{}
+	// endpoints are the execution client endpoints in order of preference.
+	endpoints []*endpoint
+
+	// activeMu protects active and lastForkchoice.
+	activeMu sync.RWMutex
+	// active is the index of the endpoint serving calls.
+	active int
+	// lastForkchoice is the last forkchoice state accepted by the active
+	// endpoint, used to resync an endpoint when it becomes active.
+	lastForkchoice *forkchoiceUpdate
+
 	// connected will be set to true when we have successfully connected
 	// to the execution client.
 	connectedMu sync.RWMutex
 	connected   bool
 }
 
+// forkchoiceUpdate is a forkchoice state along with its fork version.
+type forkchoiceUpdate struct {
+	state       *engineprimitives.ForkchoiceStateV1
+	forkVersion uint32
+}
+
 // New creates a new engine client EngineClient.
-// It takes an Eth1Client as an argument and returns a pointer  to an
-// EngineClient.
+// It dials the configured RPCDialURL, followed by the fallback dial urls, and
+// returns a pointer to an EngineClient.
 func New(
 	cfg *Config,
 	logger log.Logger,
@@ -64,21 +82,18 @@ func New(
 	telemetrySink TelemetrySink,
 	eth1ChainID *big.Int,
 ) *EngineClient {
+	endpoints := make([]*endpoint, 0, 1+len(cfg.RPCFallbackDialURLs))
+	endpoints = append(endpoints, newEndpoint(cfg.RPCDialURL, cfg, jwtSecret))
+	for _, dialURL := range cfg.RPCFallbackDialURLs {
+		endpoints = append(endpoints, newEndpoint(dialURL, cfg, jwtSecret))
+	}
 	return &EngineClient{
-		cfg:    cfg,
-		logger: logger,
-		Client: ethclient.New(
-			ethclientrpc.NewClient(
-				cfg.RPCDialURL.String(),
-				ethclientrpc.WithJWTSecret(jwtSecret),
-				ethclientrpc.WithJWTRefreshInterval(
-					cfg.RPCJWTRefreshInterval,
-				),
-			)),
-		capabilities: make(map[string]struct{}),
-		eth1ChainID:  eth1ChainID,
-		metrics:      newClientMetrics(telemetrySink, logger),
-		connected:    false,
+		cfg:         cfg,
+		logger:      logger,
+		endpoints:   endpoints,
+		eth1ChainID: eth1ChainID,
+		metrics:     newClientMetrics(telemetrySink, logger),
+		connected:   false,
 	}
 }
 
@@ -91,17 +106,19 @@ func (s *EngineClient) Name() string {
 func (s *EngineClient) Start(
 	ctx context.Context,
 ) error {
-	// Start the Client.
-	go s.Client.Start(ctx)
+	// Start the Clients.
+	for _, ep := range s.endpoints {
+		go ep.Start(ctx)
+	}
 
 	s.logger.Info(
 		"Initializing connection to the execution client...",
-		"dial_url", s.cfg.RPCDialURL.String(),
+		"dial_urls", s.endpointURLs(),
 	)
 
 	// If the connection connection succeeds, we can skip the
 	// connection initialization loop.
-	if err := s.verifyChainIDAndConnection(ctx); err == nil {
+	if s.initializeEndpoints(ctx) {
 		return nil
 	}
 
@@ -115,18 +132,11 @@ func (s *EngineClient) Start(
 		case <-ticker.C:
 			s.logger.Info(
 				"Waiting for execution client to start... 🍺🕔",
-				"dial_url", s.cfg.RPCDialURL,
+				"dial_urls", s.endpointURLs(),
 			)
-			if err := s.verifyChainIDAndConnection(ctx); err != nil {
-				if errors.Is(err, ErrMismatchedEth1ChainID) {
-					s.logger.Error(err.Error())
-				}
-				continue
+			if s.initializeEndpoints(ctx) {
+				return nil
 			}
-			s.connectedMu.Lock()
-			s.connected = true
-			s.connectedMu.Unlock()
-			return nil
 		}
 	}
 }
@@ -141,75 +151,136 @@ func (s *EngineClient) IsConnected() bool {
 	return s.connected
 }
 
+// HasCapability returns true if the active endpoint supports the given
+// engine API method.
 func (s *EngineClient) HasCapability(capability string) bool {
-	_, ok := s.capabilities[capability]
-	return ok
+	return s.activeEndpoint().hasCapability(capability)
+}
+
+// ActiveEndpoint returns the url of the endpoint serving calls, with any
+// credentials redacted.
+func (s *EngineClient) ActiveEndpoint() string {
+	return s.activeEndpoint().String()
+}
+
+// GetClientVersionV1 calls the engine_getClientVersionV1 method of the
+// active endpoint.
+func (s *EngineClient) GetClientVersionV1(
+	ctx context.Context,
+) ([]engineprimitives.ClientVersionV1, error) {
+	return s.activeEndpoint().GetClientVersionV1(ctx)
+}
+
+// BlockNumber returns the number of the most recent block of the active
+// endpoint.
+func (s *EngineClient) BlockNumber(ctx context.Context) (math.U64, error) {
+	return s.activeEndpoint().BlockNumber(ctx)
+}
+
+// FilterLogs executes a filter query against the active endpoint.
+func (s *EngineClient) FilterLogs(
+	ctx context.Context,
+	q ethereum.FilterQuery,
+) ([]types.Log, error) {
+	return s.activeEndpoint().FilterLogs(ctx, q)
+}
+
+// SubscribeFilterLogs subscribes to the results of a filter query on the
+// active endpoint.
+func (s *EngineClient) SubscribeFilterLogs(
+	ctx context.Context,
+	q ethereum.FilterQuery,
+	ch chan<- types.Log,
+) (ethereum.Subscription, error) {
+	return s.activeEndpoint().SubscribeFilterLogs(ctx, q, ch)
 }
 
 /* -------------------------------------------------------------------------- */
 /*                                   Helpers                                  */
 /* -------------------------------------------------------------------------- */
 
-// verifyChainID dials the execution client and
-// ensures the chain ID is correct.
+// initializeEndpoints health checks every endpoint and activates the
+// preferred healthy one. It returns false if no endpoint is healthy.
+func (s *EngineClient) initializeEndpoints(ctx context.Context) bool {
+	s.checkEndpoints(ctx)
+	for i, ep := range s.endpoints {
+		if !ep.isHealthy() {
+			continue
+		}
+		s.setActive(i)
+		s.logger.Info(
+			"Connected to execution client 🔌",
+			"dial_url", ep.String(),
+			"required_chain_id", s.eth1ChainID,
+		)
+		s.logCapabilities(ep)
+		s.connectedMu.Lock()
+		s.connected = true
+		s.connectedMu.Unlock()
+		if len(s.endpoints) > 1 {
+			go s.monitorEndpoints(ctx)
+			for _, standby := range s.endpoints {
+				go s.resyncStandby(ctx, standby)
+			}
+		}
+		return true
+	}
+	return false
+}
+
+// verifyChainIDAndConnection dials the endpoint, ensures the chain ID is
+// correct and exchanges capabilities with it.
 func (s *EngineClient) verifyChainIDAndConnection(
 	ctx context.Context,
+	ep *endpoint,
 ) error {
-	var (
-		err     error
-		chainID math.U64
-	)
-
-	defer func() {
-		if err != nil {
-			err = s.Client.Close()
-		}
-	}()
-
 	// After the initial dial, check to make sure the chain ID is correct.
-	chainID, err = s.Client.ChainID(ctx)
+	err := ep.verifyChainID(ctx, s.eth1ChainID)
 	if err != nil {
 		if strings.Contains(err.Error(), "401 Unauthorized") {
 			// We always log this error as it is a critical error.
 			s.logger.Error(UnauthenticatedConnectionErrorStr)
 		}
+		if errors.Is(err, ErrMismatchedEth1ChainID) {
+			s.logger.Error(err.Error(), "dial_url", ep.String())
+		}
 		return err
 	}
 
-	// TODO: consider validating once when config is set or
-	// client is initialized
-	if !s.eth1ChainID.IsUint64() {
-		err = errors.Wrapf(
-			errors.New("provided chain ID is not uint64"),
-			s.eth1ChainID.String(),
-		)
-		return err
-	}
-	if chainID.Unwrap() != s.eth1ChainID.Uint64() {
-		err = errors.Wrapf(
-			ErrMismatchedEth1ChainID,
-			"wanted chain ID %d, got %d",
-			s.eth1ChainID,
-			chainID,
+	// Exchange capabilities with the execution client.
+	capabilities, err := ep.ExchangeCapabilities(
+		ctx, ethclient.BeaconKitSupportedCapabilities(),
+	)
+	if err != nil {
+		s.logger.Error(
+			"failed to exchange capabilities",
+			"dial_url", ep.String(), "err", err,
 		)
 		return err
 	}
+	ep.setCapabilities(capabilities)
+	return nil
+}
 
-	// Log the chain ID.
-	s.logger.Info(
-		"Connected to execution client 🔌",
-		"dial_url",
-		s.cfg.RPCDialURL.String(),
-		"chain_id",
-		chainID.Unwrap(),
-		"required_chain_id",
-		s.eth1ChainID,
-	)
+// logCapabilities warns about the capabilities that the endpoint does not
+// have.
+func (s *EngineClient) logCapabilities(ep *endpoint) {
+	for _, capability := range ethclient.BeaconKitSupportedCapabilities() {
+		if !ep.hasCapability(capability) {
+			s.logger.Warn(
+				"Your execution client may require an update 🚸",
+				"dial_url", ep.String(),
+				"unsupported_capability", capability,
+			)
+		}
+	}
+}
 
-	// Exchange capabilities with the execution client.
-	if _, err = s.ExchangeCapabilities(ctx); err != nil {
-		s.logger.Error("failed to exchange capabilities", "err", err)
-		return err
+// endpointURLs returns the redacted urls of all endpoints.
+func (s *EngineClient) endpointURLs() []string {
+	urls := make([]string, len(s.endpoints))
+	for i, ep := range s.endpoints {
+		urls[i] = ep.String()
 	}
-	return nil
+	return urls
 }
@@ -32,6 +32,7 @@ const (
 	defaultRPCTimeout              = 2 * time.Second
 	defaultRPCStartupCheckInterval = 3 * time.Second
 	defaultRPCJWTRefreshInterval   = 20 * time.Second
+	defaultRPCHealthCheckInterval  = 5 * time.Second
 	//#nosec:G101 // false positive.
 	defaultJWTSecretPath = "./jwt.hex"
 )
@@ -42,18 +43,24 @@ func DefaultConfig() Config {
 	dialURL, _ := url.NewFromRaw(defaultDialURL)
 	return Config{
 		RPCDialURL:              dialURL,
+		RPCFallbackDialURLs:     []*url.ConnectionURL{},
 		RPCRetries:              defaultRPCRetries,
 		RPCTimeout:              defaultRPCTimeout,
 		RPCStartupCheckInterval: defaultRPCStartupCheckInterval,
 		RPCJWTRefreshInterval:   defaultRPCJWTRefreshInterval,
+		RPCHealthCheckInterval:  defaultRPCHealthCheckInterval,
 		JWTSecretPath:           defaultJWTSecretPath,
 	}
 }
 
 // Config is the configuration struct for the execution client.
 type Config struct {
-	// RPCDialURL is the HTTP url of the execution client JSON-RPC endpoint.
+	// RPCDialURL is the url of the execution client JSON-RPC endpoint. Its
+	// scheme, http(s), ws(s) or ipc, selects the transport.
 	RPCDialURL *url.ConnectionURL `mapstructure:"rpc-dial-url"`
+	// RPCFallbackDialURLs are the urls of standby execution client endpoints,
+	// in order of preference, that are used when RPCDialURL is unavailable.
+	RPCFallbackDialURLs []*url.ConnectionURL `mapstructure:"rpc-fallback-dial-urls"`
 	// RPCRetries is the number of retries before shutting down consensus
 	// client.
 	RPCRetries uint64 `mapstructure:"rpc-retries"`
@@ -63,6 +70,9 @@ type Config struct {
 	RPCStartupCheckInterval time.Duration `mapstructure:"rpc-startup-check-interval"`
 	// JWTRefreshInterval is the Interval for the JWT refresh.
 	RPCJWTRefreshInterval time.Duration `mapstructure:"rpc-jwt-refresh-interval"`
+	// RPCHealthCheckInterval is the interval at which the execution client
+	// endpoints are health checked when fallback endpoints are configured.
+	RPCHealthCheckInterval time.Duration `mapstructure:"rpc-health-check-interval"`
 	// JWTSecretPath is the path to the JWT secret.
 	JWTSecretPath string `mapstructure:"jwt-secret-path"`
 }
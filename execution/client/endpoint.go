@@ -0,0 +1,166 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package client
+
+import (
+	"context"
+	"math/big"
+	"sync"
+
+	"github.com/berachain/beacon-kit/errors"
+	ethclient "github.com/berachain/beacon-kit/execution/client/ethclient"
+	ethclientrpc "github.com/berachain/beacon-kit/execution/client/ethclient/rpc"
+	"github.com/berachain/beacon-kit/primitives/net/jwt"
+	"github.com/berachain/beacon-kit/primitives/net/url"
+)
+
+// endpoint is a single execution client JSON-RPC endpoint along with its
+// last known health.
+type endpoint struct {
+	*ethclient.Client
+	// url is the dial url of the endpoint.
+	url *url.ConnectionURL
+
+	// mu protects the fields below.
+	mu sync.RWMutex
+	// healthy is true if the endpoint passed its last health check.
+	healthy bool
+	// synced is true if the endpoint reported it was not syncing during its
+	// last health check.
+	synced bool
+	// capabilities are the engine API methods supported by the endpoint.
+	capabilities map[string]struct{}
+
+	// resyncs holds the latest forkchoice state to mirror to the endpoint
+	// while it is on standby. Older states still queued are replaced, so
+	// that at most one resync is in flight per endpoint.
+	resyncs chan *forkchoiceUpdate
+}
+
+// newEndpoint creates a new endpoint dialing the given url.
+func newEndpoint(
+	dialURL *url.ConnectionURL,
+	cfg *Config,
+	jwtSecret *jwt.Secret,
+) *endpoint {
+	return &endpoint{
+		Client: ethclient.New(
+			ethclientrpc.NewClient(
+				dialURL.String(),
+				ethclientrpc.WithJWTSecret(jwtSecret),
+				ethclientrpc.WithJWTRefreshInterval(
+					cfg.RPCJWTRefreshInterval,
+				),
+			)),
+		url:          dialURL,
+		capabilities: make(map[string]struct{}),
+		resyncs:      make(chan *forkchoiceUpdate, 1),
+	}
+}
+
+// queueResync queues the forkchoice state for the endpoint, replacing the
+// one queued before if it was not sent yet.
+func (e *endpoint) queueResync(fcu *forkchoiceUpdate) {
+	for {
+		select {
+		case e.resyncs <- fcu:
+			return
+		default:
+		}
+		select {
+		case <-e.resyncs:
+		default:
+		}
+	}
+}
+
+// String returns the url of the endpoint with any credentials redacted.
+func (e *endpoint) String() string {
+	return e.url.Redacted()
+}
+
+// isHealthy returns true if the endpoint passed its last health check.
+func (e *endpoint) isHealthy() bool {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	return e.healthy
+}
+
+// isSynced returns true if the endpoint was healthy and synced during its
+// last health check.
+func (e *endpoint) isSynced() bool {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	return e.healthy && e.synced
+}
+
+// setHealth records the result of a health check.
+func (e *endpoint) setHealth(healthy, synced bool) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.healthy = healthy
+	e.synced = synced
+}
+
+// hasCapability returns true if the endpoint supports the given engine API
+// method.
+func (e *endpoint) hasCapability(capability string) bool {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	_, ok := e.capabilities[capability]
+	return ok
+}
+
+// setCapabilities records the engine API methods supported by the endpoint.
+func (e *endpoint) setCapabilities(capabilities []string) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.capabilities = make(map[string]struct{}, len(capabilities))
+	for _, capability := range capabilities {
+		e.capabilities[capability] = struct{}{}
+	}
+}
+
+// verifyChainID checks that the endpoint serves the expected chain.
+func (e *endpoint) verifyChainID(
+	ctx context.Context,
+	eth1ChainID *big.Int,
+) error {
+	chainID, err := e.ChainID(ctx)
+	if err != nil {
+		return err
+	}
+	if !eth1ChainID.IsUint64() {
+		return errors.Wrapf(
+			errors.New("provided chain ID is not uint64"),
+			eth1ChainID.String(),
+		)
+	}
+	if chainID.Unwrap() != eth1ChainID.Uint64() {
+		return errors.Wrapf(
+			ErrMismatchedEth1ChainID,
+			"wanted chain ID %d, got %d",
+			eth1ChainID,
+			chainID,
+		)
+	}
+	return nil
+}
@@ -43,16 +43,23 @@ func (s *EngineClient) NewPayload(
 	versionedHashes []common.ExecutionHash,
 	parentBeaconBlockRoot *common.Root,
 ) (*common.ExecutionHash, error) {
-	var (
-		startTime    = time.Now()
-		cctx, cancel = s.createContextWithTimeout(ctx)
-	)
+	startTime := time.Now()
 	defer s.metrics.measureNewPayloadDuration(startTime)
-	defer cancel()
 
 	// Call the appropriate RPC method based on the payload version.
-	result, err := s.Client.NewPayload(
-		cctx, payload, versionedHashes, parentBeaconBlockRoot,
+	result, err := withFailover(
+		ctx, s,
+		func(
+			cctx context.Context, ep *endpoint,
+		) (*engineprimitives.PayloadStatusV1, error) {
+			return ep.NewPayload(
+				cctx, payload, versionedHashes, parentBeaconBlockRoot,
+			)
+		},
+		func(result *engineprimitives.PayloadStatusV1) bool {
+			return result != nil &&
+				result.Status == engineprimitives.PayloadStatusSyncing
+		},
 	)
 	if err != nil {
 		if errors.Is(err, engineerrors.ErrEngineAPITimeout) {
@@ -88,12 +95,8 @@ func (s *EngineClient) ForkchoiceUpdated(
 	attrs *engineprimitives.PayloadAttributes,
 	forkVersion uint32,
 ) (*engineprimitives.PayloadID, *common.ExecutionHash, error) {
-	var (
-		startTime    = time.Now()
-		cctx, cancel = s.createContextWithTimeout(ctx)
-	)
+	startTime := time.Now()
 	defer s.metrics.measureForkchoiceUpdateDuration(startTime)
-	defer cancel()
 
 	// If the suggested fee recipient is not set, log a warning.
 	if !attrs.IsNil() &&
@@ -104,10 +107,18 @@ func (s *EngineClient) ForkchoiceUpdated(
 		)
 	}
 
-	result, err := s.Client.ForkchoiceUpdated(
-		cctx, state, attrs, forkVersion,
+	result, err := withFailover(
+		ctx, s,
+		func(
+			cctx context.Context, ep *endpoint,
+		) (*engineprimitives.ForkchoiceResponseV1, error) {
+			return ep.ForkchoiceUpdated(cctx, state, attrs, forkVersion)
+		},
+		func(result *engineprimitives.ForkchoiceResponseV1) bool {
+			return result != nil && result.PayloadStatus.Status ==
+				engineprimitives.PayloadStatusSyncing
+		},
 	)
-
 	if err != nil {
 		if errors.Is(err, engineerrors.ErrEngineAPITimeout) {
 			s.metrics.incrementForkchoiceUpdateTimeout()
@@ -122,6 +133,9 @@ func (s *EngineClient) ForkchoiceUpdated(
 	if err != nil {
 		return nil, latestValidHash, err
 	}
+	if len(s.endpoints) > 1 {
+		s.recordForkchoice(state, forkVersion)
+	}
 	return result.PayloadID, latestValidHash, nil
 }
 
@@ -143,8 +157,9 @@ func (s *EngineClient) GetPayload(
 	defer s.metrics.measureGetPayloadDuration(startTime)
 	defer cancel()
 
-	// Call and check for errors.
-	result, err := s.Client.GetPayload(cctx, payloadID, forkVersion)
+	// Call and check for errors. Payloads are built by the active endpoint
+	// so there is no failover.
+	result, err := s.activeEndpoint().GetPayload(cctx, payloadID, forkVersion)
 	if err != nil {
 		if errors.Is(err, engineerrors.ErrEngineAPITimeout) {
 			s.metrics.incrementGetPayloadTimeout()
@@ -161,12 +176,13 @@ func (s *EngineClient) GetPayload(
 	return result, nil
 }
 
-// ExchangeCapabilities calls the engine_exchangeCapabilities method via
-// JSON-RPC.
+// ExchangeCapabilities calls the engine_exchangeCapabilities method of the
+// active endpoint via JSON-RPC.
 func (s *EngineClient) ExchangeCapabilities(
 	ctx context.Context,
 ) ([]string, error) {
-	result, err := s.Client.ExchangeCapabilities(
+	ep := s.activeEndpoint()
+	result, err := ep.ExchangeCapabilities(
 		ctx, ethclient.BeaconKitSupportedCapabilities(),
 	)
 	if err != nil {
@@ -176,18 +192,8 @@ func (s *EngineClient) ExchangeCapabilities(
 	// Capture and log the capabilities that the execution client has.
 	for _, capability := range result {
 		s.logger.Info("Exchanged capability", "capability", capability)
-		s.capabilities[capability] = struct{}{}
 	}
-
-	// Log the capabilities that the execution client does not have.
-	for _, capability := range ethclient.BeaconKitSupportedCapabilities() {
-		if !s.HasCapability(capability) {
-			s.logger.Warn(
-				"Your execution client may require an update 🚸",
-				"unsupported_capability", capability,
-			)
-		}
-	}
-
+	ep.setCapabilities(result)
+	s.logCapabilities(ep)
 	return result, nil
 }
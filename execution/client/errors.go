@@ -51,6 +51,10 @@ var (
 	// ErrMismatchedEth1ChainID is returned when the chainID does not
 	// match the expected chain ID.
 	ErrMismatchedEth1ChainID = errors.New("mismatched chain ID")
+
+	// ErrNoHealthyEndpoint is returned when no execution client endpoint is
+	// healthy.
+	ErrNoHealthyEndpoint = errors.New("no healthy execution client endpoint")
 )
 
 // Handles errors received from the RPC server according to the specification.
@@ -27,6 +27,7 @@ import (
 
 	"github.com/berachain/beacon-kit/errors"
 	"github.com/berachain/beacon-kit/geth-primitives/rpc"
+	"github.com/berachain/beacon-kit/primitives/encoding/json"
 	"github.com/berachain/beacon-kit/primitives/math"
 	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common/hexutil"
@@ -44,6 +45,35 @@ func (s *Client) ChainID(
 	return result, nil
 }
 
+// BlockNumber returns the number of the most recent block.
+func (s *Client) BlockNumber(
+	ctx context.Context,
+) (math.U64, error) {
+	var result math.U64
+	if err := s.Call(ctx, &result, "eth_blockNumber"); err != nil {
+		return 0, err
+	}
+	return result, nil
+}
+
+// Syncing returns true if the execution client is syncing. eth_syncing
+// returns false once the client is synced, and a sync progress object
+// otherwise.
+func (s *Client) Syncing(
+	ctx context.Context,
+) (bool, error) {
+	result, err := s.CallRaw(ctx, "eth_syncing")
+	if err != nil {
+		return false, err
+	}
+	var syncing bool
+	if err = json.Unmarshal(result, &syncing); err != nil {
+		// The result is a sync progress object.
+		return true, nil //nolint:nilerr // an object means syncing.
+	}
+	return syncing, nil
+}
+
 // TODO: Figure out how to unhood all this.
 
 // FilterLogs executes a filter query.
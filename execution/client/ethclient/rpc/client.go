@@ -21,11 +21,10 @@
 package rpc
 
 import (
-	"bytes"
 	"context"
-	"io"
 	"net/http"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/berachain/beacon-kit/primitives/encoding/json"
@@ -37,10 +36,14 @@ import (
 type Client struct {
 	// url is the URL of the RPC endpoint.
 	url string
-	// client is the HTTP client used to make RPC calls.
-	client *http.Client
+	// transport sends the requests to the RPC endpoint. It is selected by
+	// the scheme of the url.
+	transport transport
 	// reqPool is a sync.Pool for reusing RPC request objects.
 	reqPool *sync.Pool
+	// nextID is the ID of the next request, so that responses can be matched
+	// to requests on transports that multiplex them.
+	nextID atomic.Int64
 	// jwtSecret is the JWT secret used for authentication.
 	jwtSecret *jwt.Secret
 	// jwtRefershInterval is the interval at which the JWT token should be
@@ -54,11 +57,14 @@ type Client struct {
 	header http.Header
 }
 
-// New create new rpc client with given url.
+// New create new rpc client with given url. The transport is selected by
+// the scheme of the url: "ws" and "wss" use a persistent WebSocket
+// connection, "ipc" uses a Unix socket at the url path, and anything else
+// uses HTTP.
 func NewClient(url string, options ...func(rpc *Client)) *Client {
 	rpc := &Client{
-		url:    url,
-		client: http.DefaultClient,
+		url:       url,
+		transport: newTransport(url, http.DefaultClient),
 		reqPool: &sync.Pool{
 			New: func() any {
 				return &Request{
@@ -100,8 +106,7 @@ func (rpc *Client) Start(ctx context.Context) {
 
 // Close closes the RPC client.
 func (rpc *Client) Close() error {
-	rpc.client.CloseIdleConnections()
-	return nil
+	return rpc.transport.Close()
 }
 
 // Call calls the given method with the given parameters.
@@ -125,12 +130,13 @@ func (rpc *Client) CallRaw(
 	ctx context.Context, method string, params ...any,
 ) (json.RawMessage, error) {
 	// Pull a request from the pool, we know that it already has the correct
-	// JSONRPC version and ID set.
+	// JSONRPC version set.
 	//nolint:errcheck // this is safe.
 	request := rpc.reqPool.Get().(*Request)
 	defer rpc.reqPool.Put(request)
 
-	// Update the request with the method and params.
+	// Update the request with a unique ID, the method and params.
+	request.ID = int(rpc.nextID.Add(1))
 	request.Method = method
 	request.Params = params
 
@@ -139,30 +145,11 @@ func (rpc *Client) CallRaw(
 		return nil, err
 	}
 
-	req, err := http.NewRequestWithContext(
-		ctx,
-		http.MethodPost,
-		rpc.url,
-		bytes.NewBuffer(body),
-	)
-	if err != nil {
-		return nil, err
-	}
-
 	rpc.mu.RLock()
-	req.Header = rpc.header.Clone()
+	header := rpc.header.Clone()
 	rpc.mu.RUnlock()
 
-	response, err := rpc.client.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	if response == nil {
-		return nil, ErrNilResponse
-	}
-	defer response.Body.Close()
-
-	data, err := io.ReadAll(response.Body)
+	data, err := rpc.transport.Do(ctx, header, request.ID, body)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,197 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package rpc_test
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/execution/client/ethclient/rpc"
+	"github.com/berachain/beacon-kit/primitives/net/jwt"
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/require"
+)
+
+// request is a JSON-RPC request as received by the test servers.
+type request struct {
+	ID     int    `json:"id"`
+	Method string `json:"method"`
+}
+
+// reply answers a request with its method name as the result.
+func reply(req request) []byte {
+	//nolint:errchkjson // test helper.
+	bz, _ := json.Marshal(map[string]any{
+		"jsonrpc": "2.0", "id": req.ID, "result": req.Method,
+	})
+	return bz
+}
+
+// callConcurrently calls distinct methods concurrently and checks that each
+// call receives its own response.
+func callConcurrently(t *testing.T, client *rpc.Client, n int) {
+	t.Helper()
+	var wg sync.WaitGroup
+	for i := range n {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			method := "method_" + string(rune('a'+i))
+			var result string
+			require.NoError(t,
+				client.Call(context.Background(), &result, method),
+			)
+			require.Equal(t, method, result)
+		}()
+	}
+	wg.Wait()
+}
+
+func TestIPCTransport(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "el.ipc")
+	listener, err := net.Listen("unix", path)
+	require.NoError(t, err)
+	t.Cleanup(func() { listener.Close() })
+
+	// The server replies to a batch of requests in reverse order on the
+	// first connection and closes it, to exercise reconnecting. Later
+	// connections reply to each request as it arrives.
+	const batch = 4
+	go func() {
+		first := true
+		for {
+			conn, aerr := listener.Accept()
+			if aerr != nil {
+				return
+			}
+			size := 1
+			if first {
+				size = batch
+			}
+			go func(conn net.Conn, size int, closeAfter bool) {
+				defer conn.Close()
+				dec := json.NewDecoder(conn)
+				for {
+					reqs := make([]request, size)
+					for i := range reqs {
+						if dec.Decode(&reqs[i]) != nil {
+							return
+						}
+					}
+					for i := len(reqs) - 1; i >= 0; i-- {
+						if _, werr := conn.Write(reply(reqs[i])); werr != nil {
+							return
+						}
+					}
+					if closeAfter {
+						return
+					}
+				}
+			}(conn, size, first)
+			first = false
+		}
+	}()
+
+	client := rpc.NewClient("ipc://" + path)
+	callConcurrently(t, client, batch)
+	// The first connection was closed, so the client redials.
+	require.Eventually(t, func() bool {
+		ctx, cancel := context.WithTimeout(context.Background(), testTick)
+		defer cancel()
+		return client.Call(ctx, nil, "probe") == nil
+	}, testTimeout, testTick)
+	callConcurrently(t, client, batch)
+	require.NoError(t, client.Close())
+}
+
+func TestWSTransport(t *testing.T) {
+	secret, err := jwt.NewRandom()
+	require.NoError(t, err)
+
+	upgrader := websocket.Upgrader{}
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			token := strings.TrimPrefix(
+				r.Header.Get("Authorization"), "Bearer ",
+			)
+			if secret.VerifySignedToken(token) != nil {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			conn, uerr := upgrader.Upgrade(w, r, nil)
+			if uerr != nil {
+				return
+			}
+			defer conn.Close()
+			var mu sync.Mutex
+			for {
+				_, msg, rerr := conn.ReadMessage()
+				if rerr != nil {
+					return
+				}
+				go func() {
+					var req request
+					if json.Unmarshal(msg, &req) != nil {
+						return
+					}
+					mu.Lock()
+					defer mu.Unlock()
+					//nolint:errcheck // test server.
+					conn.WriteMessage(websocket.TextMessage, reply(req))
+				}()
+			}
+		},
+	))
+	t.Cleanup(server.Close)
+	wsURL := "ws" + strings.TrimPrefix(server.URL, "http")
+
+	// Without a JWT the handshake is rejected.
+	unauthenticated := rpc.NewClient(wsURL)
+	require.Error(t,
+		unauthenticated.Call(context.Background(), nil, "eth_chainId"),
+	)
+
+	client := rpc.NewClient(
+		wsURL,
+		rpc.WithJWTSecret(secret),
+		rpc.WithJWTRefreshInterval(time.Minute),
+	)
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	go client.Start(ctx)
+	require.Eventually(t, func() bool {
+		return client.Call(ctx, nil, "eth_chainId") == nil
+	}, testTimeout, testTick)
+	callConcurrently(t, client, 8)
+}
+
+const (
+	testTimeout = 5 * time.Second
+	testTick    = 10 * time.Millisecond
+)
@@ -0,0 +1,258 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package rpc
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/berachain/beacon-kit/primitives/encoding/json"
+	"github.com/gorilla/websocket"
+)
+
+// streamConn is a persistent connection exchanging JSON-RPC messages.
+type streamConn interface {
+	// WriteMessage writes a single message before the deadline.
+	WriteMessage(deadline time.Time, msg []byte) error
+	// ReadMessage blocks until a message is received.
+	ReadMessage() ([]byte, error)
+	// Close closes the connection, unblocking ReadMessage.
+	Close() error
+}
+
+// streamDialer opens a streamConn with the given header.
+type streamDialer func(ctx context.Context, header http.Header) (streamConn, error)
+
+// streamResult is the response to a request sent over a streamConn.
+type streamResult struct {
+	msg []byte
+	err error
+}
+
+// streamTransport multiplexes requests over a persistent connection,
+// matching responses to requests by ID. The connection is dialed on the
+// first request and redialed on the next request after it fails.
+type streamTransport struct {
+	dial streamDialer
+
+	// mu protects conn and pending.
+	mu      sync.Mutex
+	conn    streamConn
+	pending map[int]chan streamResult
+
+	// writeMu serializes writes to the connection.
+	writeMu sync.Mutex
+}
+
+// newStreamTransport creates a new streamTransport using the given dialer.
+func newStreamTransport(dial streamDialer) *streamTransport {
+	return &streamTransport{
+		dial:    dial,
+		pending: make(map[int]chan streamResult),
+	}
+}
+
+// Do implements transport.
+func (t *streamTransport) Do(
+	ctx context.Context, header http.Header, id int, body []byte,
+) ([]byte, error) {
+	conn, err := t.connect(ctx, header)
+	if err != nil {
+		return nil, err
+	}
+
+	ch := make(chan streamResult, 1)
+	t.mu.Lock()
+	t.pending[id] = ch
+	t.mu.Unlock()
+	defer func() {
+		t.mu.Lock()
+		delete(t.pending, id)
+		t.mu.Unlock()
+	}()
+
+	// A zero deadline, when the context has none, means no deadline.
+	deadline, _ := ctx.Deadline()
+	t.writeMu.Lock()
+	err = conn.WriteMessage(deadline, body)
+	t.writeMu.Unlock()
+	if err != nil {
+		t.drop(conn, err)
+		return nil, err
+	}
+
+	select {
+	case <-ctx.Done():
+		return nil, context.Cause(ctx)
+	case res := <-ch:
+		return res.msg, res.err
+	}
+}
+
+// Close implements transport.
+func (t *streamTransport) Close() error {
+	t.mu.Lock()
+	conn := t.conn
+	t.mu.Unlock()
+	if conn == nil {
+		return nil
+	}
+	return conn.Close()
+}
+
+// connect returns the current connection, dialing a new one if needed.
+func (t *streamTransport) connect(
+	ctx context.Context, header http.Header,
+) (streamConn, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.conn != nil {
+		return t.conn, nil
+	}
+	conn, err := t.dial(ctx, header)
+	if err != nil {
+		return nil, err
+	}
+	t.conn = conn
+	go t.read(conn)
+	return conn, nil
+}
+
+// read dispatches the messages received on the connection to the pending
+// requests until the connection fails.
+func (t *streamTransport) read(conn streamConn) {
+	for {
+		msg, err := conn.ReadMessage()
+		if err != nil {
+			t.drop(conn, err)
+			return
+		}
+		var resp struct {
+			ID int `json:"id"`
+		}
+		if err = json.Unmarshal(msg, &resp); err != nil {
+			continue
+		}
+		t.mu.Lock()
+		if ch, ok := t.pending[resp.ID]; ok {
+			ch <- streamResult{msg: msg}
+			delete(t.pending, resp.ID)
+		}
+		t.mu.Unlock()
+	}
+}
+
+// drop closes the connection if it is still the current one and fails the
+// pending requests, so that the next request redials.
+func (t *streamTransport) drop(conn streamConn, err error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.conn != conn {
+		return
+	}
+	//#nosec:G104 // the connection already failed.
+	conn.Close()
+	t.conn = nil
+	for id, ch := range t.pending {
+		ch <- streamResult{err: err}
+		delete(t.pending, id)
+	}
+}
+
+// wsConn is a streamConn over a WebSocket connection.
+type wsConn struct {
+	*websocket.Conn
+}
+
+// wsDialer returns a dialer of WebSocket connections to the given url. The
+// header, carrying the JWT, authenticates the handshake.
+func wsDialer(rawURL string) streamDialer {
+	return func(ctx context.Context, header http.Header) (streamConn, error) {
+		// The handshake must not carry the JSON content type.
+		header = header.Clone()
+		header.Del("Content-Type")
+		conn, resp, err := websocket.DefaultDialer.DialContext(
+			ctx, rawURL, header,
+		)
+		if resp != nil && resp.Body != nil {
+			resp.Body.Close()
+		}
+		if err != nil {
+			return nil, err
+		}
+		return &wsConn{Conn: conn}, nil
+	}
+}
+
+// WriteMessage implements streamConn.
+func (c *wsConn) WriteMessage(deadline time.Time, msg []byte) error {
+	if err := c.SetWriteDeadline(deadline); err != nil {
+		return err
+	}
+	return c.Conn.WriteMessage(websocket.TextMessage, msg)
+}
+
+// ReadMessage implements streamConn.
+func (c *wsConn) ReadMessage() ([]byte, error) {
+	_, msg, err := c.Conn.ReadMessage()
+	return msg, err
+}
+
+// ipcConn is a streamConn over a Unix socket, exchanging a stream of JSON
+// values.
+type ipcConn struct {
+	net.Conn
+	dec *json.Decoder
+}
+
+// ipcDialer returns a dialer of Unix socket connections to the given path.
+// IPC connections are not authenticated, so the header is ignored.
+func ipcDialer(path string) streamDialer {
+	return func(ctx context.Context, _ http.Header) (streamConn, error) {
+		var d net.Dialer
+		conn, err := d.DialContext(ctx, "unix", path)
+		if err != nil {
+			return nil, err
+		}
+		return &ipcConn{Conn: conn, dec: json.NewDecoder(conn)}, nil
+	}
+}
+
+// WriteMessage implements streamConn.
+func (c *ipcConn) WriteMessage(deadline time.Time, msg []byte) error {
+	if err := c.SetWriteDeadline(deadline); err != nil {
+		return err
+	}
+	_, err := c.Write(msg)
+	return err
+}
+
+// ReadMessage implements streamConn.
+func (c *ipcConn) ReadMessage() ([]byte, error) {
+	var msg json.RawMessage
+	if err := c.dec.Decode(&msg); err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
@@ -0,0 +1,98 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package rpc
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+)
+
+// transport sends encoded JSON-RPC requests to an RPC endpoint.
+type transport interface {
+	// Do sends the request with the given ID and returns the encoded
+	// response. The header carries the authorization of the request.
+	Do(
+		ctx context.Context, header http.Header, id int, body []byte,
+	) ([]byte, error)
+	// Close releases the connections held by the transport.
+	Close() error
+}
+
+// newTransport returns the transport for the scheme of the given url.
+func newTransport(rawURL string, client *http.Client) transport {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		// Let the HTTP transport surface the error on the first request.
+		return &httpTransport{url: rawURL, client: client}
+	}
+	switch u.Scheme {
+	case "ws", "wss":
+		return newStreamTransport(wsDialer(rawURL))
+	case "ipc":
+		return newStreamTransport(ipcDialer(u.Path))
+	default:
+		return &httpTransport{url: rawURL, client: client}
+	}
+}
+
+// httpTransport sends each request as an HTTP POST.
+type httpTransport struct {
+	// url is the URL of the RPC endpoint.
+	url string
+	// client is the HTTP client used to make RPC calls.
+	client *http.Client
+}
+
+// Do implements transport.
+func (t *httpTransport) Do(
+	ctx context.Context, header http.Header, _ int, body []byte,
+) ([]byte, error) {
+	req, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodPost,
+		t.url,
+		bytes.NewBuffer(body),
+	)
+	if err != nil {
+		return nil, err
+	}
+	req.Header = header
+
+	response, err := t.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if response == nil {
+		return nil, ErrNilResponse
+	}
+	defer response.Body.Close()
+
+	return io.ReadAll(response.Body)
+}
+
+// Close implements transport.
+func (t *httpTransport) Close() error {
+	t.client.CloseIdleConnections()
+	return nil
+}
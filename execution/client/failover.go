@@ -0,0 +1,278 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package client
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
+	"github.com/berachain/beacon-kit/errors"
+	jsonrpc "github.com/berachain/beacon-kit/primitives/net/json-rpc"
+)
+
+// monitorEndpoints periodically health checks the endpoints, and fails over
+// if the active endpoint is unhealthy or is syncing while another endpoint
+// is synced.
+func (s *EngineClient) monitorEndpoints(ctx context.Context) {
+	ticker := time.NewTicker(s.cfg.RPCHealthCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.checkEndpoints(ctx)
+			active, idx := s.activeEndpointWithIndex()
+			if active.isSynced() ||
+				(active.isHealthy() && !s.hasSyncedStandby(idx)) {
+				continue
+			}
+			if _, err := s.failover(ctx, idx); err != nil {
+				s.logger.Error(
+					"No healthy execution client to fail over to",
+					"active", active.String(), "err", err,
+				)
+			}
+		}
+	}
+}
+
+// checkEndpoints health checks every endpoint concurrently.
+func (s *EngineClient) checkEndpoints(ctx context.Context) {
+	var wg sync.WaitGroup
+	for _, ep := range s.endpoints {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			s.checkEndpoint(ctx, ep)
+		}()
+	}
+	wg.Wait()
+}
+
+// checkEndpoint health checks the endpoint with eth_chainId,
+// engine_exchangeCapabilities and eth_syncing, and records the result.
+func (s *EngineClient) checkEndpoint(ctx context.Context, ep *endpoint) {
+	cctx, cancel := context.WithTimeout(ctx, s.cfg.RPCTimeout)
+	defer cancel()
+	if err := s.verifyChainIDAndConnection(cctx, ep); err != nil {
+		if ep.isHealthy() {
+			s.logger.Warn(
+				"Execution client became unhealthy",
+				"dial_url", ep.String(), "err", err,
+			)
+		}
+		ep.setHealth(false, false)
+		return
+	}
+	syncing, err := ep.Syncing(cctx)
+	ep.setHealth(err == nil, err == nil && !syncing)
+}
+
+// activeEndpoint returns the endpoint serving calls.
+func (s *EngineClient) activeEndpoint() *endpoint {
+	ep, _ := s.activeEndpointWithIndex()
+	return ep
+}
+
+// activeEndpointWithIndex returns the endpoint serving calls and its index.
+func (s *EngineClient) activeEndpointWithIndex() (*endpoint, int) {
+	s.activeMu.RLock()
+	defer s.activeMu.RUnlock()
+	return s.endpoints[s.active], s.active
+}
+
+// setActive makes the endpoint at the given index the one serving calls.
+func (s *EngineClient) setActive(idx int) {
+	s.activeMu.Lock()
+	prev := s.active
+	s.active = idx
+	s.activeMu.Unlock()
+	s.metrics.setActiveEndpoint(
+		s.endpoints[prev].String(), s.endpoints[idx].String(),
+	)
+}
+
+// hasSyncedStandby returns true if an endpoint other than the one at the
+// given index is healthy and synced.
+func (s *EngineClient) hasSyncedStandby(idx int) bool {
+	for i, ep := range s.endpoints {
+		if i != idx && ep.isSynced() {
+			return true
+		}
+	}
+	return false
+}
+
+// failover replaces the endpoint at the given index, if it is still active,
+// with the preferred synced endpoint, or else the preferred healthy one. The
+// new endpoint is resynced with the last forkchoice state before it starts
+// serving calls.
+func (s *EngineClient) failover(
+	ctx context.Context,
+	from int,
+) (*endpoint, error) {
+	s.activeMu.Lock()
+	if s.active != from {
+		// Another caller already failed over.
+		defer s.activeMu.Unlock()
+		return s.endpoints[s.active], nil
+	}
+	next := -1
+	for i, ep := range s.endpoints {
+		if i == from || !ep.isHealthy() {
+			continue
+		}
+		if ep.isSynced() {
+			next = i
+			break
+		}
+		if next == -1 {
+			next = i
+		}
+	}
+	if next == -1 {
+		s.activeMu.Unlock()
+		return nil, ErrNoHealthyEndpoint
+	}
+	s.active = next
+	last := s.lastForkchoice
+	s.activeMu.Unlock()
+
+	prev, ep := s.endpoints[from], s.endpoints[next]
+	s.metrics.setActiveEndpoint(prev.String(), ep.String())
+	s.metrics.incrementFailover()
+	s.logger.Warn(
+		"Failing over to execution client 🔀",
+		"from", prev.String(), "to", ep.String(),
+	)
+	if last != nil {
+		s.resyncForkchoice(ctx, ep, last)
+	}
+	return ep, nil
+}
+
+// resyncForkchoice sends the forkchoice state to the endpoint, so that it
+// syncs to the head known to the previously active endpoint.
+func (s *EngineClient) resyncForkchoice(
+	ctx context.Context,
+	ep *endpoint,
+	fcu *forkchoiceUpdate,
+) {
+	cctx, cancel := s.createContextWithTimeout(ctx)
+	defer cancel()
+	result, err := ep.ForkchoiceUpdated(cctx, fcu.state, nil, fcu.forkVersion)
+	if err == nil && result != nil {
+		_, err = processPayloadStatusResult(&result.PayloadStatus)
+	}
+	if err != nil {
+		s.logger.Warn(
+			"Failed to resync execution client with forkchoice",
+			"dial_url", ep.String(),
+			"head", fcu.state.HeadBlockHash,
+			"err", err,
+		)
+	}
+}
+
+// recordForkchoice stores the forkchoice state accepted by the active
+// endpoint and queues it for the healthy standby endpoints, so that they
+// keep following the chain.
+func (s *EngineClient) recordForkchoice(
+	state *engineprimitives.ForkchoiceStateV1,
+	forkVersion uint32,
+) {
+	fcu := &forkchoiceUpdate{state: state, forkVersion: forkVersion}
+	s.activeMu.Lock()
+	s.lastForkchoice = fcu
+	active := s.active
+	s.activeMu.Unlock()
+
+	for i, ep := range s.endpoints {
+		if i == active || !ep.isHealthy() {
+			continue
+		}
+		ep.queueResync(fcu)
+	}
+}
+
+// resyncStandby sends the forkchoice states queued for the endpoint, one at
+// a time, until the context is done. States queued before the endpoint
+// became active are dropped, as it is then updated directly.
+func (s *EngineClient) resyncStandby(ctx context.Context, ep *endpoint) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case fcu := <-ep.resyncs:
+			if s.activeEndpoint() != ep {
+				s.resyncForkchoice(ctx, ep, fcu)
+			}
+		}
+	}
+}
+
+// withFailover performs the call against the active endpoint. If the active
+// endpoint is unreachable, or is syncing while a standby is synced, the call
+// is retried against the endpoint that replaces it.
+func withFailover[T any](
+	ctx context.Context,
+	s *EngineClient,
+	call func(context.Context, *endpoint) (T, error),
+	isSyncing func(T) bool,
+) (T, error) {
+	var (
+		result  T
+		err     error
+		ep, idx = s.activeEndpointWithIndex()
+	)
+	for range s.endpoints {
+		cctx, cancel := s.createContextWithTimeout(ctx)
+		result, err = call(cctx, ep)
+		cancel()
+
+		switch {
+		case len(s.endpoints) == 1 || ctx.Err() != nil:
+			return result, err
+		case err != nil && isEndpointFailure(err):
+			ep.setHealth(false, false)
+		case err == nil && isSyncing(result) && s.hasSyncedStandby(idx):
+			ep.setHealth(true, false)
+		default:
+			return result, err
+		}
+
+		if _, ferr := s.failover(ctx, idx); ferr != nil {
+			return result, err
+		}
+		ep, idx = s.activeEndpointWithIndex()
+	}
+	return result, err
+}
+
+// isEndpointFailure returns true if the error means that the endpoint could
+// not serve the request, as opposed to a JSON-RPC error returned by it.
+func isEndpointFailure(err error) bool {
+	var rpcErr jsonrpc.Error
+	return !errors.As(err, &rpcErr)
+}
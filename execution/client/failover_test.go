@@ -0,0 +1,320 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package client_test
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
+	"github.com/berachain/beacon-kit/execution/client"
+	"github.com/berachain/beacon-kit/log"
+	"github.com/berachain/beacon-kit/log/noop"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/net/jwt"
+	"github.com/berachain/beacon-kit/primitives/net/url"
+	"github.com/berachain/beacon-kit/primitives/version"
+	"github.com/stretchr/testify/require"
+)
+
+const testChainID = 80087
+
+// fakeEL is a minimal execution client serving the engine API methods used
+// by the engine client.
+type fakeEL struct {
+	*httptest.Server
+
+	mu      sync.Mutex
+	syncing bool
+	status  engineprimitives.PayloadStatusStr
+	heads   []common.ExecutionHash
+	// gate, if set, holds forkchoice updates until it is received from.
+	gate chan struct{}
+	held int
+}
+
+func newFakeEL(t *testing.T) *fakeEL {
+	t.Helper()
+	el := &fakeEL{status: engineprimitives.PayloadStatusValid}
+	el.Server = httptest.NewServer(http.HandlerFunc(el.serve))
+	t.Cleanup(el.Close)
+	return el
+}
+
+func (el *fakeEL) serve(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		ID     int               `json:"id"`
+		Method string            `json:"method"`
+		Params []json.RawMessage `json:"params"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	el.mu.Lock()
+	gate := el.gate
+	if gate != nil && req.Method == "engine_forkchoiceUpdatedV3" {
+		el.held++
+		el.mu.Unlock()
+		<-gate
+	} else {
+		el.mu.Unlock()
+	}
+
+	el.mu.Lock()
+	defer el.mu.Unlock()
+	var result any
+	switch req.Method {
+	case "eth_chainId":
+		result = "0x138d7"
+	case "eth_syncing":
+		if el.syncing {
+			result = map[string]string{"currentBlock": "0x1"}
+		} else {
+			result = false
+		}
+	case "engine_exchangeCapabilities":
+		result = []string{"engine_forkchoiceUpdatedV3"}
+	case "engine_forkchoiceUpdatedV3":
+		var state engineprimitives.ForkchoiceStateV1
+		if err := json.Unmarshal(req.Params[0], &state); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		el.heads = append(el.heads, state.HeadBlockHash)
+		result = engineprimitives.ForkchoiceResponseV1{
+			PayloadStatus: engineprimitives.PayloadStatusV1{
+				Status: el.status,
+			},
+		}
+	default:
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	//nolint:errcheck // test server.
+	json.NewEncoder(w).Encode(map[string]any{
+		"jsonrpc": "2.0", "id": req.ID, "result": result,
+	})
+}
+
+// receivedHeads returns the heads received through forkchoice updates.
+func (el *fakeEL) receivedHeads() []common.ExecutionHash {
+	el.mu.Lock()
+	defer el.mu.Unlock()
+	return append([]common.ExecutionHash{}, el.heads...)
+}
+
+func (el *fakeEL) setSyncing(syncing bool) {
+	el.mu.Lock()
+	defer el.mu.Unlock()
+	el.syncing = syncing
+	if syncing {
+		el.status = engineprimitives.PayloadStatusSyncing
+	} else {
+		el.status = engineprimitives.PayloadStatusValid
+	}
+}
+
+// holdForkchoiceUpdates makes the forkchoice updates wait on the returned
+// channel.
+func (el *fakeEL) holdForkchoiceUpdates() chan struct{} {
+	el.mu.Lock()
+	defer el.mu.Unlock()
+	el.gate = make(chan struct{})
+	return el.gate
+}
+
+// heldUpdates returns the number of forkchoice updates held so far.
+func (el *fakeEL) heldUpdates() int {
+	el.mu.Lock()
+	defer el.mu.Unlock()
+	return el.held
+}
+
+// recordingSink records the gauges set by the engine client.
+type recordingSink struct {
+	mu     sync.Mutex
+	gauges map[string]int64
+}
+
+func (*recordingSink) IncrementCounter(string, ...string)        {}
+func (*recordingSink) MeasureSince(string, time.Time, ...string) {}
+func (s *recordingSink) SetGauge(key string, value int64, args ...string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.gauges[key+"/"+args[len(args)-1]] = value
+}
+
+func (s *recordingSink) gauge(key string) int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.gauges[key]
+}
+
+func newTestClient(
+	t *testing.T,
+	sink client.TelemetrySink,
+	els ...*fakeEL,
+) *client.EngineClient {
+	t.Helper()
+	cfg := client.DefaultConfig()
+	dialURLs := make([]*url.ConnectionURL, len(els))
+	for i, el := range els {
+		var err error
+		dialURLs[i], err = url.NewFromRaw(el.URL)
+		require.NoError(t, err)
+	}
+	cfg.RPCDialURL = dialURLs[0]
+	cfg.RPCFallbackDialURLs = dialURLs[1:]
+	cfg.RPCHealthCheckInterval = time.Hour
+
+	secret, err := jwt.NewRandom()
+	require.NoError(t, err)
+	ec := client.New(
+		&cfg, noop.NewLogger[log.Logger](), secret, sink,
+		big.NewInt(testChainID),
+	)
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	require.NoError(t, ec.Start(ctx))
+	return ec
+}
+
+func forkchoiceUpdated(
+	t *testing.T,
+	ec *client.EngineClient,
+	head common.ExecutionHash,
+) error {
+	t.Helper()
+	_, _, err := ec.ForkchoiceUpdated(
+		context.Background(),
+		&engineprimitives.ForkchoiceStateV1{HeadBlockHash: head},
+		nil,
+		version.Deneb,
+	)
+	return err
+}
+
+func TestFailoverOnUnreachableEndpoint(t *testing.T) {
+	primary, standby := newFakeEL(t), newFakeEL(t)
+	sink := &recordingSink{gauges: map[string]int64{}}
+	ec := newTestClient(t, sink, primary, standby)
+	require.Equal(t, primary.URL, ec.ActiveEndpoint())
+	require.Equal(t, int64(1), sink.gauge(
+		"beacon_kit.execution.client.active_endpoint/"+primary.URL,
+	))
+
+	// The forkchoice is served by the primary and mirrored to the standby.
+	require.NoError(t, forkchoiceUpdated(t, ec, common.ExecutionHash{0x01}))
+	require.Equal(t,
+		[]common.ExecutionHash{{0x01}}, primary.receivedHeads(),
+	)
+	require.Eventually(t, func() bool {
+		return len(standby.receivedHeads()) == 1
+	}, time.Second, 10*time.Millisecond)
+
+	// Once the primary dies, the standby is resynced with the last
+	// forkchoice and then serves the call.
+	primary.Close()
+	require.NoError(t, forkchoiceUpdated(t, ec, common.ExecutionHash{0x02}))
+	require.Equal(t, standby.URL, ec.ActiveEndpoint())
+	require.Equal(t,
+		[]common.ExecutionHash{{0x01}, {0x01}, {0x02}},
+		standby.receivedHeads(),
+	)
+	require.Equal(t, int64(0), sink.gauge(
+		"beacon_kit.execution.client.active_endpoint/"+primary.URL,
+	))
+	require.Equal(t, int64(1), sink.gauge(
+		"beacon_kit.execution.client.active_endpoint/"+standby.URL,
+	))
+}
+
+func TestFailoverOnSyncingEndpoint(t *testing.T) {
+	primary, standby := newFakeEL(t), newFakeEL(t)
+	ec := newTestClient(
+		t, &recordingSink{gauges: map[string]int64{}}, primary, standby,
+	)
+
+	// A syncing primary is replaced by the synced standby.
+	primary.setSyncing(true)
+	require.NoError(t, forkchoiceUpdated(t, ec, common.ExecutionHash{0x01}))
+	require.Equal(t, standby.URL, ec.ActiveEndpoint())
+
+	// A syncing endpoint is kept if no standby is synced.
+	standby.setSyncing(true)
+	err := forkchoiceUpdated(t, ec, common.ExecutionHash{0x02})
+	require.Error(t, err)
+	require.Equal(t, standby.URL, ec.ActiveEndpoint())
+}
+
+func TestNoFailoverWithSingleEndpoint(t *testing.T) {
+	primary := newFakeEL(t)
+	ec := newTestClient(t, &recordingSink{gauges: map[string]int64{}}, primary)
+
+	primary.Close()
+	require.Error(t, forkchoiceUpdated(t, ec, common.ExecutionHash{0x01}))
+	require.Equal(t, primary.URL, ec.ActiveEndpoint())
+}
+
+func TestStandbyResyncKeepsLatestForkchoice(t *testing.T) {
+	primary, standby := newFakeEL(t), newFakeEL(t)
+	ec := newTestClient(
+		t, &recordingSink{gauges: map[string]int64{}}, primary, standby,
+	)
+	gate := standby.holdForkchoiceUpdates()
+
+	// The standby is slow, so the states mirrored to it while a resync is
+	// in flight are replaced by the latest one.
+	require.NoError(t, forkchoiceUpdated(t, ec, common.ExecutionHash{0x01}))
+	require.Eventually(t, func() bool {
+		return standby.heldUpdates() == 1
+	}, time.Second, 10*time.Millisecond)
+	for head := byte(2); head <= 5; head++ {
+		require.NoError(t,
+			forkchoiceUpdated(t, ec, common.ExecutionHash{head}),
+		)
+	}
+	require.Len(t, primary.receivedHeads(), 5)
+
+	gate <- struct{}{}
+	gate <- struct{}{}
+	require.Eventually(t, func() bool {
+		return len(standby.receivedHeads()) == 2
+	}, time.Second, 10*time.Millisecond)
+	require.Equal(t,
+		[]common.ExecutionHash{{0x01}, {0x05}}, standby.receivedHeads(),
+	)
+
+	// Nothing else is left to send.
+	select {
+	case gate <- struct{}{}:
+		t.Fatal("unexpected forkchoice update sent to the standby")
+	case <-time.After(100 * time.Millisecond):
+	}
+}
@@ -73,6 +73,22 @@ func (cm *clientMetrics) measureGetPayloadDuration(startTime time.Time) {
 	)
 }
 
+// setActiveEndpoint marks the given endpoint as the one serving calls.
+func (cm *clientMetrics) setActiveEndpoint(prev, active string) {
+	cm.sink.SetGauge(
+		"beacon_kit.execution.client.active_endpoint", 0, "endpoint", prev,
+	)
+	cm.sink.SetGauge(
+		"beacon_kit.execution.client.active_endpoint", 1, "endpoint", active,
+	)
+}
+
+// incrementFailover increments the counter of failovers to another
+// endpoint.
+func (cm *clientMetrics) incrementFailover() {
+	cm.sink.IncrementCounter("beacon_kit.execution.client.failover")
+}
+
 // incrementForkchoiceUpdateTimeout increments the timeout counter
 // for forkchoice update.
 func (cm *clientMetrics) incrementForkchoiceUpdateTimeout() {
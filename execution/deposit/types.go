@@ -41,6 +41,16 @@ type Contract interface {
 	) ([]*ctypes.Deposit, error)
 }
 
+// WithdrawalRequestContract is the ABI for the withdrawal request contract.
+type WithdrawalRequestContract interface {
+	// ReadWithdrawalRequests reads withdrawal requests from the withdrawal
+	// request contract.
+	ReadWithdrawalRequests(
+		ctx context.Context,
+		blockNumber math.U64,
+	) ([]*ctypes.WithdrawalRequest, error)
+}
+
 // Store defines the interface for managing deposit operations.
 type Store interface {
 	// Prune prunes the deposit store of [start, end)
@@ -0,0 +1,127 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package deposit
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	gethprimitives "github.com/berachain/beacon-kit/geth-primitives"
+	"github.com/berachain/beacon-kit/geth-primitives/bind"
+	"github.com/berachain/beacon-kit/geth-primitives/withdrawal"
+	"github.com/berachain/beacon-kit/primitives/bytes"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
+)
+
+type WrappedWithdrawalRequestContract struct {
+	// WithdrawalRequestContractFilterer is a pointer to the codegen ABI
+	// binding.
+	withdrawal.WithdrawalRequestContractFilterer
+}
+
+// NewWrappedWithdrawalRequestContract creates a new withdrawal request
+// contract.
+func NewWrappedWithdrawalRequestContract(
+	address common.ExecutionAddress,
+	client bind.ContractFilterer,
+) (*WrappedWithdrawalRequestContract, error) {
+	contract, err := withdrawal.NewWithdrawalRequestContractFilterer(
+		gethprimitives.ExecutionAddress(address), client,
+	)
+
+	if err != nil {
+		return nil, err
+	} else if contract == nil {
+		return nil, errors.New("contract must not be nil")
+	}
+
+	return &WrappedWithdrawalRequestContract{
+		WithdrawalRequestContractFilterer: *contract,
+	}, nil
+}
+
+// ReadWithdrawalRequests reads withdrawal requests, including withdrawal
+// credentials changes, from the withdrawal request contract, ordered by
+// index.
+func (wc *WrappedWithdrawalRequestContract) ReadWithdrawalRequests(
+	ctx context.Context,
+	blkNum math.U64,
+) ([]*ctypes.WithdrawalRequest, error) {
+	opts := &bind.FilterOpts{
+		Context: ctx,
+		Start:   blkNum.Unwrap(),
+		End:     (*uint64)(&blkNum),
+	}
+	logs, err := wc.FilterWithdrawalRequest(opts, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	requests := make([]*ctypes.WithdrawalRequest, 0)
+	for logs.Next() {
+		var pubKey bytes.B48
+		pubKey, err = bytes.ToBytes48(logs.Event.Pubkey)
+		if err != nil {
+			return nil, fmt.Errorf("failed reading pub key: %w", err)
+		}
+		requests = append(requests, ctypes.NewWithdrawalRequest(
+			common.ExecutionAddress(logs.Event.SourceAddress),
+			pubKey,
+			math.Gwei(logs.Event.Amount),
+			logs.Event.Index,
+		))
+	}
+	if err = logs.Error(); err != nil {
+		return nil, err
+	}
+
+	changeLogs, err := wc.FilterCredentialsChangeRequest(opts, nil)
+	if err != nil {
+		return nil, err
+	}
+	for changeLogs.Next() {
+		var pubKey bytes.B48
+		pubKey, err = bytes.ToBytes48(changeLogs.Event.Pubkey)
+		if err != nil {
+			return nil, fmt.Errorf("failed reading pub key: %w", err)
+		}
+		requests = append(requests, ctypes.NewCredentialsChangeRequest(
+			common.ExecutionAddress(changeLogs.Event.SourceAddress),
+			pubKey,
+			common.ExecutionAddress(changeLogs.Event.NewWithdrawalAddress),
+			changeLogs.Event.Index,
+		))
+	}
+	if err = changeLogs.Error(); err != nil {
+		return nil, err
+	}
+
+	// Both kinds of requests share the index of the contract.
+	slices.SortFunc(requests, func(a, b *ctypes.WithdrawalRequest) int {
+		return cmp.Compare(a.Index, b.Index)
+	})
+	return requests, nil
+}
@@ -25,9 +25,13 @@ import "github.com/ethereum/go-ethereum/accounts/abi/bind"
 type (
 	ContractBackend  = bind.ContractBackend
 	ContractFilterer = bind.ContractFilterer
+	DeployBackend    = bind.DeployBackend
 	FilterOpts       = bind.FilterOpts
 	TransactOpts     = bind.TransactOpts
 )
 
 //nolint:gochecknoglobals //used an alias.
-var WaitMined = bind.WaitMined
+var (
+	WaitMined                     = bind.WaitMined
+	NewKeyedTransactorWithChainID = bind.NewKeyedTransactorWithChainID
+)
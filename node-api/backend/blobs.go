@@ -0,0 +1,74 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package backend
+
+import (
+	"slices"
+
+	dastore "github.com/berachain/beacon-kit/da/store"
+	datypes "github.com/berachain/beacon-kit/da/types"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/primitives/math"
+)
+
+// BlobSidecarsAtSlot returns the sidecars of the block at the given slot,
+// ordered by index. If indices is not empty, only the sidecars at those
+// indices are returned. A slot of 0 is resolved to the latest slot.
+func (b Backend[
+	_, _, _, _, _, _, _,
+]) BlobSidecarsAtSlot(
+	slot math.Slot,
+	indices []uint64,
+) (datypes.BlobSidecars, error) {
+	_, headSlot, err := b.stateFromSlotRaw(0)
+	if err != nil {
+		return nil, err
+	}
+	if slot == 0 {
+		slot = headSlot
+	}
+	if !b.cs.WithinDAPeriod(slot, headSlot) {
+		return nil, errors.Wrapf(
+			dastore.ErrBlobSidecarsPruned, "slot %d, head slot %d", slot, headSlot,
+		)
+	}
+
+	blk, err := b.sb.BlockStore().GetBlockBySlot(slot)
+	if err != nil {
+		return nil, err
+	}
+	commitments := blk.GetBody().GetBlobKzgCommitments()
+	sidecars := make(datypes.BlobSidecars, 0, len(commitments))
+	for i, commitment := range commitments {
+		if len(indices) > 0 && !slices.Contains(indices, uint64(i)) {
+			continue
+		}
+		var sidecar *datypes.BlobSidecar
+		sidecar, err = b.sb.AvailabilityStore().GetBlobSidecar(
+			slot, commitment,
+		)
+		if err != nil {
+			return nil, errors.Wrapf(err, "blob sidecar %d at slot %d", i, slot)
+		}
+		sidecars = append(sidecars, sidecar)
+	}
+	return sidecars, nil
+}
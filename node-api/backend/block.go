@@ -24,15 +24,39 @@ import (
 	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
 	types "github.com/berachain/beacon-kit/node-api/handlers/beacon/types"
 	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/crypto"
 	"github.com/berachain/beacon-kit/primitives/math"
 )
 
-// BlockHeader returns the block header at the given slot.
+// BlockAtSlot returns the finalized block at the given slot from the block
+// store. A slot of 0 is resolved to the latest slot.
+func (b Backend[
+	_, _, _, _, _, _, _,
+]) BlockAtSlot(slot math.Slot) (*ctypes.BeaconBlock, error) {
+	if slot == 0 {
+		var err error
+		if _, slot, err = b.stateFromSlotRaw(slot); err != nil {
+			return nil, err
+		}
+	}
+	return b.sb.BlockStore().GetBlockBySlot(slot)
+}
+
+// BlockHeader returns the block header at the given slot. Headers of
+// finalized blocks are served from the block store, which retains them past
+// the state history; the latest slot (0) is resolved from the state.
 func (b Backend[
 	_, _, _, _, _, _, _,
 ]) BlockHeaderAtSlot(slot math.Slot) (*ctypes.BeaconBlockHeader, error) {
 	var blockHeader *ctypes.BeaconBlockHeader
 
+	if slot != 0 {
+		header, err := b.sb.BlockStore().GetHeaderBySlot(slot)
+		if err == nil {
+			return header, nil
+		}
+	}
+
 	st, _, err := b.stateFromSlot(slot)
 	if err != nil {
 		return blockHeader, err
@@ -42,6 +66,14 @@ func (b Backend[
 	return blockHeader, err
 }
 
+// BlockSignatureAtSlot returns the proposer signature of the finalized block
+// at the given slot, if known.
+func (b Backend[
+	_, _, _, _, _, _, _,
+]) BlockSignatureAtSlot(slot math.Slot) (crypto.BLSSignature, error) {
+	return b.sb.BlockStore().GetSignatureBySlot(slot)
+}
+
 // GetBlockRoot returns the root of the block at the given stateID.
 func (b Backend[
 	_, _, _, _, _, _, _,
@@ -6,6 +6,7 @@ import (
 	context "context"
 
 	datypes "github.com/berachain/beacon-kit/da/types"
+	eip4844 "github.com/berachain/beacon-kit/primitives/eip4844"
 	math "github.com/berachain/beacon-kit/primitives/math"
 	mock "github.com/stretchr/testify/mock"
 )
@@ -23,6 +24,65 @@ func (_m *AvailabilityStore) EXPECT() *AvailabilityStore_Expecter {
 	return &AvailabilityStore_Expecter{mock: &_m.Mock}
 }
 
+// GetBlobSidecar provides a mock function with given fields: slot, commitment
+func (_m *AvailabilityStore) GetBlobSidecar(slot math.U64, commitment eip4844.KZGCommitment) (*datypes.BlobSidecar, error) {
+	ret := _m.Called(slot, commitment)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBlobSidecar")
+	}
+
+	var r0 *datypes.BlobSidecar
+	var r1 error
+	if rf, ok := ret.Get(0).(func(math.U64, eip4844.KZGCommitment) (*datypes.BlobSidecar, error)); ok {
+		return rf(slot, commitment)
+	}
+	if rf, ok := ret.Get(0).(func(math.U64, eip4844.KZGCommitment) *datypes.BlobSidecar); ok {
+		r0 = rf(slot, commitment)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*datypes.BlobSidecar)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(math.U64, eip4844.KZGCommitment) error); ok {
+		r1 = rf(slot, commitment)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AvailabilityStore_GetBlobSidecar_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBlobSidecar'
+type AvailabilityStore_GetBlobSidecar_Call struct {
+	*mock.Call
+}
+
+// GetBlobSidecar is a helper method to define mock.On call
+//   - slot math.U64
+//   - commitment eip4844.KZGCommitment
+func (_e *AvailabilityStore_Expecter) GetBlobSidecar(slot interface{}, commitment interface{}) *AvailabilityStore_GetBlobSidecar_Call {
+	return &AvailabilityStore_GetBlobSidecar_Call{Call: _e.mock.On("GetBlobSidecar", slot, commitment)}
+}
+
+func (_c *AvailabilityStore_GetBlobSidecar_Call) Run(run func(slot math.U64, commitment eip4844.KZGCommitment)) *AvailabilityStore_GetBlobSidecar_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(math.U64), args[1].(eip4844.KZGCommitment))
+	})
+	return _c
+}
+
+func (_c *AvailabilityStore_GetBlobSidecar_Call) Return(_a0 *datypes.BlobSidecar, _a1 error) *AvailabilityStore_GetBlobSidecar_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AvailabilityStore_GetBlobSidecar_Call) RunAndReturn(run func(math.U64, eip4844.KZGCommitment) (*datypes.BlobSidecar, error)) *AvailabilityStore_GetBlobSidecar_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // IsDataAvailable provides a mock function with given fields: _a0, _a1
 func (_m *AvailabilityStore) IsDataAvailable(_a0 context.Context, _a1 math.U64) bool {
 	ret := _m.Called(_a0, _a1)
@@ -7,6 +7,8 @@ import (
 	math "github.com/berachain/beacon-kit/primitives/math"
 
 	mock "github.com/stretchr/testify/mock"
+
+	types "github.com/berachain/beacon-kit/consensus-types/types"
 )
 
 // BlockStore is an autogenerated mock type for the BlockStore type
@@ -22,6 +24,64 @@ func (_m *BlockStore) EXPECT() *BlockStore_Expecter {
 	return &BlockStore_Expecter{mock: &_m.Mock}
 }
 
+// GetBlockBySlot provides a mock function with given fields: slot
+func (_m *BlockStore) GetBlockBySlot(slot math.U64) (*types.BeaconBlock, error) {
+	ret := _m.Called(slot)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBlockBySlot")
+	}
+
+	var r0 *types.BeaconBlock
+	var r1 error
+	if rf, ok := ret.Get(0).(func(math.U64) (*types.BeaconBlock, error)); ok {
+		return rf(slot)
+	}
+	if rf, ok := ret.Get(0).(func(math.U64) *types.BeaconBlock); ok {
+		r0 = rf(slot)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.BeaconBlock)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(math.U64) error); ok {
+		r1 = rf(slot)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// BlockStore_GetBlockBySlot_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBlockBySlot'
+type BlockStore_GetBlockBySlot_Call struct {
+	*mock.Call
+}
+
+// GetBlockBySlot is a helper method to define mock.On call
+//   - slot math.U64
+func (_e *BlockStore_Expecter) GetBlockBySlot(slot interface{}) *BlockStore_GetBlockBySlot_Call {
+	return &BlockStore_GetBlockBySlot_Call{Call: _e.mock.On("GetBlockBySlot", slot)}
+}
+
+func (_c *BlockStore_GetBlockBySlot_Call) Run(run func(slot math.U64)) *BlockStore_GetBlockBySlot_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(math.U64))
+	})
+	return _c
+}
+
+func (_c *BlockStore_GetBlockBySlot_Call) Return(_a0 *types.BeaconBlock, _a1 error) *BlockStore_GetBlockBySlot_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *BlockStore_GetBlockBySlot_Call) RunAndReturn(run func(math.U64) (*types.BeaconBlock, error)) *BlockStore_GetBlockBySlot_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetParentSlotByTimestamp provides a mock function with given fields: timestamp
 func (_m *BlockStore) GetParentSlotByTimestamp(timestamp math.U64) (math.U64, error) {
 	ret := _m.Called(timestamp)
@@ -76,6 +76,96 @@ func (_c *Node_CreateQueryContext_Call[ContextT]) RunAndReturn(run func(int64, b
 	return _c
 }
 
+// HighestPeerHeight provides a mock function with no fields
+func (_m *Node[ContextT]) HighestPeerHeight() int64 {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for HighestPeerHeight")
+	}
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func() int64); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	return r0
+}
+
+// Node_HighestPeerHeight_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HighestPeerHeight'
+type Node_HighestPeerHeight_Call[ContextT any] struct {
+	*mock.Call
+}
+
+// HighestPeerHeight is a helper method to define mock.On call
+func (_e *Node_Expecter[ContextT]) HighestPeerHeight() *Node_HighestPeerHeight_Call[ContextT] {
+	return &Node_HighestPeerHeight_Call[ContextT]{Call: _e.mock.On("HighestPeerHeight")}
+}
+
+func (_c *Node_HighestPeerHeight_Call[ContextT]) Run(run func()) *Node_HighestPeerHeight_Call[ContextT] {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Node_HighestPeerHeight_Call[ContextT]) Return(_a0 int64) *Node_HighestPeerHeight_Call[ContextT] {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Node_HighestPeerHeight_Call[ContextT]) RunAndReturn(run func() int64) *Node_HighestPeerHeight_Call[ContextT] {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IsSyncing provides a mock function with no fields
+func (_m *Node[ContextT]) IsSyncing() bool {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsSyncing")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// Node_IsSyncing_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsSyncing'
+type Node_IsSyncing_Call[ContextT any] struct {
+	*mock.Call
+}
+
+// IsSyncing is a helper method to define mock.On call
+func (_e *Node_Expecter[ContextT]) IsSyncing() *Node_IsSyncing_Call[ContextT] {
+	return &Node_IsSyncing_Call[ContextT]{Call: _e.mock.On("IsSyncing")}
+}
+
+func (_c *Node_IsSyncing_Call[ContextT]) Run(run func()) *Node_IsSyncing_Call[ContextT] {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Node_IsSyncing_Call[ContextT]) Return(_a0 bool) *Node_IsSyncing_Call[ContextT] {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Node_IsSyncing_Call[ContextT]) RunAndReturn(run func() bool) *Node_IsSyncing_Call[ContextT] {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewNode creates a new instance of Node. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewNode[ContextT any](t interface {
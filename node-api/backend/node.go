@@ -0,0 +1,50 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package backend
+
+import (
+	nodetypes "github.com/berachain/beacon-kit/node-api/handlers/node/types"
+)
+
+// SyncingData returns the sync status of the node. The head slot is the slot
+// of the latest finalized block in the beacon state, and the sync distance is
+// measured against the highest block committed by the peers of the node.
+//
+// NOTE: Execution payloads are verified before their block is finalized, so
+// the node is never optimistic.
+func (b Backend[
+	_, _, _, _, _, _, _,
+]) SyncingData() (*nodetypes.SyncingData, error) {
+	_, headSlot, err := b.stateFromSlotRaw(0)
+	if err != nil {
+		return nil, err
+	}
+	data := &nodetypes.SyncingData{
+		HeadSlot:     headSlot.Unwrap(),
+		IsSyncing:    b.node.IsSyncing(),
+		IsOptimistic: false,
+	}
+	//#nosec:G115 // block heights are never negative.
+	if highest := uint64(b.node.HighestPeerHeight()); highest > data.HeadSlot {
+		data.SyncDistance = highest - data.HeadSlot
+	}
+	return data, nil
+}
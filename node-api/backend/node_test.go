@@ -0,0 +1,146 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package backend_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/berachain/beacon-kit/config/spec"
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	dastore "github.com/berachain/beacon-kit/da/store"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/node-api/backend"
+	"github.com/berachain/beacon-kit/node-api/backend/mocks"
+	"github.com/berachain/beacon-kit/node-core/components/storage"
+	"github.com/berachain/beacon-kit/primitives/math"
+	statedb "github.com/berachain/beacon-kit/state-transition/core/state"
+	"github.com/berachain/beacon-kit/storage/beacondb"
+	"github.com/berachain/beacon-kit/storage/block"
+	depositstore "github.com/berachain/beacon-kit/storage/deposit"
+	"github.com/berachain/beacon-kit/storage/encoding"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+)
+
+var errTestQuery = errors.New("query failed")
+
+type (
+	testBlockStore     = block.KVStore[*ctypes.BeaconBlock]
+	testStorageBackend = mocks.StorageBackend[
+		*dastore.Store, *testBlockStore, *depositstore.KVStore,
+	]
+	testNode = mocks.Node[context.Context]
+)
+
+// newSyncingBackend returns a backend over a beacon state at the given slot,
+// queried through the given node.
+func newSyncingBackend(
+	t *testing.T,
+	headSlot math.Slot,
+	node *testNode,
+) *backend.Backend[
+	*dastore.Store, *testBlockStore, context.Context,
+	*depositstore.KVStore, *testNode, any, *testStorageBackend,
+] {
+	t.Helper()
+	cs, err := spec.DevnetChainSpec()
+	require.NoError(t, err)
+
+	kvStore := beacondb.New(
+		storage.NewKVStoreProvider(dbm.NewMemDB()),
+		&encoding.SSZInterfaceCodec[*ctypes.ExecutionPayloadHeader]{},
+	)
+	st := new(statedb.StateDB).NewFromDB(kvStore, cs)
+	require.NoError(t, st.SetSlot(headSlot))
+
+	sb := mocks.NewStorageBackend[
+		*dastore.Store, *testBlockStore, *depositstore.KVStore,
+	](t)
+	sb.EXPECT().StateFromContext(context.Background()).Return(st).Maybe()
+
+	b := backend.New[
+		*dastore.Store, *testBlockStore, context.Context,
+		*depositstore.KVStore, *testNode, any, *testStorageBackend,
+	](sb, cs, mocks.NewStateProcessor(t))
+	b.AttachQueryBackend(node)
+	return b
+}
+
+func TestSyncingData(t *testing.T) {
+	tests := []struct {
+		name              string
+		headSlot          math.Slot
+		isSyncing         bool
+		highestPeerHeight int64
+		expectedDistance  uint64
+	}{
+		{
+			name:              "synced",
+			headSlot:          10,
+			highestPeerHeight: 10,
+		},
+		{
+			name:              "syncing behind peers",
+			headSlot:          10,
+			isSyncing:         true,
+			highestPeerHeight: 25,
+			expectedDistance:  15,
+		},
+		{
+			name:      "no peers",
+			headSlot:  10,
+			isSyncing: true,
+		},
+		{
+			name:              "ahead of peers",
+			headSlot:          10,
+			highestPeerHeight: 8,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			node := mocks.NewNode[context.Context](t)
+			node.EXPECT().CreateQueryContext(int64(0), false).
+				Return(context.Background(), nil)
+			node.EXPECT().IsSyncing().Return(tt.isSyncing)
+			node.EXPECT().HighestPeerHeight().Return(tt.highestPeerHeight)
+			b := newSyncingBackend(t, tt.headSlot, node)
+
+			data, err := b.SyncingData()
+			require.NoError(t, err)
+			require.Equal(t, tt.headSlot.Unwrap(), data.HeadSlot)
+			require.Equal(t, tt.expectedDistance, data.SyncDistance)
+			require.Equal(t, tt.isSyncing, data.IsSyncing)
+			require.False(t, data.IsOptimistic)
+		})
+	}
+}
+
+func TestSyncingDataQueryError(t *testing.T) {
+	node := mocks.NewNode[context.Context](t)
+	node.EXPECT().CreateQueryContext(int64(0), false).
+		Return(context.Background(), errTestQuery)
+	b := newSyncingBackend(t, 10, node)
+
+	_, err := b.SyncingData()
+	require.ErrorIs(t, err, errTestQuery)
+}
@@ -61,3 +61,17 @@ func (b Backend[
 	}
 	return st.GetFork()
 }
+
+// StateAtSlot returns the full beacon state committed at the given slot, as
+// stored in the state DB. The next slot is not processed, so the state matches
+// the app hash committed at that height.
+func (b Backend[
+	_, _, _, _, _, _, _,
+]) StateAtSlot(slot math.Slot) (*ctypes.BeaconState, math.Slot, error) {
+	st, slot, err := b.stateFromSlotRaw(slot)
+	if err != nil {
+		return nil, slot, err
+	}
+	bs, err := st.GetMarshallable()
+	return bs, slot, err
+}
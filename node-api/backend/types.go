@@ -26,6 +26,8 @@ import (
 	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
 	datypes "github.com/berachain/beacon-kit/da/types"
 	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/eip4844"
 	"github.com/berachain/beacon-kit/primitives/math"
 	"github.com/berachain/beacon-kit/primitives/transition"
 	statedb "github.com/berachain/beacon-kit/state-transition/core/state"
@@ -41,10 +43,23 @@ type AvailabilityStore interface {
 	// Persist makes sure that the sidecar remains accessible for data
 	// availability checks throughout the beacon node's operation.
 	Persist(math.Slot, datypes.BlobSidecars) error
+	// GetBlobSidecar returns the sidecar stored at the given slot for the
+	// given commitment.
+	GetBlobSidecar(
+		slot math.Slot, commitment eip4844.KZGCommitment,
+	) (*datypes.BlobSidecar, error)
 }
 
 // BlockStore is the interface for block storage.
 type BlockStore interface {
+	// GetBlockBySlot retrieves the finalized block at the given slot.
+	GetBlockBySlot(slot math.Slot) (*ctypes.BeaconBlock, error)
+	// GetHeaderBySlot retrieves the header of the finalized block at the
+	// given slot.
+	GetHeaderBySlot(slot math.Slot) (*ctypes.BeaconBlockHeader, error)
+	// GetSignatureBySlot retrieves the proposer signature of the finalized
+	// block at the given slot, if known.
+	GetSignatureBySlot(slot math.Slot) (crypto.BLSSignature, error)
 	// GetSlotByBlockRoot retrieves the slot by a given block root.
 	GetSlotByBlockRoot(root common.Root) (math.Slot, error)
 	// GetSlotByStateRoot retrieves the slot by a given state root.
@@ -61,6 +76,46 @@ type DepositStore interface {
 	Prune(start, end uint64) error
 	// EnqueueDeposits adds a list of deposits to the deposit store.
 	EnqueueDeposits(deposits []*ctypes.Deposit) error
+	// GetEth1BlockCursor returns the highest execution block up to which
+	// every block has either been processed or recorded as failed.
+	GetEth1BlockCursor() (uint64, error)
+	// SetEth1BlockCursor sets the highest execution block up to which every
+	// block has either been processed or recorded as failed.
+	SetEth1BlockCursor(blockNum uint64) error
+	// GetFailedEth1Blocks returns the execution blocks whose deposits failed
+	// to be fetched.
+	GetFailedEth1Blocks() ([]uint64, error)
+	// AddFailedEth1Block records that the deposits of the given execution
+	// block failed to be fetched.
+	AddFailedEth1Block(blockNum uint64) error
+	// RemoveFailedEth1Block removes the given execution block from the
+	// failed set.
+	RemoveFailedEth1Block(blockNum uint64) error
+	// MarkEth1BlocksMissing records the execution blocks after the cursor up
+	// to the given one as a missing range, and moves the cursor to it.
+	MarkEth1BlocksMissing(blockNum uint64) error
+	// TakeMissingEth1Blocks moves up to limit missing execution blocks into
+	// the failed set and returns them.
+	TakeMissingEth1Blocks(limit uint64) ([]uint64, error)
+	// GetOldestMissingEth1Block returns the lowest failed or missing
+	// execution block, and whether there is any.
+	GetOldestMissingEth1Block() (uint64, bool, error)
+	// EnqueueWithdrawalRequests adds a list of withdrawal requests to the
+	// deposit store.
+	EnqueueWithdrawalRequests(requests []*ctypes.WithdrawalRequest) error
+	// GetWithdrawalRequestsByIndex returns `numView` expected withdrawal
+	// requests.
+	GetWithdrawalRequestsByIndex(
+		startIndex uint64,
+		numView uint64,
+	) (ctypes.WithdrawalRequests, error)
+	// PruneWithdrawalRequests prunes the withdrawal requests of [start, end)
+	// from the deposit store.
+	PruneWithdrawalRequests(start, end uint64) error
+	// RecordCheckpoint records the deposit cursor, the number of deposits
+	// and the number of withdrawal requests at the given consensus height,
+	// which bound state sync snapshots.
+	RecordCheckpoint(height uint64) error
 }
 
 // Node is the interface for a node.
@@ -68,6 +123,11 @@ type Node[ContextT any] interface {
 	// CreateQueryContext creates a query context for a given height and proof
 	// flag.
 	CreateQueryContext(height int64, prove bool) (ContextT, error)
+	// IsSyncing returns true if the node is catching up with the network.
+	IsSyncing() bool
+	// HighestPeerHeight returns the highest committed block height reported
+	// by the peers of the node.
+	HighestPeerHeight() int64
 }
 
 type StateProcessor interface {
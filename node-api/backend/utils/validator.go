@@ -22,7 +22,11 @@ package utils
 
 import (
 	"strconv"
+	"strings"
 
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	beacontypes "github.com/berachain/beacon-kit/node-api/handlers/beacon/types"
+	"github.com/berachain/beacon-kit/primitives/constants"
 	"github.com/berachain/beacon-kit/primitives/crypto"
 	"github.com/berachain/beacon-kit/primitives/math"
 	statedb "github.com/berachain/beacon-kit/state-transition/core/state"
@@ -41,3 +45,104 @@ func ValidatorIndexByID(st *statedb.StateDB, keyOrIndex string) (math.U64, error
 	}
 	return st.ValidatorIndexByPubkey(key)
 }
+
+// ValidatorIndicesByIDs resolves the given validator indices or pubkeys to
+// indices of the given registry, in the order they are given. Duplicates and
+// IDs that are not in the registry are skipped. All the validators of the
+// registry are returned if no IDs are given.
+func ValidatorIndicesByIDs(
+	validators ctypes.Validators, ids []string,
+) ([]math.ValidatorIndex, error) {
+	if len(ids) == 0 {
+		indices := make([]math.ValidatorIndex, len(validators))
+		for i := range validators {
+			indices[i] = math.ValidatorIndex(i)
+		}
+		return indices, nil
+	}
+
+	var byPubkey map[crypto.BLSPubkey]math.ValidatorIndex
+	seen := make(map[math.ValidatorIndex]struct{}, len(ids))
+	indices := make([]math.ValidatorIndex, 0, len(ids))
+	for _, id := range ids {
+		index, err := strconv.ParseUint(id, 10, 64)
+		if err != nil {
+			var key crypto.BLSPubkey
+			if err = key.UnmarshalText([]byte(id)); err != nil {
+				return nil, err
+			}
+			// Index the registry by pubkey once, on the first pubkey ID.
+			if byPubkey == nil {
+				byPubkey = make(
+					map[crypto.BLSPubkey]math.ValidatorIndex, len(validators),
+				)
+				for i, v := range validators {
+					byPubkey[v.GetPubkey()] = math.ValidatorIndex(i)
+				}
+			}
+			idx, found := byPubkey[key]
+			if !found {
+				continue
+			}
+			index = idx.Unwrap()
+		}
+		if index >= uint64(len(validators)) {
+			continue
+		}
+		if _, found := seen[math.ValidatorIndex(index)]; found {
+			continue
+		}
+		seen[math.ValidatorIndex(index)] = struct{}{}
+		indices = append(indices, math.ValidatorIndex(index))
+	}
+	return indices, nil
+}
+
+// ValidatorStatus returns the status of the validator at the given epoch, as
+// defined by the Beacon Node API.
+// https://hackmd.io/ofFJ5gOmQpu1jjHilHbdQQ
+func ValidatorStatus(
+	v *ctypes.Validator, balance math.Gwei, epoch math.Epoch,
+) string {
+	farFutureEpoch := math.Epoch(constants.FarFutureEpoch)
+	switch {
+	case epoch < v.GetActivationEpoch():
+		if v.GetActivationEligibilityEpoch() == farFutureEpoch {
+			return beacontypes.ValidatorStatusPendingInitialized
+		}
+		return beacontypes.ValidatorStatusPendingQueued
+	case epoch < v.GetExitEpoch():
+		if v.GetExitEpoch() == farFutureEpoch {
+			return beacontypes.ValidatorStatusActiveOngoing
+		}
+		if v.IsSlashed() {
+			return beacontypes.ValidatorStatusActiveSlashed
+		}
+		return beacontypes.ValidatorStatusActiveExiting
+	case epoch < v.GetWithdrawableEpoch():
+		if v.IsSlashed() {
+			return beacontypes.ValidatorStatusExitedSlashed
+		}
+		return beacontypes.ValidatorStatusExitedUnslashed
+	case balance != 0:
+		return beacontypes.ValidatorStatusWithdrawalPossible
+	default:
+		return beacontypes.ValidatorStatusWithdrawalDone
+	}
+}
+
+// StatusMatches returns whether the status matches one of the given statuses,
+// either exactly or by its general status, e.g. `active` for
+// `active_ongoing`. Every status matches if no statuses are given.
+func StatusMatches(status string, statuses []string) bool {
+	if len(statuses) == 0 {
+		return true
+	}
+	general, _, _ := strings.Cut(status, "_")
+	for _, s := range statuses {
+		if s == status || s == general {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,195 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package utils_test
+
+import (
+	"testing"
+
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/node-api/backend/utils"
+	beacontypes "github.com/berachain/beacon-kit/node-api/handlers/beacon/types"
+	"github.com/berachain/beacon-kit/primitives/constants"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/stretchr/testify/require"
+)
+
+//nolint:funlen // table test.
+func TestValidatorStatus(t *testing.T) {
+	farFuture := math.Epoch(constants.FarFutureEpoch)
+	const epoch = math.Epoch(10)
+
+	testCases := []struct {
+		name      string
+		validator *ctypes.Validator
+		balance   math.Gwei
+		expected  string
+	}{
+		{
+			name: "pending initialized",
+			validator: &ctypes.Validator{
+				ActivationEligibilityEpoch: farFuture,
+				ActivationEpoch:            farFuture,
+				ExitEpoch:                  farFuture,
+				WithdrawableEpoch:          farFuture,
+			},
+			balance:  32e9,
+			expected: beacontypes.ValidatorStatusPendingInitialized,
+		},
+		{
+			name: "pending queued",
+			validator: &ctypes.Validator{
+				ActivationEligibilityEpoch: 9,
+				ActivationEpoch:            11,
+				ExitEpoch:                  farFuture,
+				WithdrawableEpoch:          farFuture,
+			},
+			balance:  32e9,
+			expected: beacontypes.ValidatorStatusPendingQueued,
+		},
+		{
+			name: "active ongoing",
+			validator: &ctypes.Validator{
+				ActivationEpoch:   10,
+				ExitEpoch:         farFuture,
+				WithdrawableEpoch: farFuture,
+			},
+			balance:  32e9,
+			expected: beacontypes.ValidatorStatusActiveOngoing,
+		},
+		{
+			name: "active exiting",
+			validator: &ctypes.Validator{
+				ActivationEpoch:   1,
+				ExitEpoch:         11,
+				WithdrawableEpoch: 20,
+			},
+			balance:  32e9,
+			expected: beacontypes.ValidatorStatusActiveExiting,
+		},
+		{
+			name: "active slashed",
+			validator: &ctypes.Validator{
+				Slashed:           true,
+				ActivationEpoch:   1,
+				ExitEpoch:         11,
+				WithdrawableEpoch: 20,
+			},
+			balance:  31e9,
+			expected: beacontypes.ValidatorStatusActiveSlashed,
+		},
+		{
+			name: "exited unslashed",
+			validator: &ctypes.Validator{
+				ActivationEpoch:   1,
+				ExitEpoch:         10,
+				WithdrawableEpoch: 20,
+			},
+			balance:  32e9,
+			expected: beacontypes.ValidatorStatusExitedUnslashed,
+		},
+		{
+			name: "exited slashed",
+			validator: &ctypes.Validator{
+				Slashed:           true,
+				ActivationEpoch:   1,
+				ExitEpoch:         5,
+				WithdrawableEpoch: 11,
+			},
+			balance:  31e9,
+			expected: beacontypes.ValidatorStatusExitedSlashed,
+		},
+		{
+			name: "withdrawal possible",
+			validator: &ctypes.Validator{
+				ActivationEpoch:   1,
+				ExitEpoch:         5,
+				WithdrawableEpoch: 10,
+			},
+			balance:  32e9,
+			expected: beacontypes.ValidatorStatusWithdrawalPossible,
+		},
+		{
+			name: "withdrawal done",
+			validator: &ctypes.Validator{
+				ActivationEpoch:   1,
+				ExitEpoch:         5,
+				WithdrawableEpoch: 10,
+			},
+			balance:  0,
+			expected: beacontypes.ValidatorStatusWithdrawalDone,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t,
+				tc.expected, utils.ValidatorStatus(tc.validator, tc.balance, epoch),
+			)
+		})
+	}
+}
+
+func TestStatusMatches(t *testing.T) {
+	status := beacontypes.ValidatorStatusActiveExiting
+	require.True(t, utils.StatusMatches(status, nil))
+	require.True(t, utils.StatusMatches(status, []string{status}))
+	require.True(t, utils.StatusMatches(
+		status, []string{beacontypes.ValidatorStatusActive},
+	))
+	require.True(t, utils.StatusMatches(status, []string{
+		beacontypes.ValidatorStatusPending,
+		beacontypes.ValidatorStatusActiveExiting,
+	}))
+	require.False(t, utils.StatusMatches(
+		status, []string{beacontypes.ValidatorStatusActiveOngoing},
+	))
+	require.False(t, utils.StatusMatches(
+		status, []string{beacontypes.ValidatorStatusExited},
+	))
+}
+
+func TestValidatorIndicesByIDs(t *testing.T) {
+	validators := ctypes.Validators{
+		{Pubkey: crypto.BLSPubkey{0x01}},
+		{Pubkey: crypto.BLSPubkey{0x02}},
+		{Pubkey: crypto.BLSPubkey{0x03}},
+	}
+
+	indices, err := utils.ValidatorIndicesByIDs(validators, nil)
+	require.NoError(t, err)
+	require.Equal(t, []math.ValidatorIndex{0, 1, 2}, indices)
+
+	pubkey, err := crypto.BLSPubkey{0x03}.MarshalText()
+	require.NoError(t, err)
+	unknown, err := crypto.BLSPubkey{0x04}.MarshalText()
+	require.NoError(t, err)
+
+	// IDs keep their order, and unknown IDs and duplicates are skipped.
+	indices, err = utils.ValidatorIndicesByIDs(validators, []string{
+		string(pubkey), "1", "7", string(unknown), "2", "1",
+	})
+	require.NoError(t, err)
+	require.Equal(t, []math.ValidatorIndex{2, 1}, indices)
+
+	_, err = utils.ValidatorIndicesByIDs(validators, []string{"0xzz"})
+	require.Error(t, err)
+}
@@ -34,7 +34,7 @@ func (b Backend[
 	// TODO: to adhere to the spec, this shouldn't error if the error
 	// is not found, but i can't think of a way to do that without coupling
 	// db impl to the api impl.
-	st, _, err := b.stateFromSlot(slot)
+	st, slot, err := b.stateFromSlot(slot)
 	if err != nil {
 		return nil, err
 	}
@@ -55,27 +55,56 @@ func (b Backend[
 			Index:   index.Unwrap(),
 			Balance: balance.Unwrap(),
 		},
-		Status:    "active_ongoing", // TODO: fix
+		Status: utils.ValidatorStatus(
+			validator, balance, b.cs.SlotToEpoch(slot),
+		),
 		Validator: validator,
 	}, nil
 }
 
-// TODO: filter by status
+// ValidatorsByIDs returns the validators with the given indices or pubkeys,
+// or all the validators if no IDs are given, keeping those whose status
+// matches one of the given statuses. The registry and balances are read in
+// bulk, and IDs that are not in the registry are skipped.
 func (b Backend[
 	_, _, _, _, _, _, _,
 ]) ValidatorsByIDs(
-	slot math.Slot, ids []string, _ []string,
+	slot math.Slot, ids []string, statuses []string,
 ) ([]*beacontypes.ValidatorData, error) {
-	validatorsData := make([]*beacontypes.ValidatorData, 0)
-	for _, id := range ids {
-		// TODO: we can probably optimize this via a getAllValidators
-		// query and then filtering but blocked by the fact that IDs
-		// can be indices and the hard type only holds its own pubkey.
-		validatorData, err := b.ValidatorByID(slot, id)
-		if err != nil {
-			return nil, err
+	st, slot, err := b.stateFromSlot(slot)
+	if err != nil {
+		return nil, err
+	}
+	validators, err := st.GetValidators()
+	if err != nil {
+		return nil, err
+	}
+	balances, err := st.GetBalances()
+	if err != nil {
+		return nil, err
+	}
+	indices, err := utils.ValidatorIndicesByIDs(validators, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	epoch := b.cs.SlotToEpoch(slot)
+	validatorsData := make([]*beacontypes.ValidatorData, 0, len(indices))
+	for _, index := range indices {
+		validator := validators[index]
+		balance := math.Gwei(balances[index])
+		status := utils.ValidatorStatus(validator, balance, epoch)
+		if !utils.StatusMatches(status, statuses) {
+			continue
 		}
-		validatorsData = append(validatorsData, validatorData)
+		validatorsData = append(validatorsData, &beacontypes.ValidatorData{
+			ValidatorBalanceData: beacontypes.ValidatorBalanceData{
+				Index:   index.Unwrap(),
+				Balance: balance.Unwrap(),
+			},
+			Status:    status,
+			Validator: validator,
+		})
 	}
 	return validatorsData, nil
 }
@@ -0,0 +1,57 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package backend
+
+import (
+	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
+	"github.com/berachain/beacon-kit/errors"
+	buildertypes "github.com/berachain/beacon-kit/node-api/handlers/builder/types"
+	"github.com/berachain/beacon-kit/primitives/math"
+)
+
+// ExpectedWithdrawalsAtSlot returns the withdrawals expected in the payload
+// of the block proposed at proposalSlot, on top of the state at the given
+// slot. The proposal slot defaults to the next slot and is bounded to the
+// following epoch, the state being processed up to it.
+func (b Backend[
+	_, _, _, _, _, _, _,
+]) ExpectedWithdrawalsAtSlot(
+	slot, proposalSlot math.Slot,
+) (engineprimitives.Withdrawals, error) {
+	st, slot, err := b.stateFromSlotRaw(slot)
+	if err != nil {
+		return nil, err
+	}
+	if proposalSlot == 0 {
+		proposalSlot = slot + 1
+	}
+	if proposalSlot <= slot ||
+		proposalSlot > slot+math.Slot(b.cs.SlotsPerEpoch()) {
+		return nil, errors.Wrapf(
+			buildertypes.ErrInvalidProposalSlot,
+			"proposal slot %d, state slot %d", proposalSlot, slot,
+		)
+	}
+	if _, err = b.sp.ProcessSlots(st, proposalSlot); err != nil {
+		return nil, err
+	}
+	return st.ExpectedWithdrawals()
+}
@@ -20,16 +20,30 @@
 
 package blockstore
 
+import (
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/storage/pruner"
+)
+
 const (
 	DefaultAvailabilityWindow = 8192
+	DefaultRetentionMode      = pruner.RetentionWindow
 )
 
+// ErrInvalidRetentionMode is returned when the retention mode is neither
+// archive nor window.
+var ErrInvalidRetentionMode = errors.New("invalid block store retention mode")
+
 // Config is the configuration for the block service.
 type Config struct {
 	// Enabled enables the block service.
 	Enabled bool `mapstructure:"enabled"`
-	// AvailabilityWindow is the number of slots to keep in the store.
+	// AvailabilityWindow is the number of slots for which blocks, headers
+	// and their indices are kept when the retention mode is window.
 	AvailabilityWindow int `mapstructure:"availability-window"`
+	// RetentionMode is either archive, which keeps every finalized block, or
+	// window, which prunes blocks older than the availability window.
+	RetentionMode string `mapstructure:"retention-mode"`
 }
 
 // DefaultConfig returns the default configuration for the block service.
@@ -37,5 +51,16 @@ func DefaultConfig() Config {
 	return Config{
 		Enabled:            false,
 		AvailabilityWindow: DefaultAvailabilityWindow,
+		RetentionMode:      DefaultRetentionMode,
+	}
+}
+
+// Validate checks that the retention mode is supported.
+func (c Config) Validate() error {
+	switch c.RetentionMode {
+	case pruner.RetentionArchive, pruner.RetentionWindow:
+		return nil
+	default:
+		return errors.Wrapf(ErrInvalidRetentionMode, "%q", c.RetentionMode)
 	}
 }
@@ -22,10 +22,18 @@ package blockstore
 
 import (
 	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/math"
 )
 
 // BlockStore is a generic interface for a block store.
 type BlockStore interface {
 	// Set sets a block at a given index.
 	Set(blk *ctypes.BeaconBlock) error
+	// SetSignature sets the proposer signature of the block at a given slot.
+	SetSignature(slot math.Slot, signature crypto.BLSSignature) error
+	// Prune prunes the blocks of the slots in [start, end).
+	Prune(start, end uint64) error
+	// Close closes the block store.
+	Close() error
 }
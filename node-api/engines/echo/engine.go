@@ -21,16 +21,20 @@
 package echo
 
 import (
+	"crypto/tls"
+
 	"github.com/berachain/beacon-kit/log"
 	"github.com/berachain/beacon-kit/node-api/handlers"
+	"github.com/berachain/beacon-kit/node-api/server"
+	"github.com/berachain/beacon-kit/primitives/net/jwt"
 	"github.com/labstack/echo/v4"
-	"github.com/labstack/echo/v4/middleware"
 )
 
 // Engine is an implementation of the API engine interface using Echo.
 type Engine struct {
 	*echo.Echo
 	logger log.Logger
+	tls    server.TLSConfig
 }
 
 // New initializes a new API engine with the given Echo instance.
@@ -40,21 +44,60 @@ func New(e *echo.Echo) *Engine {
 	}
 }
 
-// NewDefaultEngine returns a new default Echo Engine instance.
-func NewDefaultEngine() *Engine {
+// NewDefaultEngine returns a new default Echo Engine instance, protected by
+// the CORS, rate limiting, route filtering and authentication middleware
+// described by the given config. The JWT secret is only required when the
+// auth mode is "jwt".
+func NewDefaultEngine(
+	cfg server.Config,
+	jwtSecret *jwt.Secret,
+) (*Engine, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	if cfg.TLS.Enabled() {
+		// Fail at startup rather than when the server starts listening.
+		if _, err := tls.LoadX509KeyPair(
+			cfg.TLS.CertFile, cfg.TLS.KeyFile,
+		); err != nil {
+			return nil, err
+		}
+	}
+
 	engine := echo.New()
-	engine.Use(middleware.CORSWithConfig(
-		middleware.DefaultCORSConfig,
-	))
+	// Rate limits are keyed by the peer address, so never trust
+	// client-provided forwarding headers.
+	engine.IPExtractor = echo.ExtractIPDirect()
+	engine.Use(corsMiddleware(cfg.CORS))
+	if cfg.RateLimit.RequestsPerSecond > 0 {
+		engine.Use(rateLimitMiddleware(cfg.RateLimit))
+	}
+	if len(cfg.Routes.Allow) > 0 || len(cfg.Routes.Deny) > 0 {
+		engine.Use(routeFilterMiddleware(cfg.Routes))
+	}
+	authMW, err := authMiddleware(cfg.Auth, jwtSecret)
+	if err != nil {
+		return nil, err
+	}
+	if authMW != nil {
+		engine.Use(authMW)
+	}
 	engine.Validator = &CustomValidator{
 		Validator: ConstructValidator(),
 	}
 	engine.HideBanner = true
-	return New(engine)
+
+	e := New(engine)
+	e.tls = cfg.TLS
+	return e, nil
 }
 
-// Run starts the Echo engine at the given address.
+// Run starts the Echo engine at the given address, serving over TLS if it
+// is configured.
 func (e *Engine) Run(addr string) error {
+	if e.tls.Enabled() {
+		return e.Echo.StartTLS(addr, e.tls.CertFile, e.tls.KeyFile)
+	}
 	return e.Echo.Start(addr)
 }
 
@@ -21,11 +21,15 @@
 package echo
 
 import (
+	"mime"
 	"net/http"
+	"strconv"
+	"strings"
 
 	"github.com/berachain/beacon-kit/errors"
 	"github.com/berachain/beacon-kit/node-api/handlers"
 	"github.com/berachain/beacon-kit/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/primitives/constraints"
 	"github.com/labstack/echo/v4"
 )
 
@@ -35,16 +39,115 @@ type ErrorResponse struct {
 	Message string `json:"message"`
 }
 
+// HeaderConsensusVersion is the header carrying the fork version of
+// versioned responses.
+const HeaderConsensusVersion = "Eth-Consensus-Version"
+
 // responseMiddleware is a middleware that converts errors to an HTTP status
-// code and response.
+// code and response. Successful responses are SSZ encoded if the client
+// prefers it and the response supports it, and JSON encoded otherwise.
 func responseMiddleware(
 	handler *handlers.Route[Context],
 ) echo.HandlerFunc {
 	return func(c Context) error {
 		data, err := handler.Handler(c)
-		code, response := responseFromError(data, err)
-		return c.JSON(code, response)
+		if stream, ok := data.(types.Streamer); ok && err == nil {
+			return writeStream(c, stream)
+		}
+		if status, ok := data.(types.StatusResponse); ok && err == nil {
+			return c.NoContent(status.Code)
+		}
+		if err != nil {
+			code, response := responseFromError(data, err)
+			return c.JSON(code, response)
+		}
+		if versioned, ok := data.(types.Versioned); ok {
+			c.Response().Header().Set(
+				HeaderConsensusVersion, versioned.ConsensusVersion(),
+			)
+		}
+		preferSSZ, acceptsJSON := negotiate(
+			c.Request().Header.Get(echo.HeaderAccept),
+		)
+		if preferSSZ {
+			if ssz, ok := data.(constraints.SSZMarshaler); ok {
+				var bz []byte
+				bz, err = ssz.MarshalSSZ()
+				switch {
+				case err == nil:
+					return c.Blob(http.StatusOK, echo.MIMEOctetStream, bz)
+				case !errors.Is(err, types.ErrSSZNotSupported):
+					code, response := responseFromError(nil, err)
+					return c.JSON(code, response)
+				}
+			}
+		}
+		if !acceptsJSON {
+			return c.JSON(http.StatusNotAcceptable, ErrorResponse{
+				Code:    http.StatusNotAcceptable,
+				Message: "response is only available as JSON",
+			})
+		}
+		return c.JSON(http.StatusOK, data)
+	}
+}
+
+// writeStream hands the connection over to the given stream, flushing the
+// headers first so that the client knows the stream has been established.
+func writeStream(c Context, stream types.Streamer) error {
+	res := c.Response()
+	res.Header().Set(echo.HeaderContentType, stream.ContentType())
+	res.Header().Set(echo.HeaderCacheControl, "no-cache")
+	res.Header().Set(echo.HeaderConnection, "keep-alive")
+	res.WriteHeader(http.StatusOK)
+	res.Flush()
+	return stream.Stream(c.Request().Context(), res, res.Flush)
+}
+
+// negotiate parses the Accept header and reports whether the client prefers
+// an SSZ response, and whether it accepts a JSON response. SSZ is preferred
+// when application/octet-stream has the highest quality, ties being broken
+// in favour of the media type listed first, wildcards counting as listed
+// last. An empty Accept header accepts JSON only.
+func negotiate(accept string) (bool, bool) {
+	if strings.TrimSpace(accept) == "" {
+		return false, true
+	}
+	var (
+		parts            = strings.Split(accept, ",")
+		qSSZ, qJSON      float64
+		posSSZ, posJSON  = len(parts), len(parts)
+		wildcardPosition = len(parts)
+	)
+	for i, part := range parts {
+		mediaType, params, err := mime.ParseMediaType(strings.TrimSpace(part))
+		if err != nil {
+			continue
+		}
+		q := 1.0
+		if raw, ok := params["q"]; ok {
+			if q, err = strconv.ParseFloat(raw, 64); err != nil {
+				continue
+			}
+		}
+		switch mediaType {
+		case echo.MIMEOctetStream:
+			if q > qSSZ {
+				qSSZ, posSSZ = q, i
+			}
+		case echo.MIMEApplicationJSON:
+			if q > qJSON || (q == qJSON && posJSON == wildcardPosition) {
+				qJSON, posJSON = q, i
+			}
+		case "application/*", "*/*":
+			if q > qJSON {
+				qJSON, posJSON = q, wildcardPosition
+			}
+		}
 	}
+	preferSSZ := qSSZ > 0 &&
+		(qSSZ > qJSON || (qSSZ == qJSON && posSSZ < posJSON))
+	return preferSSZ, qJSON > 0
 }
 
 // responseFromErr converts an error to an HTTP status code and response. If
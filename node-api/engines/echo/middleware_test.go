@@ -0,0 +1,156 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package echo_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/berachain/beacon-kit/log"
+	"github.com/berachain/beacon-kit/log/noop"
+	"github.com/berachain/beacon-kit/node-api/engines/echo"
+	"github.com/berachain/beacon-kit/node-api/handlers"
+	beacontypes "github.com/berachain/beacon-kit/node-api/handlers/beacon/types"
+	"github.com/berachain/beacon-kit/node-api/server"
+	"github.com/stretchr/testify/require"
+)
+
+// newNegotiationEngine serves a versioned SSZ capable response and a JSON
+// only response.
+func newNegotiationEngine(t *testing.T) *echo.Engine {
+	t.Helper()
+	engine, err := echo.NewDefaultEngine(server.DefaultConfig(), nil)
+	require.NoError(t, err)
+	engine.RegisterRoutes(handlers.NewRouteSet(
+		"",
+		&handlers.Route[echo.Context]{
+			Method: http.MethodGet,
+			Path:   "/ssz",
+			Handler: func(echo.Context) (any, error) {
+				return &beacontypes.BlockResponse{
+					Version: "deneb",
+					ValidatorResponse: beacontypes.ValidatorResponse{
+						Data: beacontypes.ValidatorBalancesData{
+							{Index: 1, Balance: 2},
+						},
+					},
+				}, nil
+			},
+		},
+		&handlers.Route[echo.Context]{
+			Method: http.MethodGet,
+			Path:   "/json",
+			Handler: func(echo.Context) (any, error) {
+				return beacontypes.ValidatorResponse{
+					Data: beacontypes.RootData{},
+				}, nil
+			},
+		},
+	), noop.NewLogger[log.Logger]())
+	return engine
+}
+
+func TestContentNegotiation(t *testing.T) {
+	engine := newNegotiationEngine(t)
+	sszBody := []byte{1, 0, 0, 0, 0, 0, 0, 0, 2, 0, 0, 0, 0, 0, 0, 0}
+
+	tests := []struct {
+		name        string
+		path        string
+		accept      string
+		wantCode    int
+		wantSSZ     bool
+		wantVersion string
+	}{
+		{
+			name:        "no accept header",
+			path:        "/ssz",
+			wantCode:    http.StatusOK,
+			wantVersion: "deneb",
+		},
+		{
+			name:        "ssz requested",
+			path:        "/ssz",
+			accept:      "application/octet-stream",
+			wantCode:    http.StatusOK,
+			wantSSZ:     true,
+			wantVersion: "deneb",
+		},
+		{
+			name:        "ssz preferred over wildcard",
+			path:        "/ssz",
+			accept:      "*/*, application/octet-stream",
+			wantCode:    http.StatusOK,
+			wantSSZ:     true,
+			wantVersion: "deneb",
+		},
+		{
+			name:        "json preferred by quality",
+			path:        "/ssz",
+			accept:      "application/octet-stream;q=0.5, application/json",
+			wantCode:    http.StatusOK,
+			wantVersion: "deneb",
+		},
+		{
+			name:        "json listed first",
+			path:        "/ssz",
+			accept:      "application/json, application/octet-stream",
+			wantCode:    http.StatusOK,
+			wantVersion: "deneb",
+		},
+		{
+			name:     "json fallback",
+			path:     "/json",
+			accept:   "application/octet-stream, application/json;q=0.9",
+			wantCode: http.StatusOK,
+		},
+		{
+			name:     "ssz only on json route",
+			path:     "/json",
+			accept:   "application/octet-stream",
+			wantCode: http.StatusNotAcceptable,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			headers := map[string]string{}
+			if tt.accept != "" {
+				headers["Accept"] = tt.accept
+			}
+			rec := serve(engine, tt.path, headers)
+			require.Equal(t, tt.wantCode, rec.Code)
+			require.Equal(t,
+				tt.wantVersion, rec.Header().Get(echo.HeaderConsensusVersion),
+			)
+			if tt.wantSSZ {
+				require.Equal(t,
+					"application/octet-stream",
+					rec.Header().Get("Content-Type"),
+				)
+				require.Equal(t, sszBody, rec.Body.Bytes())
+				return
+			}
+			require.Contains(t,
+				rec.Header().Get("Content-Type"), "application/json",
+			)
+		})
+	}
+}
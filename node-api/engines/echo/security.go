@@ -0,0 +1,185 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package echo
+
+import (
+	"crypto/subtle"
+	"net/http"
+	"path"
+	"strings"
+
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/node-api/server"
+	"github.com/berachain/beacon-kit/primitives/net/jwt"
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/echo/v4/middleware"
+	"golang.org/x/time/rate"
+)
+
+// bearerPrefix is the prefix of the Authorization header value.
+const bearerPrefix = "Bearer "
+
+// errMissingJWTSecret is returned when JWT auth is enabled without a secret.
+var errMissingJWTSecret = errors.New("node api jwt auth requires a secret")
+
+// corsMiddleware returns the CORS middleware for the given config.
+func corsMiddleware(cfg server.CORSConfig) echo.MiddlewareFunc {
+	corsCfg := middleware.DefaultCORSConfig
+	if len(cfg.AllowedOrigins) > 0 {
+		corsCfg.AllowOrigins = cfg.AllowedOrigins
+	}
+	if len(cfg.AllowedMethods) > 0 {
+		corsCfg.AllowMethods = cfg.AllowedMethods
+	}
+	corsCfg.AllowHeaders = cfg.AllowedHeaders
+	corsCfg.ExposeHeaders = []string{HeaderConsensusVersion}
+	return middleware.CORSWithConfig(corsCfg)
+}
+
+// rateLimitMiddleware returns a middleware limiting the number of requests
+// served to each client IP.
+func rateLimitMiddleware(cfg server.RateLimitConfig) echo.MiddlewareFunc {
+	burst := cfg.Burst
+	if burst == 0 {
+		// A zero burst would reject every request.
+		burst = 1
+	}
+	return middleware.RateLimiterWithConfig(middleware.RateLimiterConfig{
+		Skipper: middleware.DefaultSkipper,
+		Store: middleware.NewRateLimiterMemoryStoreWithConfig(
+			middleware.RateLimiterMemoryStoreConfig{
+				Rate:      rate.Limit(cfg.RequestsPerSecond),
+				Burst:     burst,
+				ExpiresIn: cfg.ExpiresIn,
+			},
+		),
+		IdentifierExtractor: func(c echo.Context) (string, error) {
+			return c.RealIP(), nil
+		},
+		ErrorHandler: func(c echo.Context, err error) error {
+			return errorResponse(c, http.StatusForbidden, err.Error())
+		},
+		DenyHandler: func(c echo.Context, _ string, _ error) error {
+			return errorResponse(
+				c, http.StatusTooManyRequests, "too many requests",
+			)
+		},
+	})
+}
+
+// routeFilterMiddleware returns a middleware rejecting requests whose path
+// is denied, or is not allowed when an allow list is configured.
+func routeFilterMiddleware(cfg server.RoutesConfig) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if !routeAllowed(cfg, c.Request().URL.Path) {
+				return errorResponse(
+					c, http.StatusForbidden, "route is not allowed",
+				)
+			}
+			return next(c)
+		}
+	}
+}
+
+// routeAllowed returns true if the given path may be served.
+func routeAllowed(cfg server.RoutesConfig, p string) bool {
+	for _, pattern := range cfg.Deny {
+		if matchRoute(pattern, p) {
+			return false
+		}
+	}
+	if len(cfg.Allow) == 0 {
+		return true
+	}
+	for _, pattern := range cfg.Allow {
+		if matchRoute(pattern, p) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchRoute matches the path against a path.Match pattern, where a trailing
+// "*" matches any suffix including further path segments.
+func matchRoute(pattern, p string) bool {
+	if prefix, ok := strings.CutSuffix(pattern, "*"); ok &&
+		strings.HasPrefix(p, prefix) {
+		return true
+	}
+	matched, err := path.Match(pattern, p)
+	return err == nil && matched
+}
+
+// authMiddleware returns the authentication middleware for the given config,
+// or nil if authentication is disabled.
+func authMiddleware(
+	cfg server.AuthConfig,
+	jwtSecret *jwt.Secret,
+) (echo.MiddlewareFunc, error) {
+	var verify func(token string) bool
+	switch cfg.Mode {
+	case "", server.AuthModeNone:
+		return nil, nil //nolint:nilnil // no middleware when disabled.
+	case server.AuthModeBearer:
+		tokens := cfg.BearerTokens
+		verify = func(token string) bool {
+			for _, expected := range tokens {
+				if subtle.ConstantTimeCompare(
+					[]byte(token), []byte(expected),
+				) == 1 {
+					return true
+				}
+			}
+			return false
+		}
+	case server.AuthModeJWT:
+		if jwtSecret == nil {
+			return nil, errMissingJWTSecret
+		}
+		verify = func(token string) bool {
+			return jwtSecret.VerifySignedToken(token) == nil
+		}
+	default:
+		return nil, server.ErrInvalidAuthMode
+	}
+
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			header := c.Request().Header.Get(echo.HeaderAuthorization)
+			token, ok := strings.CutPrefix(header, bearerPrefix)
+			if !ok || token == "" || !verify(token) {
+				c.Response().Header().Set(
+					echo.HeaderWWWAuthenticate, "Bearer",
+				)
+				return errorResponse(
+					c, http.StatusUnauthorized, "unauthorized",
+				)
+			}
+			return next(c)
+		}
+	}, nil
+}
+
+// errorResponse writes an ErrorResponse with the given code and message.
+func errorResponse(c echo.Context, code int, msg string) error {
+	return c.JSON(code, ErrorResponse{Code: code, Message: msg})
+}
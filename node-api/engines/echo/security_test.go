@@ -0,0 +1,180 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package echo_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/berachain/beacon-kit/node-api/engines/echo"
+	"github.com/berachain/beacon-kit/node-api/server"
+	"github.com/berachain/beacon-kit/primitives/net/jwt"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestEngine builds an engine from the config with a few plain routes.
+func newTestEngine(
+	t *testing.T,
+	cfg server.Config,
+	secret *jwt.Secret,
+) *echo.Engine {
+	t.Helper()
+	engine, err := echo.NewDefaultEngine(cfg, secret)
+	require.NoError(t, err)
+	ok := func(c echo.Context) error { return c.NoContent(http.StatusOK) }
+	engine.GET("/eth/v1/node/health", ok)
+	engine.GET("/eth/v1/debug/beacon/states/:state_id", ok)
+	return engine
+}
+
+// serve performs a GET request against the engine.
+func serve(
+	engine *echo.Engine,
+	target string,
+	headers map[string]string,
+) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodGet, target, nil)
+	req.RemoteAddr = "10.0.0.1:1234"
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+	rec := httptest.NewRecorder()
+	engine.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestBearerAuth(t *testing.T) {
+	cfg := server.DefaultConfig()
+	cfg.Auth.Mode = server.AuthModeBearer
+	cfg.Auth.BearerTokens = []string{"dashboard"}
+	engine := newTestEngine(t, cfg, nil)
+
+	rec := serve(engine, "/eth/v1/node/health", nil)
+	require.Equal(t, http.StatusUnauthorized, rec.Code)
+
+	rec = serve(engine, "/eth/v1/node/health", map[string]string{
+		"Authorization": "Bearer wrong",
+	})
+	require.Equal(t, http.StatusUnauthorized, rec.Code)
+
+	rec = serve(engine, "/eth/v1/node/health", map[string]string{
+		"Authorization": "Bearer dashboard",
+	})
+	require.Equal(t, http.StatusOK, rec.Code)
+}
+
+func TestJWTAuth(t *testing.T) {
+	secret, err := jwt.NewRandom()
+	require.NoError(t, err)
+	cfg := server.DefaultConfig()
+	cfg.Auth.Mode = server.AuthModeJWT
+	cfg.Auth.JWTSecretPath = "unused"
+	engine := newTestEngine(t, cfg, secret)
+
+	token, err := secret.BuildSignedToken()
+	require.NoError(t, err)
+	rec := serve(engine, "/eth/v1/node/health", map[string]string{
+		"Authorization": "Bearer " + token,
+	})
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	other, err := jwt.NewRandom()
+	require.NoError(t, err)
+	token, err = other.BuildSignedToken()
+	require.NoError(t, err)
+	rec = serve(engine, "/eth/v1/node/health", map[string]string{
+		"Authorization": "Bearer " + token,
+	})
+	require.Equal(t, http.StatusUnauthorized, rec.Code)
+
+	// JWT mode without a secret is a configuration error.
+	_, err = echo.NewDefaultEngine(cfg, nil)
+	require.Error(t, err)
+}
+
+func TestRouteFilter(t *testing.T) {
+	cfg := server.DefaultConfig()
+	cfg.Routes.Allow = []string{"/eth/v1/*"}
+	cfg.Routes.Deny = []string{"/eth/v1/debug/*"}
+	engine := newTestEngine(t, cfg, nil)
+
+	rec := serve(engine, "/eth/v1/node/health", nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	rec = serve(engine, "/eth/v1/debug/beacon/states/head", nil)
+	require.Equal(t, http.StatusForbidden, rec.Code)
+
+	rec = serve(engine, "/eth/v2/debug/beacon/states/head", nil)
+	require.Equal(t, http.StatusForbidden, rec.Code)
+}
+
+func TestRateLimit(t *testing.T) {
+	cfg := server.DefaultConfig()
+	cfg.RateLimit.RequestsPerSecond = 0.001
+	cfg.RateLimit.Burst = 2
+	engine := newTestEngine(t, cfg, nil)
+
+	for range cfg.RateLimit.Burst {
+		rec := serve(engine, "/eth/v1/node/health", nil)
+		require.Equal(t, http.StatusOK, rec.Code)
+	}
+	rec := serve(engine, "/eth/v1/node/health", nil)
+	require.Equal(t, http.StatusTooManyRequests, rec.Code)
+
+	// Forwarding headers must not allow escaping the limit.
+	rec = serve(engine, "/eth/v1/node/health", map[string]string{
+		"X-Forwarded-For": "10.0.0.2",
+	})
+	require.Equal(t, http.StatusTooManyRequests, rec.Code)
+}
+
+func TestCORS(t *testing.T) {
+	cfg := server.DefaultConfig()
+	cfg.CORS.AllowedOrigins = []string{"https://dashboard.example"}
+	engine := newTestEngine(t, cfg, nil)
+
+	rec := serve(engine, "/eth/v1/node/health", map[string]string{
+		"Origin": "https://dashboard.example",
+	})
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t,
+		"https://dashboard.example",
+		rec.Header().Get("Access-Control-Allow-Origin"),
+	)
+
+	rec = serve(engine, "/eth/v1/node/health", map[string]string{
+		"Origin": "https://other.example",
+	})
+	require.Empty(t, rec.Header().Get("Access-Control-Allow-Origin"))
+}
+
+func TestInvalidConfig(t *testing.T) {
+	cfg := server.DefaultConfig()
+	cfg.Auth.Mode = "basic"
+	_, err := echo.NewDefaultEngine(cfg, nil)
+	require.ErrorIs(t, err, server.ErrInvalidAuthMode)
+
+	cfg = server.DefaultConfig()
+	cfg.TLS.CertFile = "cert.pem"
+	_, err = echo.NewDefaultEngine(cfg, nil)
+	require.ErrorIs(t, err, server.ErrIncompleteTLSConfig)
+}
@@ -162,6 +162,10 @@ func ValidateValidatorStatus(fl validator.FieldLevel) bool {
 		"exited_slashed":      true,
 		"withdrawal_possible": true,
 		"withdrawal_done":     true,
+		"pending":             true,
+		"active":              true,
+		"exited":              true,
+		"withdrawal":          true,
 	}
 	return validateAllowedStrings(fl.Field().String(), allowedStatuses)
 }
@@ -0,0 +1,122 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package events
+
+import "sync"
+
+// DefaultBufferSize is the default number of events buffered per subscriber
+// before new events are dropped for it.
+const DefaultBufferSize = 64
+
+// Event is a single message published on the bus.
+type Event struct {
+	// Topic is the topic the event was published on.
+	Topic Topic
+	// Data is the payload of the event.
+	Data any
+}
+
+// Bus is an in-process publish/subscribe event bus. Publishing never blocks:
+// events are dropped for subscribers whose buffer is full, so that a slow
+// consumer can never stall block finalization.
+type Bus struct {
+	// mu protects subs and nextID.
+	mu sync.RWMutex
+	// subs holds all the active subscriptions, keyed by their id.
+	subs map[uint64]*Subscription
+	// nextID is the id handed out to the next subscription.
+	nextID uint64
+	// bufferSize is the channel capacity of each subscription.
+	bufferSize int
+}
+
+// NewBus creates a new event bus buffering up to bufferSize events per
+// subscriber.
+func NewBus(bufferSize int) *Bus {
+	return &Bus{
+		subs:       make(map[uint64]*Subscription),
+		bufferSize: bufferSize,
+	}
+}
+
+// Publish sends the data to every subscriber of the given topic.
+func (b *Bus) Publish(topic Topic, data any) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	for _, sub := range b.subs {
+		if _, ok := sub.topics[topic]; !ok {
+			continue
+		}
+		select {
+		case sub.ch <- Event{Topic: topic, Data: data}:
+		default:
+			// The subscriber is lagging behind, drop the event.
+		}
+	}
+}
+
+// Subscribe registers a new subscription receiving the events published on
+// any of the given topics.
+func (b *Bus) Subscribe(topics ...Topic) *Subscription {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	sub := &Subscription{
+		id:     b.nextID,
+		bus:    b,
+		ch:     make(chan Event, b.bufferSize),
+		topics: make(map[Topic]struct{}, len(topics)),
+	}
+	for _, topic := range topics {
+		sub.topics[topic] = struct{}{}
+	}
+	b.subs[sub.id] = sub
+	b.nextID++
+	return sub
+}
+
+// unsubscribe removes the subscription from the bus.
+func (b *Bus) unsubscribe(sub *Subscription) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	delete(b.subs, sub.id)
+}
+
+// Subscription is a handle on a set of topics of a Bus.
+type Subscription struct {
+	id     uint64
+	bus    *Bus
+	ch     chan Event
+	topics map[Topic]struct{}
+	once   sync.Once
+}
+
+// Events returns the channel on which the subscribed events are delivered.
+func (s *Subscription) Events() <-chan Event {
+	return s.ch
+}
+
+// Unsubscribe stops the delivery of events to the subscription. It is safe to
+// call Unsubscribe multiple times.
+func (s *Subscription) Unsubscribe() {
+	s.once.Do(func() {
+		s.bus.unsubscribe(s)
+	})
+}
@@ -0,0 +1,74 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package events_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/node-api/events"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBusFiltersByTopic(t *testing.T) {
+	bus := events.NewBus(events.DefaultBufferSize)
+	sub := bus.Subscribe(events.TopicHead, events.TopicBlock)
+	defer sub.Unsubscribe()
+
+	bus.Publish(events.TopicFinalizedCheckpoint, "finalized")
+	bus.Publish(events.TopicHead, "head")
+	bus.Publish(events.TopicBlock, "block")
+
+	require.Equal(t, events.Event{Topic: events.TopicHead, Data: "head"}, <-sub.Events())
+	require.Equal(t, events.Event{Topic: events.TopicBlock, Data: "block"}, <-sub.Events())
+	require.Empty(t, sub.Events())
+}
+
+func TestBusDropsEventsForLaggingSubscribers(t *testing.T) {
+	bus := events.NewBus(1)
+	sub := bus.Subscribe(events.TopicBlock)
+	defer sub.Unsubscribe()
+
+	// Publishing must not block even though the buffer is full.
+	bus.Publish(events.TopicBlock, 1)
+	bus.Publish(events.TopicBlock, 2)
+
+	require.Equal(t, 1, (<-sub.Events()).Data)
+	require.Empty(t, sub.Events())
+}
+
+func TestBusUnsubscribe(t *testing.T) {
+	bus := events.NewBus(events.DefaultBufferSize)
+	sub := bus.Subscribe(events.TopicBlock)
+	sub.Unsubscribe()
+	sub.Unsubscribe()
+
+	bus.Publish(events.TopicBlock, "block")
+	require.Empty(t, sub.Events())
+}
+
+func TestTopicFromString(t *testing.T) {
+	topic, err := events.TopicFromString("blob_sidecar")
+	require.NoError(t, err)
+	require.Equal(t, events.TopicBlobSidecar, topic)
+
+	_, err = events.TopicFromString("attestation")
+	require.ErrorIs(t, err, events.ErrUnknownTopic)
+}
@@ -0,0 +1,58 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package events
+
+import "fmt"
+
+// Topic is the name of an event stream as defined by the Beacon Node API.
+type Topic string
+
+const (
+	// TopicHead is emitted when the head of the chain changes.
+	TopicHead Topic = "head"
+	// TopicBlock is emitted when a new block has been processed.
+	TopicBlock Topic = "block"
+	// TopicFinalizedCheckpoint is emitted when a new block is finalized.
+	TopicFinalizedCheckpoint Topic = "finalized_checkpoint"
+	// TopicBlobSidecar is emitted for every blob sidecar of a processed
+	// block.
+	TopicBlobSidecar Topic = "blob_sidecar"
+	// TopicChainReorg is emitted when the chain is reorganized.
+	//
+	// NOTE: CometBFT provides single slot finality, hence beacon-kit never
+	// reorgs and this topic is accepted for compatibility only.
+	TopicChainReorg Topic = "chain_reorg"
+)
+
+// TopicFromString returns the topic matching the given name, erroring if the
+// topic is not supported.
+func TopicFromString(name string) (Topic, error) {
+	switch topic := Topic(name); topic {
+	case TopicHead,
+		TopicBlock,
+		TopicFinalizedCheckpoint,
+		TopicBlobSidecar,
+		TopicChainReorg:
+		return topic, nil
+	default:
+		return "", fmt.Errorf("%w: %s", ErrUnknownTopic, name)
+	}
+}
@@ -21,9 +21,14 @@
 package beacon
 
 import (
+	"context"
+
 	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	datypes "github.com/berachain/beacon-kit/da/types"
 	"github.com/berachain/beacon-kit/node-api/handlers/beacon/types"
+	"github.com/berachain/beacon-kit/payload/relay"
 	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/crypto"
 	"github.com/berachain/beacon-kit/primitives/math"
 )
 
@@ -31,6 +36,7 @@ import (
 type Backend interface {
 	GenesisBackend
 	BlockBackend
+	BlobBackend
 	RandaoBackend
 	StateBackend
 	ValidatorBackend
@@ -41,6 +47,19 @@ type Backend interface {
 	GetSlotByStateRoot(root common.Root) (math.Slot, error)
 }
 
+// Relay is the interface of the relay to external block builders, which
+// reveals the payloads of the blinded blocks published.
+type Relay interface {
+	// Enabled returns whether payloads are requested from the relay.
+	Enabled() bool
+	// SubmitBlindedBlock submits the signed blinded block to the relay,
+	// which reveals the payload committed to in exchange.
+	SubmitBlindedBlock(
+		ctx context.Context,
+		blk *relay.SignedBlindedBeaconBlock,
+	) (*relay.ExecutionPayloadAndBlobsBundle, error)
+}
+
 type GenesisBackend interface {
 	GenesisValidatorsRoot(slot math.Slot) (common.Root, error)
 }
@@ -55,9 +74,17 @@ type RandaoBackend interface {
 }
 
 type BlockBackend interface {
+	BlockAtSlot(slot math.Slot) (*ctypes.BeaconBlock, error)
 	BlockRootAtSlot(slot math.Slot) (common.Root, error)
 	BlockRewardsAtSlot(slot math.Slot) (*types.BlockRewardsData, error)
 	BlockHeaderAtSlot(slot math.Slot) (*ctypes.BeaconBlockHeader, error)
+	BlockSignatureAtSlot(slot math.Slot) (crypto.BLSSignature, error)
+}
+
+type BlobBackend interface {
+	BlobSidecarsAtSlot(
+		slot math.Slot, indices []uint64,
+	) (datypes.BlobSidecars, error)
 }
 
 type StateBackend interface {
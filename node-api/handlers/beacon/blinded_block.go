@@ -0,0 +1,124 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package beacon
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/berachain/beacon-kit/errors"
+	beacontypes "github.com/berachain/beacon-kit/node-api/handlers/beacon/types"
+	"github.com/berachain/beacon-kit/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/node-api/handlers/utils"
+	"github.com/berachain/beacon-kit/payload/relay"
+	"github.com/berachain/beacon-kit/primitives/version"
+	"github.com/berachain/beacon-kit/storage/block"
+)
+
+var (
+	// errNilBlindedBlock is returned when the published blinded block is
+	// missing its message or body.
+	errNilBlindedBlock = errors.New("nil blinded block")
+	// errNilSignature is returned when the published blinded block is not
+	// signed.
+	errNilSignature = errors.New("nil blinded block signature")
+	// errRelayDisabled is returned when a blinded block is published while
+	// the relay is disabled, as there is no one to reveal its payload.
+	errRelayDisabled = errors.New("relay disabled")
+)
+
+// GetBlindedBlock returns the beacon block for the given block ID, with its
+// execution payload replaced by its header. The block is served as SSZ if the
+// client requests it.
+func (h *Handler[ContextT]) GetBlindedBlock(c ContextT) (any, error) {
+	req, err := utils.BindAndValidate[beacontypes.GetBlocksRequest](
+		c, h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	slot, err := utils.SlotFromBlockID(req.BlockID, h.backend)
+	if err != nil {
+		return nil, err
+	}
+	blk, err := h.backend.BlockAtSlot(slot)
+	if errors.Is(err, block.ErrBlockNotFound) {
+		return nil, errors.Join(types.ErrNotFound, err)
+	}
+	if err != nil {
+		return nil, err
+	}
+	blinded, err := blk.Blind()
+	if err != nil {
+		return nil, err
+	}
+	// The blinded block shares the hash tree root, hence the signature, of
+	// the block.
+	signature, err := h.blockSignature(slot)
+	if err != nil {
+		return nil, err
+	}
+	return &beacontypes.BlockResponse{
+		Version: version.Name(blk.Version()),
+		ValidatorResponse: beacontypes.ValidatorResponse{
+			ExecutionOptimistic: false, // stubbed
+			Finalized:           true,
+			Data: &beacontypes.SignedBlindedBeaconBlock{
+				Message:   blinded,
+				Signature: signature,
+			},
+		},
+	}, nil
+}
+
+// PostBlindedBlock publishes a signed blinded block by submitting it to the
+// relay, which reveals the payload committed to by the block.
+//
+// NOTE: beacon blocks are proposed through CometBFT rather than gossiped, so
+// the revealed block is not broadcast to the network by this node.
+func (h *Handler[ContextT]) PostBlindedBlock(c ContextT) (any, error) {
+	req, err := utils.BindAndValidate[beacontypes.PostBlindedBlocksRequest](
+		c, h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	if req.Message == nil || req.Message.Body == nil ||
+		req.Message.Body.ExecutionPayloadHeader == nil {
+		return nil, errors.Join(types.ErrInvalidRequest, errNilBlindedBlock)
+	}
+	if req.Signature == nil {
+		return nil, errors.Join(types.ErrInvalidRequest, errNilSignature)
+	}
+	if !h.relay.Enabled() {
+		return nil, errors.Join(types.ErrNotImplemented, errRelayDisabled)
+	}
+	if _, err = h.relay.SubmitBlindedBlock(
+		context.Background(),
+		&relay.SignedBlindedBeaconBlock{
+			Message:   req.Message,
+			Signature: *req.Signature,
+		},
+	); err != nil {
+		return nil, err
+	}
+	return types.StatusResponse{Code: http.StatusOK}, nil
+}
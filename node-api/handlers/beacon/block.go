@@ -21,10 +21,77 @@
 package beacon
 
 import (
+	"github.com/berachain/beacon-kit/errors"
 	beacontypes "github.com/berachain/beacon-kit/node-api/handlers/beacon/types"
+	"github.com/berachain/beacon-kit/node-api/handlers/types"
 	"github.com/berachain/beacon-kit/node-api/handlers/utils"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/version"
+	"github.com/berachain/beacon-kit/storage/block"
 )
 
+// GetBlock returns the full beacon block for the given block ID. The block is
+// served as SSZ if the client requests it.
+func (h *Handler[ContextT]) GetBlock(c ContextT) (any, error) {
+	req, err := utils.BindAndValidate[beacontypes.GetBlocksRequest](
+		c, h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	slot, err := utils.SlotFromBlockID(req.BlockID, h.backend)
+	if err != nil {
+		return nil, err
+	}
+	blk, err := h.backend.BlockAtSlot(slot)
+	if errors.Is(err, block.ErrBlockNotFound) {
+		return nil, errors.Join(types.ErrNotFound, err)
+	}
+	if err != nil {
+		return nil, err
+	}
+	signature, err := h.blockSignature(slot)
+	if err != nil {
+		return nil, err
+	}
+	return &beacontypes.BlockResponse{
+		Version: version.Name(blk.Version()),
+		ValidatorResponse: beacontypes.ValidatorResponse{
+			ExecutionOptimistic: false, // stubbed
+			// Stored blocks are final under CometBFT consensus.
+			Finalized: true,
+			Data: &beacontypes.SignedBeaconBlock{
+				Message:   blk,
+				Signature: signature,
+			},
+		},
+	}, nil
+}
+
+// GetBlockRoot returns the root of the beacon block for the given block ID.
+func (h *Handler[ContextT]) GetBlockRoot(c ContextT) (any, error) {
+	req, err := utils.BindAndValidate[beacontypes.GetBlockRootRequest](
+		c, h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	slot, err := utils.SlotFromBlockID(req.BlockID, h.backend)
+	if err != nil {
+		return nil, err
+	}
+	root, err := h.backend.BlockRootAtSlot(slot)
+	if err != nil {
+		return nil, err
+	}
+	return beacontypes.ValidatorResponse{
+		ExecutionOptimistic: false, // stubbed
+		Finalized:           true,
+		Data:                beacontypes.RootData{Root: root},
+	}, nil
+}
+
 func (h *Handler[ContextT]) GetBlockRewards(c ContextT) (any, error) {
 	req, err := utils.BindAndValidate[beacontypes.GetBlockRewardsRequest](
 		c, h.Logger(),
@@ -42,7 +109,24 @@ func (h *Handler[ContextT]) GetBlockRewards(c ContextT) (any, error) {
 	}
 	return &beacontypes.ValidatorResponse{
 		ExecutionOptimistic: false, // stubbed
-		Finalized:           false, // stubbed
+		Finalized:           true,
 		Data:                rewards,
 	}, nil
 }
+
+// blockSignature returns the proposer signature of the block at the given
+// slot, or nil if it is not known. The signature is only known for blocks
+// which came with blob sidecars.
+func (h *Handler[ContextT]) blockSignature(
+	slot math.Slot,
+) (*crypto.BLSSignature, error) {
+	signature, err := h.backend.BlockSignatureAtSlot(slot)
+	if errors.Is(err, block.ErrSignatureNotFound) {
+		//nolint:nilnil // an unknown signature is left out of the response.
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &signature, nil
+}
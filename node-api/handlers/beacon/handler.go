@@ -31,6 +31,7 @@ type Handler[
 ] struct {
 	*handlers.BaseHandler[ContextT]
 	backend Backend
+	relay   Relay
 }
 
 // NewHandler creates a new handler for the beacon API.
@@ -38,12 +39,14 @@ func NewHandler[
 	ContextT context.Context,
 ](
 	backend Backend,
+	relay Relay,
 ) *Handler[ContextT] {
 	h := &Handler[ContextT]{
 		BaseHandler: handlers.NewBaseHandler(
 			handlers.NewRouteSet[ContextT](""),
 		),
 		backend: backend,
+		relay:   relay,
 	}
 	return h
 }
@@ -23,7 +23,6 @@ package beacon
 import (
 	beacontypes "github.com/berachain/beacon-kit/node-api/handlers/beacon/types"
 	"github.com/berachain/beacon-kit/node-api/handlers/utils"
-	"github.com/berachain/beacon-kit/primitives/bytes"
 )
 
 func (h *Handler[ContextT]) GetBlockHeaders(c ContextT) (any, error) {
@@ -41,15 +40,19 @@ func (h *Handler[ContextT]) GetBlockHeaders(c ContextT) (any, error) {
 	if err != nil {
 		return nil, err
 	}
+	signature, err := h.blockSignature(header.GetSlot())
+	if err != nil {
+		return nil, err
+	}
 	return beacontypes.ValidatorResponse{
 		ExecutionOptimistic: false, // stubbed
-		Finalized:           false, // stubbed
+		Finalized:           true,
 		Data: &beacontypes.BlockHeaderResponse{
 			Root:      header.GetBodyRoot(),
 			Canonical: true,
 			Header: &beacontypes.BlockHeader{
 				Message:   header,
-				Signature: bytes.B48{}, // TODO: implement
+				Signature: signature,
 			},
 		},
 	}, nil
@@ -70,15 +73,19 @@ func (h *Handler[ContextT]) GetBlockHeaderByID(c ContextT) (any, error) {
 	if err != nil {
 		return nil, err
 	}
+	signature, err := h.blockSignature(header.GetSlot())
+	if err != nil {
+		return nil, err
+	}
 	return beacontypes.ValidatorResponse{
 		ExecutionOptimistic: false, // stubbed
-		Finalized:           false, // stubbed
+		Finalized:           true,
 		Data: &beacontypes.BlockHeaderResponse{
 			Root:      header.GetBodyRoot(),
 			Canonical: true,
 			Header: &beacontypes.BlockHeader{
 				Message:   header,
-				Signature: bytes.B48{}, // TODO: implement
+				Signature: signature,
 			},
 		},
 	}, nil
@@ -105,13 +105,13 @@ func (h *Handler[ContextT]) RegisterRoutes(
 		},
 		{
 			Method:  http.MethodPost,
-			Path:    "/eth/v1/beacon/blocks/blinded_blocks",
-			Handler: h.NotImplemented,
+			Path:    "/eth/v1/beacon/blinded_blocks",
+			Handler: h.PostBlindedBlock,
 		},
 		{
 			Method:  http.MethodPost,
-			Path:    "eth/v2/beacon/blocks/blinded_blocks",
-			Handler: h.NotImplemented,
+			Path:    "/eth/v2/beacon/blinded_blocks",
+			Handler: h.PostBlindedBlock,
 		},
 		{
 			Method:  http.MethodPost,
@@ -126,12 +126,12 @@ func (h *Handler[ContextT]) RegisterRoutes(
 		{
 			Method:  http.MethodGet,
 			Path:    "eth/v2/beacon/blocks/:block_id",
-			Handler: h.NotImplemented,
+			Handler: h.GetBlock,
 		},
 		{
 			Method:  http.MethodGet,
 			Path:    "/eth/v1/beacon/blocks/:block_id/root",
-			Handler: h.NotImplemented,
+			Handler: h.GetBlockRoot,
 		},
 		{
 			Method:  http.MethodGet,
@@ -141,7 +141,7 @@ func (h *Handler[ContextT]) RegisterRoutes(
 		{
 			Method:  http.MethodGet,
 			Path:    "/eth/v1/beacon/blob_sidecars/:block_id",
-			Handler: h.NotImplemented,
+			Handler: h.GetBlobSidecars,
 		},
 		{
 			Method:  http.MethodPost,
@@ -161,7 +161,7 @@ func (h *Handler[ContextT]) RegisterRoutes(
 		{
 			Method:  http.MethodGet,
 			Path:    "/eth/v1/beacon/blinded_blocks/:block_id",
-			Handler: h.NotImplemented,
+			Handler: h.GetBlindedBlock,
 		},
 		{
 			Method:  http.MethodGet,
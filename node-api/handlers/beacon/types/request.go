@@ -92,13 +92,10 @@ type GetBlockHeaderRequest struct {
 	types.BlockIDRequest
 }
 
-type PostBlindedBlocksV1Request struct {
-	EthConsensusVersion string `json:"eth_consensus_version" validate:"required,eth_consensus_version"`
-}
-
-type PostBlindedBlocksV2Request struct {
-	PostBlindedBlocksV1Request
-	BroadcastValidation string `json:"broadcast_validation" validate:"required,broadcast_validation"`
+// PostBlindedBlocksRequest is the signed blinded block to publish, sent as
+// the request body.
+type PostBlindedBlocksRequest struct {
+	SignedBlindedBeaconBlock
 }
 
 type PostBlocksV1Request struct {
@@ -21,9 +21,17 @@
 package types
 
 import (
+	"encoding/binary"
+	"encoding/json"
+
 	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
-	"github.com/berachain/beacon-kit/primitives/bytes"
+	datypes "github.com/berachain/beacon-kit/da/types"
+	"github.com/berachain/beacon-kit/node-api/handlers/types"
 	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/constants"
+	"github.com/berachain/beacon-kit/primitives/constraints"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/eip4844"
 )
 
 type ValidatorResponse struct {
@@ -32,20 +40,158 @@ type ValidatorResponse struct {
 	Data                any  `json:"data"`
 }
 
+// MarshalSSZ returns the SSZ encoding of the response data, which is served
+// instead of the JSON envelope when the client requests SSZ.
+func (r ValidatorResponse) MarshalSSZ() ([]byte, error) {
+	marshaler, ok := r.Data.(constraints.SSZMarshaler)
+	if !ok {
+		return nil, types.ErrSSZNotSupported
+	}
+	return marshaler.MarshalSSZ()
+}
+
 type BlockResponse struct {
 	Version string `json:"version"`
 	ValidatorResponse
 }
 
+// ConsensusVersion returns the fork version name of the block.
+func (r *BlockResponse) ConsensusVersion() string {
+	return r.Version
+}
+
+// BlobSidecarsResponse is the response of the blob sidecars endpoint.
+type BlobSidecarsResponse struct {
+	ValidatorResponse
+}
+
+// SignedBeaconBlock is the signed envelope of a beacon block, as served by the
+// Beacon API.
+type SignedBeaconBlock struct {
+	Message *ctypes.BeaconBlock `json:"message"`
+	// Signature is the proposer signature, left out when not known.
+	Signature *crypto.BLSSignature `json:"signature,omitempty"`
+}
+
+// MarshalSSZ returns the SSZ encoding of the signed block. The container has a
+// single variable size field, the message, so the fixed part is made of the
+// message offset followed by the signature. The signed block has no SSZ
+// encoding when the signature is not known.
+func (b *SignedBeaconBlock) MarshalSSZ() ([]byte, error) {
+	if b.Signature == nil {
+		return nil, types.ErrSSZNotSupported
+	}
+	message, err := b.Message.MarshalSSZ()
+	if err != nil {
+		return nil, err
+	}
+	return marshalSignedSSZ(message, *b.Signature), nil
+}
+
+// SignedBlindedBeaconBlock is the signed envelope of a blinded beacon block,
+// as served by the Beacon API.
+type SignedBlindedBeaconBlock struct {
+	Message *ctypes.BlindedBeaconBlock `json:"message"`
+	// Signature is the proposer signature, left out when not known.
+	Signature *crypto.BLSSignature `json:"signature,omitempty"`
+}
+
+// MarshalSSZ returns the SSZ encoding of the signed blinded block, laid out
+// as the signed block.
+func (b *SignedBlindedBeaconBlock) MarshalSSZ() ([]byte, error) {
+	if b.Signature == nil {
+		return nil, types.ErrSSZNotSupported
+	}
+	message, err := b.Message.MarshalSSZ()
+	if err != nil {
+		return nil, err
+	}
+	return marshalSignedSSZ(message, *b.Signature), nil
+}
+
+// marshalSignedSSZ returns the SSZ encoding of a signed envelope made of the
+// given variable size message and its signature.
+func marshalSignedSSZ(message []byte, signature crypto.BLSSignature) []byte {
+	const fixedSize = 4 + constants.BLSSignatureLength
+	bz := make([]byte, 0, fixedSize+len(message))
+	bz = binary.LittleEndian.AppendUint32(bz, uint32(fixedSize))
+	bz = append(bz, signature[:]...)
+	return append(bz, message...)
+}
+
 type BlockHeaderResponse struct {
 	Root      common.Root  `json:"root"`
 	Canonical bool         `json:"canonical"`
 	Header    *BlockHeader `json:"header"`
 }
 
+// MarshalSSZ returns the SSZ encoding of the signed header.
+func (r *BlockHeaderResponse) MarshalSSZ() ([]byte, error) {
+	return r.Header.MarshalSSZ()
+}
+
 type BlockHeader struct {
-	Message   *ctypes.BeaconBlockHeader `json:"message"`
-	Signature bytes.B48                 `json:"signature"`
+	Message *ctypes.BeaconBlockHeader `json:"message"`
+	// Signature is the proposer signature, left out when not known.
+	Signature *crypto.BLSSignature `json:"signature,omitempty"`
+}
+
+// MarshalSSZ returns the SSZ encoding of the header as a
+// SignedBeaconBlockHeader, if the signature is known.
+func (h *BlockHeader) MarshalSSZ() ([]byte, error) {
+	if h.Signature == nil {
+		return nil, types.ErrSSZNotSupported
+	}
+	return ctypes.NewSignedBeaconBlockHeader(
+		h.Message, *h.Signature,
+	).MarshalSSZ()
+}
+
+// BlobSidecars are the sidecars of a block. They are marshalled to JSON in the
+// Beacon API format.
+type BlobSidecars datypes.BlobSidecars
+
+// MarshalJSON marshals the sidecars in the Beacon API format.
+func (s BlobSidecars) MarshalJSON() ([]byte, error) {
+	data := make([]*BlobSidecarData, len(s))
+	for i, sidecar := range s {
+		signature := sidecar.GetSignedBeaconBlockHeader().GetSignature()
+		data[i] = &BlobSidecarData{
+			Index:         sidecar.GetIndex(),
+			Blob:          sidecar.GetBlob(),
+			KzgCommitment: sidecar.GetKzgCommitment(),
+			KzgProof:      sidecar.GetKzgProof(),
+			SignedBlockHeader: &BlockHeader{
+				Message:   sidecar.GetSignedBeaconBlockHeader().GetHeader(),
+				Signature: &signature,
+			},
+			KzgCommitmentInclusionProof: sidecar.InclusionProof,
+		}
+	}
+	return json.Marshal(data)
+}
+
+// MarshalSSZ marshals the sidecars as a bare SSZ list of BlobSidecar
+// containers. These have a fixed size, so the list is their concatenation.
+func (s BlobSidecars) MarshalSSZ() ([]byte, error) {
+	var bz []byte
+	for _, sidecar := range s {
+		sidecarBz, err := sidecar.MarshalSSZ()
+		if err != nil {
+			return nil, err
+		}
+		bz = append(bz, sidecarBz...)
+	}
+	return bz, nil
+}
+
+type BlobSidecarData struct {
+	Index                       uint64                `json:"index,string"`
+	Blob                        eip4844.Blob          `json:"blob"`
+	KzgCommitment               eip4844.KZGCommitment `json:"kzg_commitment"`
+	KzgProof                    eip4844.KZGProof      `json:"kzg_proof"`
+	SignedBlockHeader           *BlockHeader          `json:"signed_block_header"`
+	KzgCommitmentInclusionProof []common.Root         `json:"kzg_commitment_inclusion_proof"`
 }
 
 type GenesisData struct {
@@ -64,11 +210,71 @@ type ValidatorData struct {
 	Validator *ctypes.Validator `json:"validator"`
 }
 
+// validatorBalanceDataSize is the SSZ size of a ValidatorBalanceData.
+const validatorBalanceDataSize = 16
+
+// validatorDataSize is the SSZ size of a ValidatorData.
+const validatorDataSize = validatorBalanceDataSize + ctypes.ValidatorSize
+
+// MarshalSSZ returns the SSZ encoding of the validator as the container
+// (index, balance, validator). The status is not encoded, as it can be
+// derived from the validator.
+func (d *ValidatorData) MarshalSSZ() ([]byte, error) {
+	return d.marshalSSZTo(make([]byte, 0, validatorDataSize))
+}
+
+// marshalSSZTo appends the SSZ encoding of the validator to dst.
+func (d *ValidatorData) marshalSSZTo(dst []byte) ([]byte, error) {
+	dst = d.ValidatorBalanceData.marshalSSZTo(dst)
+	return d.Validator.MarshalSSZTo(dst)
+}
+
+// ValidatorsData is a list of validators, marshalled to SSZ as a list of
+// ValidatorData containers.
+type ValidatorsData []*ValidatorData
+
+// MarshalSSZ returns the SSZ encoding of the validators.
+func (v ValidatorsData) MarshalSSZ() ([]byte, error) {
+	var err error
+	bz := make([]byte, 0, len(v)*validatorDataSize)
+	for _, d := range v {
+		if bz, err = d.marshalSSZTo(bz); err != nil {
+			return nil, err
+		}
+	}
+	return bz, nil
+}
+
 type ValidatorBalanceData struct {
 	Index   uint64 `json:"index,string"`
 	Balance uint64 `json:"balance,string"`
 }
 
+// MarshalSSZ returns the SSZ encoding of the balance as the container
+// (index, balance).
+func (d *ValidatorBalanceData) MarshalSSZ() ([]byte, error) {
+	return d.marshalSSZTo(make([]byte, 0, validatorBalanceDataSize)), nil
+}
+
+// marshalSSZTo appends the SSZ encoding of the balance to dst.
+func (d *ValidatorBalanceData) marshalSSZTo(dst []byte) []byte {
+	dst = binary.LittleEndian.AppendUint64(dst, d.Index)
+	return binary.LittleEndian.AppendUint64(dst, d.Balance)
+}
+
+// ValidatorBalancesData is a list of balances, marshalled to SSZ as a list of
+// ValidatorBalanceData containers.
+type ValidatorBalancesData []*ValidatorBalanceData
+
+// MarshalSSZ returns the SSZ encoding of the balances.
+func (b ValidatorBalancesData) MarshalSSZ() ([]byte, error) {
+	bz := make([]byte, 0, len(b)*validatorBalanceDataSize)
+	for _, d := range b {
+		bz = d.marshalSSZTo(bz)
+	}
+	return bz, nil
+}
+
 //nolint:staticcheck // todo: figure this out.
 type CommitteeData struct {
 	Index      uint64   `json:"index,string"`
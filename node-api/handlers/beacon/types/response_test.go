@@ -0,0 +1,204 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types_test
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"testing"
+
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	datypes "github.com/berachain/beacon-kit/da/types"
+	"github.com/berachain/beacon-kit/node-api/handlers/beacon/types"
+	handlertypes "github.com/berachain/beacon-kit/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/eip4844"
+	"github.com/berachain/beacon-kit/primitives/version"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSignedBeaconBlockMarshalSSZ(t *testing.T) {
+	blk, err := (&ctypes.BeaconBlock{}).NewWithVersion(
+		10, 3, common.Root{0x01}, version.Deneb,
+	)
+	require.NoError(t, err)
+	blk.Body.ExecutionPayload = &ctypes.ExecutionPayload{}
+	message, err := blk.MarshalSSZ()
+	require.NoError(t, err)
+
+	signed := &types.SignedBeaconBlock{
+		Message:   blk,
+		Signature: &crypto.BLSSignature{0xaa},
+	}
+	bz, err := signed.MarshalSSZ()
+	require.NoError(t, err)
+
+	// The fixed part holds the message offset followed by the signature.
+	offset := binary.LittleEndian.Uint32(bz[:4])
+	require.Equal(t, uint32(100), offset)
+	require.Equal(t, signed.Signature[:], bz[4:offset])
+	require.Equal(t, message, bz[offset:])
+
+	// The block response serves the SSZ encoding of its data.
+	res := &types.BlockResponse{
+		ValidatorResponse: types.ValidatorResponse{Data: signed},
+	}
+	resBz, err := res.MarshalSSZ()
+	require.NoError(t, err)
+	require.Equal(t, bz, resBz)
+
+	// Without a known signature, the block is only served as JSON.
+	signed.Signature = nil
+	_, err = signed.MarshalSSZ()
+	require.ErrorIs(t, err, handlertypes.ErrSSZNotSupported)
+}
+
+func TestSignedBlindedBeaconBlockMarshalSSZ(t *testing.T) {
+	blk, err := (&ctypes.BeaconBlock{}).NewWithVersion(
+		10, 3, common.Root{0x01}, version.Deneb,
+	)
+	require.NoError(t, err)
+	blk.Body.ExecutionPayload = &ctypes.ExecutionPayload{}
+	blinded, err := blk.Blind()
+	require.NoError(t, err)
+	message, err := blinded.MarshalSSZ()
+	require.NoError(t, err)
+
+	signed := &types.SignedBlindedBeaconBlock{
+		Message:   blinded,
+		Signature: &crypto.BLSSignature{0xaa},
+	}
+	bz, err := signed.MarshalSSZ()
+	require.NoError(t, err)
+
+	// The layout is the one of the signed block, with the blinded message.
+	offset := binary.LittleEndian.Uint32(bz[:4])
+	require.Equal(t, uint32(100), offset)
+	require.Equal(t, signed.Signature[:], bz[4:offset])
+	require.Equal(t, message, bz[offset:])
+}
+
+func TestBlobSidecarsMarshalJSON(t *testing.T) {
+	sidecars := types.BlobSidecars{
+		&datypes.BlobSidecar{
+			Index:         1,
+			KzgCommitment: eip4844.KZGCommitment{0x01},
+			SignedBeaconBlockHeader: &ctypes.SignedBeaconBlockHeader{
+				Header: &ctypes.BeaconBlockHeader{Slot: 5},
+			},
+			InclusionProof: make([]common.Root, 8),
+		},
+	}
+	bz, err := json.Marshal(sidecars)
+	require.NoError(t, err)
+
+	var decoded []map[string]any
+	require.NoError(t, json.Unmarshal(bz, &decoded))
+	require.Len(t, decoded, 1)
+	require.Equal(t, "1", decoded[0]["index"])
+	require.Contains(t, decoded[0], "blob")
+	require.Contains(t, decoded[0], "kzg_proof")
+	require.Len(t, decoded[0]["kzg_commitment_inclusion_proof"], 8)
+	require.Contains(
+		t, decoded[0]["signed_block_header"], "message",
+	)
+	commitment, err := eip4844.KZGCommitment{0x01}.MarshalText()
+	require.NoError(t, err)
+	require.Equal(t, string(commitment), decoded[0]["kzg_commitment"])
+
+}
+
+func TestBlobSidecarsMarshalSSZ(t *testing.T) {
+	newSidecar := func(index uint64) *datypes.BlobSidecar {
+		return &datypes.BlobSidecar{
+			Index: index,
+			SignedBeaconBlockHeader: &ctypes.SignedBeaconBlockHeader{
+				Header: &ctypes.BeaconBlockHeader{Slot: 5},
+			},
+			InclusionProof: make([]common.Root, 8),
+		}
+	}
+	sidecars := types.BlobSidecars{newSidecar(1), newSidecar(2)}
+	first, err := sidecars[0].MarshalSSZ()
+	require.NoError(t, err)
+	second, err := sidecars[1].MarshalSSZ()
+	require.NoError(t, err)
+
+	// The list has no offset in front of the sidecars, which have a fixed
+	// size and are simply concatenated.
+	bz, err := sidecars.MarshalSSZ()
+	require.NoError(t, err)
+	require.Equal(t, append(first, second...), bz)
+	require.Equal(t, uint64(1), binary.LittleEndian.Uint64(bz[:8]))
+	require.Equal(t,
+		uint64(2), binary.LittleEndian.Uint64(bz[len(first):len(first)+8]),
+	)
+
+	bz, err = types.BlobSidecars{}.MarshalSSZ()
+	require.NoError(t, err)
+	require.Empty(t, bz)
+}
+
+func TestValidatorsDataMarshalSSZ(t *testing.T) {
+	validator := &ctypes.Validator{
+		Pubkey:           crypto.BLSPubkey{0x01},
+		EffectiveBalance: 32e9,
+	}
+	validatorBz, err := validator.MarshalSSZ()
+	require.NoError(t, err)
+
+	data := types.ValidatorsData{
+		{
+			ValidatorBalanceData: types.ValidatorBalanceData{
+				Index: 3, Balance: 33e9,
+			},
+			Validator: validator,
+		},
+	}
+	bz, err := data.MarshalSSZ()
+	require.NoError(t, err)
+	require.Len(t, bz, 16+ctypes.ValidatorSize)
+	require.Equal(t, uint64(3), binary.LittleEndian.Uint64(bz[:8]))
+	require.Equal(t, uint64(33e9), binary.LittleEndian.Uint64(bz[8:16]))
+	require.Equal(t, validatorBz, bz[16:])
+
+	// A response without an SSZ encoding reports it.
+	_, err = types.ValidatorResponse{Data: types.RootData{}}.MarshalSSZ()
+	require.ErrorIs(t, err, handlertypes.ErrSSZNotSupported)
+}
+
+func TestBlockHeaderMarshalSSZ(t *testing.T) {
+	header := &ctypes.BeaconBlockHeader{Slot: 7, ProposerIndex: 2}
+	res := &types.BlockHeaderResponse{
+		Header: &types.BlockHeader{
+			Message:   header,
+			Signature: &crypto.BLSSignature{0xbb},
+		},
+	}
+	bz, err := res.MarshalSSZ()
+	require.NoError(t, err)
+
+	signed := &ctypes.SignedBeaconBlockHeader{}
+	require.NoError(t, signed.UnmarshalSSZ(bz))
+	require.Equal(t, header, signed.GetHeader())
+	require.Equal(t, *res.Header.Signature, signed.GetSignature())
+}
@@ -0,0 +1,45 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+// Validator statuses as defined by the Beacon Node API.
+// https://hackmd.io/ofFJ5gOmQpu1jjHilHbdQQ
+const (
+	ValidatorStatusPendingInitialized = "pending_initialized"
+	ValidatorStatusPendingQueued      = "pending_queued"
+	ValidatorStatusActiveOngoing      = "active_ongoing"
+	ValidatorStatusActiveExiting      = "active_exiting"
+	ValidatorStatusActiveSlashed      = "active_slashed"
+	ValidatorStatusExitedUnslashed    = "exited_unslashed"
+	ValidatorStatusExitedSlashed      = "exited_slashed"
+	ValidatorStatusWithdrawalPossible = "withdrawal_possible"
+	ValidatorStatusWithdrawalDone     = "withdrawal_done"
+)
+
+// General validator statuses, each matching all the statuses it prefixes,
+// e.g. `active` matches `active_ongoing`, `active_exiting` and
+// `active_slashed`.
+const (
+	ValidatorStatusPending    = "pending"
+	ValidatorStatusActive     = "active"
+	ValidatorStatusExited     = "exited"
+	ValidatorStatusWithdrawal = "withdrawal"
+)
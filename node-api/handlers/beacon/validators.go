@@ -35,10 +35,6 @@ func (h *Handler[ContextT]) GetStateValidators(
 	if err != nil {
 		return nil, err
 	}
-	// TODO: remove this once status filter is implemented.
-	if len(req.Statuses) > 0 {
-		return nil, types.ErrNotImplemented
-	}
 	slot, err := utils.SlotFromStateID(req.StateID, h.backend)
 	if err != nil {
 		return nil, err
@@ -57,7 +53,7 @@ func (h *Handler[ContextT]) GetStateValidators(
 	return beacontypes.ValidatorResponse{
 		ExecutionOptimistic: false, // stubbed
 		Finalized:           false, // stubbed
-		Data:                validators,
+		Data:                beacontypes.ValidatorsData(validators),
 	}, nil
 }
 
@@ -70,10 +66,6 @@ func (h *Handler[ContextT]) PostStateValidators(
 	if err != nil {
 		return nil, err
 	}
-	// TODO: remove this once status filter is implemented.
-	if len(req.Statuses) > 0 {
-		return nil, types.ErrNotImplemented
-	}
 	slot, err := utils.SlotFromStateID(req.StateID, h.backend)
 	if err != nil {
 		return nil, err
@@ -89,7 +81,7 @@ func (h *Handler[ContextT]) PostStateValidators(
 	return beacontypes.ValidatorResponse{
 		ExecutionOptimistic: false, // stubbed
 		Finalized:           false, // stubbed
-		Data:                validators,
+		Data:                beacontypes.ValidatorsData(validators),
 	}, nil
 }
 
@@ -139,7 +131,7 @@ func (h *Handler[ContextT]) GetStateValidatorBalances(
 	return beacontypes.ValidatorResponse{
 		ExecutionOptimistic: false, // stubbed
 		Finalized:           false, // stubbed
-		Data:                balances,
+		Data:                beacontypes.ValidatorBalancesData(balances),
 	}, nil
 }
 
@@ -166,6 +158,6 @@ func (h *Handler[ContextT]) PostStateValidatorBalances(
 	return beacontypes.ValidatorResponse{
 		ExecutionOptimistic: false, // stubbed
 		Finalized:           false, // stubbed
-		Data:                balances,
+		Data:                beacontypes.ValidatorBalancesData(balances),
 	}, nil
 }
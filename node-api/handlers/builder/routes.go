@@ -35,7 +35,7 @@ func (h *Handler[ContextT]) RegisterRoutes(
 		{
 			Method:  http.MethodGet,
 			Path:    "/eth/v1/builder/states/:state_id/expected_withdrawals",
-			Handler: h.NotImplemented,
+			Handler: h.GetExpectedWithdrawals,
 		},
 	})
 }
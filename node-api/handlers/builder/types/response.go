@@ -0,0 +1,66 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"encoding/json"
+
+	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
+	"github.com/berachain/beacon-kit/primitives/common"
+)
+
+// Withdrawal is a withdrawal in the Beacon API format.
+type Withdrawal struct {
+	Index          uint64                  `json:"index,string"`
+	ValidatorIndex uint64                  `json:"validator_index,string"`
+	Address        common.ExecutionAddress `json:"address"`
+	Amount         uint64                  `json:"amount,string"`
+}
+
+// Withdrawals are the withdrawals expected in a payload. They are marshalled
+// to JSON in the Beacon API format.
+type Withdrawals engineprimitives.Withdrawals
+
+// MarshalJSON marshals the withdrawals in the Beacon API format.
+func (w Withdrawals) MarshalJSON() ([]byte, error) {
+	data := make([]*Withdrawal, len(w))
+	for i, withdrawal := range w {
+		data[i] = &Withdrawal{
+			Index:          withdrawal.GetIndex().Unwrap(),
+			ValidatorIndex: withdrawal.GetValidatorIndex().Unwrap(),
+			Address:        withdrawal.GetAddress(),
+			Amount:         withdrawal.GetAmount().Unwrap(),
+		}
+	}
+	return json.Marshal(data)
+}
+
+// MarshalSSZ marshals the withdrawals as an SSZ list.
+func (w Withdrawals) MarshalSSZ() ([]byte, error) {
+	var err error
+	bz := make([]byte, 0, len(w)*engineprimitives.WithdrawalSize)
+	for _, withdrawal := range w {
+		if bz, err = withdrawal.MarshalSSZTo(bz); err != nil {
+			return nil, err
+		}
+	}
+	return bz, nil
+}
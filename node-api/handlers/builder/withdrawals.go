@@ -0,0 +1,65 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package builder
+
+import (
+	"github.com/berachain/beacon-kit/errors"
+	beacontypes "github.com/berachain/beacon-kit/node-api/handlers/beacon/types"
+	buildertypes "github.com/berachain/beacon-kit/node-api/handlers/builder/types"
+	"github.com/berachain/beacon-kit/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/node-api/handlers/utils"
+	"github.com/berachain/beacon-kit/primitives/math"
+)
+
+// GetExpectedWithdrawals returns the withdrawals expected in the payload of
+// the block proposed at the proposal slot, which defaults to the slot after
+// the given state ID. The withdrawals are served as SSZ if the client
+// requests it.
+func (h *Handler[ContextT]) GetExpectedWithdrawals(c ContextT) (any, error) {
+	req, err := utils.BindAndValidate[buildertypes.ExpectedWithdrawalsRequest](
+		c, h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	slot, err := utils.SlotFromStateID(req.StateID, h.backend)
+	if err != nil {
+		return nil, err
+	}
+	var proposalSlot math.Slot
+	if req.ProposalSlot != "" {
+		if proposalSlot, err = utils.U64FromString(req.ProposalSlot); err != nil {
+			return nil, errors.Join(types.ErrInvalidRequest, err)
+		}
+	}
+	withdrawals, err := h.backend.ExpectedWithdrawalsAtSlot(slot, proposalSlot)
+	if errors.Is(err, buildertypes.ErrInvalidProposalSlot) {
+		return nil, errors.Join(types.ErrInvalidRequest, err)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &beacontypes.ValidatorResponse{
+		ExecutionOptimistic: false, // stubbed
+		Finalized:           false, // stubbed
+		Data:                buildertypes.Withdrawals(withdrawals),
+	}, nil
+}
@@ -21,19 +21,24 @@
 package config
 
 import (
+	"github.com/berachain/beacon-kit/chain-spec/chain"
 	"github.com/berachain/beacon-kit/node-api/handlers"
 	"github.com/berachain/beacon-kit/node-api/server/context"
 )
 
 type Handler[ContextT context.Context] struct {
 	*handlers.BaseHandler[ContextT]
+	chainSpec chain.ChainSpec
 }
 
-func NewHandler[ContextT context.Context]() *Handler[ContextT] {
+func NewHandler[ContextT context.Context](
+	chainSpec chain.ChainSpec,
+) *Handler[ContextT] {
 	h := &Handler[ContextT]{
 		BaseHandler: handlers.NewBaseHandler(
 			handlers.NewRouteSet[ContextT](""),
 		),
+		chainSpec: chainSpec,
 	}
 	return h
 }
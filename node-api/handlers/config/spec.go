@@ -0,0 +1,74 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package config
+
+import (
+	configtypes "github.com/berachain/beacon-kit/node-api/handlers/config/types"
+	"github.com/berachain/beacon-kit/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/version"
+)
+
+// GetSpec returns the chain spec parameters as a map of UPPER_SNAKE_CASE keys
+// to string values.
+func (h *Handler[ContextT]) GetSpec(ContextT) (any, error) {
+	return types.Wrap(h.chainSpec.Values()), nil
+}
+
+// GetForkSchedule returns every fork of the chain, including forks scheduled
+// for a future epoch. The chain starts at Deneb from genesis.
+func (h *Handler[ContextT]) GetForkSchedule(ContextT) (any, error) {
+	schedule := []struct {
+		version uint32
+		epoch   uint64
+	}{
+		{version: version.Deneb, epoch: 0},
+		{
+			version: version.DenebPlus,
+			epoch:   h.chainSpec.DenebPlusForkEpoch().Unwrap(),
+		},
+		{
+			version: version.Electra,
+			epoch:   h.chainSpec.ElectraForkEpoch().Unwrap(),
+		},
+	}
+
+	forks := make([]*configtypes.ForkData, 0, len(schedule))
+	previous := schedule[0].version
+	for _, fork := range schedule {
+		forks = append(forks, &configtypes.ForkData{
+			PreviousVersion: version.FromUint32[common.Version](previous),
+			CurrentVersion:  version.FromUint32[common.Version](fork.version),
+			Epoch:           fork.epoch,
+		})
+		previous = fork.version
+	}
+	return types.Wrap(forks), nil
+}
+
+// GetDepositContract returns the deposit contract address and the chain ID of
+// the execution chain it is deployed on.
+func (h *Handler[ContextT]) GetDepositContract(ContextT) (any, error) {
+	return types.Wrap(&configtypes.DepositContractData{
+		ChainID: h.chainSpec.DepositEth1ChainID(),
+		Address: h.chainSpec.DepositContractAddress(),
+	}), nil
+}
@@ -0,0 +1,74 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package debug
+
+import (
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	debugtypes "github.com/berachain/beacon-kit/node-api/handlers/debug/types"
+	"github.com/berachain/beacon-kit/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/node-api/handlers/utils"
+	"github.com/berachain/beacon-kit/primitives/common"
+)
+
+// GetState returns the full beacon state committed at the given state ID.
+// The state is served as SSZ if the client requests it.
+func (h *Handler[ContextT]) GetState(c ContextT) (any, error) {
+	req, err := utils.BindAndValidate[debugtypes.GetStateRequest](
+		c, h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	slot, err := utils.SlotFromStateID(req.StateID, h.backend)
+	if err != nil {
+		return nil, err
+	}
+	st, _, err := h.backend.StateAtSlot(slot)
+	if err != nil {
+		return nil, err
+	}
+	return debugtypes.NewStateResponse(st), nil
+}
+
+// GetHeads returns the heads of the chain. With single slot finality there is
+// only ever one head, the latest block.
+func (h *Handler[ContextT]) GetHeads(ContextT) (any, error) {
+	header, err := h.backend.BlockHeaderAtSlot(0)
+	if err != nil {
+		return nil, err
+	}
+
+	// The latest block header in the state only gets its state root when the
+	// next slot is processed, so fill it in from the committed state before
+	// hashing the header into the block root.
+	if (header.GetStateRoot() == common.Root{}) {
+		var st *ctypes.BeaconState
+		if st, _, err = h.backend.StateAtSlot(header.GetSlot()); err != nil {
+			return nil, err
+		}
+		header.SetStateRoot(st.HashTreeRoot())
+	}
+	return types.Wrap([]debugtypes.ChainHead{{
+		Root:                header.HashTreeRoot(),
+		Slot:                header.GetSlot(),
+		ExecutionOptimistic: false, // stubbed
+	}}), nil
+}
@@ -0,0 +1,123 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package debug_test
+
+import (
+	"testing"
+
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/node-api/handlers/debug"
+	debugtypes "github.com/berachain/beacon-kit/node-api/handlers/debug/types"
+	"github.com/berachain/beacon-kit/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/version"
+	"github.com/stretchr/testify/require"
+)
+
+// stubBackend serves a single state and the latest block header of it.
+type stubBackend struct {
+	state  *ctypes.BeaconState
+	header *ctypes.BeaconBlockHeader
+}
+
+func (b *stubBackend) StateAtSlot(
+	math.Slot,
+) (*ctypes.BeaconState, math.Slot, error) {
+	return b.state, b.header.GetSlot(), nil
+}
+
+func (b *stubBackend) BlockHeaderAtSlot(
+	math.Slot,
+) (*ctypes.BeaconBlockHeader, error) {
+	return ctypes.NewBeaconBlockHeader(
+		b.header.GetSlot(),
+		b.header.GetProposerIndex(),
+		b.header.GetParentBlockRoot(),
+		b.header.GetStateRoot(),
+		b.header.GetBodyRoot(),
+	), nil
+}
+
+func (*stubBackend) GetSlotByStateRoot(common.Root) (math.Slot, error) {
+	return 0, nil
+}
+
+// nopContext is a request context without parameters.
+type nopContext struct{}
+
+func (nopContext) Bind(any) error     { return nil }
+func (nopContext) Validate(any) error { return nil }
+
+func TestGetHeads(t *testing.T) {
+	st, err := (&ctypes.BeaconState{}).New(
+		version.Deneb,
+		common.Root{0x01},
+		10,
+		&ctypes.Fork{
+			PreviousVersion: version.FromUint32[common.Version](version.Deneb),
+			CurrentVersion:  version.FromUint32[common.Version](version.Deneb),
+		},
+		(&ctypes.BeaconBlockHeader{}).Empty(),
+		[]common.Root{{0x02}},
+		[]common.Root{{0x03}},
+		(&ctypes.Eth1Data{}).Empty(),
+		0,
+		(&ctypes.ExecutionPayloadHeader{}).Empty(),
+		ctypes.Validators{&ctypes.Validator{EffectiveBalance: 32e9}},
+		[]uint64{32e9},
+		[]common.Bytes32{},
+		0,
+		0,
+		nil,
+		0,
+	)
+	require.NoError(t, err)
+
+	// The latest block header of a committed state has no state root yet.
+	latest := ctypes.NewBeaconBlockHeader(
+		10, 1, common.Root{0x04}, common.Root{}, common.Root{0x05},
+	)
+	block := ctypes.NewBeaconBlockHeader(
+		10, 1, common.Root{0x04}, st.HashTreeRoot(), common.Root{0x05},
+	)
+
+	tests := []struct {
+		name   string
+		header *ctypes.BeaconBlockHeader
+	}{
+		{name: "state root missing", header: latest},
+		{name: "state root filled", header: block},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := debug.NewHandler[nopContext](
+				&stubBackend{state: st, header: tt.header},
+			)
+			res, err := h.GetHeads(nopContext{})
+			require.NoError(t, err)
+			require.Equal(t, types.Wrap([]debugtypes.ChainHead{{
+				Root: block.HashTreeRoot(),
+				Slot: 10,
+			}}), res)
+		})
+	}
+}
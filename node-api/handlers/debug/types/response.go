@@ -0,0 +1,61 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	beacontypes "github.com/berachain/beacon-kit/node-api/handlers/beacon/types"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/version"
+)
+
+// StateResponse is the response of the debug state endpoint. The state is
+// served as SSZ if the client requests it.
+type StateResponse struct {
+	Version string `json:"version"`
+	beacontypes.ValidatorResponse
+}
+
+// NewStateResponse wraps the given beacon state, naming its version after the
+// current fork of the state.
+func NewStateResponse(st *ctypes.BeaconState) *StateResponse {
+	return &StateResponse{
+		Version: version.Name(version.ToUint32(st.Fork.CurrentVersion)),
+		ValidatorResponse: beacontypes.ValidatorResponse{
+			ExecutionOptimistic: false, // stubbed
+			Finalized:           false, // stubbed
+			Data:                st,
+		},
+	}
+}
+
+// ConsensusVersion returns the fork version name of the state.
+func (r *StateResponse) ConsensusVersion() string {
+	return r.Version
+}
+
+// ChainHead is a head of the chain, as served by the debug heads endpoint.
+type ChainHead struct {
+	Root                common.Root `json:"root"`
+	Slot                math.Slot   `json:"slot"`
+	ExecutionOptimistic bool        `json:"execution_optimistic"`
+}
@@ -0,0 +1,81 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/node-api/handlers/debug/types"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/version"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStateResponse(t *testing.T) {
+	st, err := (&ctypes.BeaconState{}).New(
+		version.Deneb,
+		common.Root{0x01},
+		10,
+		&ctypes.Fork{
+			PreviousVersion: version.FromUint32[common.Version](version.Deneb),
+			CurrentVersion:  version.FromUint32[common.Version](version.Deneb),
+		},
+		(&ctypes.BeaconBlockHeader{}).Empty(),
+		[]common.Root{{0x02}},
+		[]common.Root{{0x03}},
+		(&ctypes.Eth1Data{}).Empty(),
+		0,
+		(&ctypes.ExecutionPayloadHeader{}).Empty(),
+		ctypes.Validators{&ctypes.Validator{EffectiveBalance: 32e9}},
+		[]uint64{32e9},
+		[]common.Bytes32{},
+		0,
+		0,
+		nil,
+		0,
+	)
+	require.NoError(t, err)
+
+	res := types.NewStateResponse(st)
+	require.Equal(t, version.Name(version.Deneb), res.ConsensusVersion())
+
+	// The SSZ encoding is the one of the full state.
+	expected, err := st.MarshalSSZ()
+	require.NoError(t, err)
+	actual, err := res.MarshalSSZ()
+	require.NoError(t, err)
+	require.Equal(t, expected, actual)
+
+	// The JSON encoding uses the spec field names.
+	bz, err := json.Marshal(res)
+	require.NoError(t, err)
+	var decoded struct {
+		Version string         `json:"version"`
+		Data    map[string]any `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal(bz, &decoded))
+	require.Equal(t, res.Version, decoded.Version)
+	require.Contains(t, decoded.Data, "genesis_validators_root")
+	require.Contains(t, decoded.Data, "latest_execution_payload_header")
+	require.Len(t, decoded.Data["validators"], 1)
+}
@@ -0,0 +1,130 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/berachain/beacon-kit/errors"
+	eventbus "github.com/berachain/beacon-kit/node-api/events"
+	eventstypes "github.com/berachain/beacon-kit/node-api/handlers/events/types"
+	"github.com/berachain/beacon-kit/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/node-api/handlers/utils"
+)
+
+const (
+	// contentTypeEventStream is the MIME type of a Server-Sent Events stream.
+	contentTypeEventStream = "text/event-stream"
+	// keepAliveInterval is the interval at which a comment is written to an
+	// idle stream, so that proxies and clients do not time it out between
+	// events.
+	keepAliveInterval = 15 * time.Second
+)
+
+// GetEvents subscribes to the requested topics and returns a Server-Sent
+// Events stream that is served until the client disconnects.
+func (h *Handler[ContextT]) GetEvents(c ContextT) (any, error) {
+	req, err := utils.BindAndValidate[eventstypes.GetEventsRequest](
+		c, h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	topics, err := topicsFromRequest(req.Topics)
+	if err != nil {
+		return nil, errors.Join(types.ErrInvalidRequest, err)
+	}
+	return &eventStream{
+		bus:       h.bus,
+		topics:    topics,
+		keepAlive: keepAliveInterval,
+	}, nil
+}
+
+// topicsFromRequest parses the requested topics, splitting any comma
+// separated values.
+func topicsFromRequest(names []string) ([]eventbus.Topic, error) {
+	topics := make([]eventbus.Topic, 0, len(names))
+	for _, name := range names {
+		for _, part := range strings.Split(name, ",") {
+			topic, err := eventbus.TopicFromString(strings.TrimSpace(part))
+			if err != nil {
+				return nil, err
+			}
+			topics = append(topics, topic)
+		}
+	}
+	return topics, nil
+}
+
+// eventStream writes the events of the subscribed topics in the Server-Sent
+// Events format.
+type eventStream struct {
+	bus       EventBus
+	topics    []eventbus.Topic
+	keepAlive time.Duration
+}
+
+// ContentType returns the MIME type of the stream.
+func (s *eventStream) ContentType() string {
+	return contentTypeEventStream
+}
+
+// Stream writes every event received on the subscription to w until ctx is
+// done. A comment is written every keepAlive interval to keep the connection
+// alive while no event is published.
+func (s *eventStream) Stream(
+	ctx context.Context,
+	w io.Writer,
+	flush func(),
+) error {
+	sub := s.bus.Subscribe(s.topics...)
+	defer sub.Unsubscribe()
+	ticker := time.NewTicker(s.keepAlive)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			if _, err := io.WriteString(w, ":\n\n"); err != nil {
+				return err
+			}
+			flush()
+		case event := <-sub.Events():
+			data, err := json.Marshal(event.Data)
+			if err != nil {
+				return err
+			}
+			if _, err = fmt.Fprintf(
+				w, "event: %s\ndata: %s\n\n", event.Topic, data,
+			); err != nil {
+				return err
+			}
+			flush()
+		}
+	}
+}
@@ -0,0 +1,93 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package events
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	eventbus "github.com/berachain/beacon-kit/node-api/events"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEventStreamKeepAlive(t *testing.T) {
+	bus := eventbus.NewBus(eventbus.DefaultBufferSize)
+	stream := &eventStream{
+		bus:       bus,
+		topics:    []eventbus.Topic{eventbus.TopicHead},
+		keepAlive: 10 * time.Millisecond,
+	}
+
+	// Every flushed chunk is handed over to the test, so that the buffer is
+	// only ever touched by the stream.
+	ctx, cancel := context.WithCancel(context.Background())
+	var (
+		buf     bytes.Buffer
+		flushed = make(chan string)
+		done    = make(chan error, 1)
+	)
+	go func() {
+		done <- stream.Stream(ctx, &buf, func() {
+			chunk := buf.String()
+			buf.Reset()
+			select {
+			case flushed <- chunk:
+			case <-ctx.Done():
+			}
+		})
+	}()
+
+	// The stream is kept alive with comments while no event is published.
+	require.Equal(t, ":\n\n", <-flushed)
+	require.Equal(t, ":\n\n", <-flushed)
+
+	// Events are still written between keep-alives.
+	bus.Publish(eventbus.TopicHead, "head")
+	for chunk := range flushed {
+		if chunk != ":\n\n" {
+			require.Equal(t, "event: head\ndata: \"head\"\n\n", chunk)
+			break
+		}
+	}
+
+	cancel()
+	require.NoError(t, <-done)
+}
+
+// failingWriter fails every write.
+type failingWriter struct{}
+
+var errTestWrite = errors.New("connection closed")
+
+func (failingWriter) Write([]byte) (int, error) { return 0, errTestWrite }
+
+func TestEventStreamKeepAliveWriteError(t *testing.T) {
+	stream := &eventStream{
+		bus:       eventbus.NewBus(eventbus.DefaultBufferSize),
+		topics:    []eventbus.Topic{eventbus.TopicHead},
+		keepAlive: time.Millisecond,
+	}
+	err := stream.Stream(context.Background(), failingWriter{}, func() {})
+	require.ErrorIs(t, err, errTestWrite)
+}
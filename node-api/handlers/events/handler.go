@@ -21,19 +21,29 @@
 package events
 
 import (
+	eventbus "github.com/berachain/beacon-kit/node-api/events"
 	"github.com/berachain/beacon-kit/node-api/handlers"
 	"github.com/berachain/beacon-kit/node-api/server/context"
 )
 
+// EventBus is the interface through which the events API subscribes to the
+// events published by the node.
+type EventBus interface {
+	// Subscribe registers a subscription to the given topics.
+	Subscribe(topics ...eventbus.Topic) *eventbus.Subscription
+}
+
 type Handler[ContextT context.Context] struct {
 	*handlers.BaseHandler[ContextT]
+	bus EventBus
 }
 
-func NewHandler[ContextT context.Context]() *Handler[ContextT] {
+func NewHandler[ContextT context.Context](bus EventBus) *Handler[ContextT] {
 	h := &Handler[ContextT]{
 		BaseHandler: handlers.NewBaseHandler(
 			handlers.NewRouteSet[ContextT](""),
 		),
+		bus: bus,
 	}
 	return h
 }
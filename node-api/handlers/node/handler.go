@@ -27,13 +27,23 @@ import (
 
 type Handler[ContextT context.Context] struct {
 	*handlers.BaseHandler[ContextT]
+	backend         Backend
+	executionClient ExecutionClient
+	version         string
 }
 
-func NewHandler[ContextT context.Context]() *Handler[ContextT] {
+func NewHandler[ContextT context.Context](
+	backend Backend,
+	executionClient ExecutionClient,
+	version string,
+) *Handler[ContextT] {
 	h := &Handler[ContextT]{
 		BaseHandler: handlers.NewBaseHandler(
 			handlers.NewRouteSet[ContextT](""),
 		),
+		backend:         backend,
+		executionClient: executionClient,
+		version:         version,
 	}
 	return h
 }
@@ -0,0 +1,70 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package node
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/berachain/beacon-kit/errors"
+	nodetypes "github.com/berachain/beacon-kit/node-api/handlers/node/types"
+	"github.com/berachain/beacon-kit/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/node-api/handlers/utils"
+)
+
+// errInvalidSyncingStatus is returned when the requested syncing status is
+// not a valid HTTP status code.
+var errInvalidSyncingStatus = errors.New("syncing_status must be within 100-599")
+
+// Health returns the health of the node through the status code: 200 if the
+// node is ready, the requested syncing status (206 by default) if it is
+// syncing, and 503 if it is not initialized or its execution client is
+// offline.
+func (h *Handler[ContextT]) Health(c ContextT) (any, error) {
+	req, err := utils.BindAndValidate[nodetypes.HealthRequest](
+		c, h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	syncingStatus := http.StatusPartialContent
+	if req.SyncingStatus != "" {
+		syncingStatus, err = strconv.Atoi(req.SyncingStatus)
+		if err != nil {
+			return nil, errors.Join(types.ErrInvalidRequest, err)
+		}
+		if syncingStatus < 100 || syncingStatus > 599 {
+			return nil, errors.Join(
+				types.ErrInvalidRequest, errInvalidSyncingStatus,
+			)
+		}
+	}
+
+	data, err := h.backend.SyncingData()
+	switch {
+	case err != nil, !h.executionClient.IsConnected():
+		return types.StatusResponse{Code: http.StatusServiceUnavailable}, nil
+	case data.IsSyncing:
+		return types.StatusResponse{Code: syncingStatus}, nil
+	default:
+		return types.StatusResponse{Code: http.StatusOK}, nil
+	}
+}
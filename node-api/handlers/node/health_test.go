@@ -0,0 +1,195 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package node_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/log/noop"
+	"github.com/berachain/beacon-kit/node-api/handlers/node"
+	nodetypes "github.com/berachain/beacon-kit/node-api/handlers/node/types"
+	"github.com/berachain/beacon-kit/node-api/handlers/types"
+	"github.com/stretchr/testify/require"
+)
+
+var errTestSyncing = errors.New("syncing data unavailable")
+
+// testContext is a request context binding the given syncing status.
+type testContext struct {
+	syncingStatus string
+}
+
+func (c testContext) Bind(req any) error {
+	if r, ok := req.(*nodetypes.HealthRequest); ok {
+		r.SyncingStatus = c.syncingStatus
+	}
+	return nil
+}
+
+func (testContext) Validate(any) error { return nil }
+
+// testBackend serves fixed sync data.
+type testBackend struct {
+	data *nodetypes.SyncingData
+	err  error
+}
+
+func (b testBackend) SyncingData() (*nodetypes.SyncingData, error) {
+	if b.err != nil {
+		return nil, b.err
+	}
+	data := *b.data
+	return &data, nil
+}
+
+// testExecutionClient reports a fixed execution client reachability.
+type testExecutionClient bool
+
+func (c testExecutionClient) IsConnected() bool { return bool(c) }
+
+func newTestHandler(
+	backend testBackend,
+	connected bool,
+) *node.Handler[testContext] {
+	h := node.NewHandler[testContext](
+		backend, testExecutionClient(connected), "v0.0.0",
+	)
+	h.SetLogger(noop.NewLogger[any]())
+	return h
+}
+
+func TestHealth(t *testing.T) {
+	var (
+		synced  = testBackend{data: &nodetypes.SyncingData{HeadSlot: 10}}
+		syncing = testBackend{data: &nodetypes.SyncingData{
+			HeadSlot: 10, SyncDistance: 5, IsSyncing: true,
+		}}
+	)
+	tests := []struct {
+		name          string
+		backend       testBackend
+		disconnected  bool
+		syncingStatus string
+		expectedCode  int
+		expectedErr   error
+	}{
+		{
+			name:         "ready",
+			backend:      synced,
+			expectedCode: http.StatusOK,
+		},
+		{
+			name:         "syncing",
+			backend:      syncing,
+			expectedCode: http.StatusPartialContent,
+		},
+		{
+			name:          "syncing with custom status",
+			backend:       syncing,
+			syncingStatus: "299",
+			expectedCode:  299,
+		},
+		{
+			name:          "ready ignores custom status",
+			backend:       synced,
+			syncingStatus: "299",
+			expectedCode:  http.StatusOK,
+		},
+		{
+			name:         "execution client disconnected",
+			backend:      synced,
+			disconnected: true,
+			expectedCode: http.StatusServiceUnavailable,
+		},
+		{
+			name:         "syncing data unavailable",
+			backend:      testBackend{err: errTestSyncing},
+			expectedCode: http.StatusServiceUnavailable,
+		},
+		{
+			name:          "lowest custom status",
+			backend:       syncing,
+			syncingStatus: "100",
+			expectedCode:  http.StatusContinue,
+		},
+		{
+			name:          "highest custom status",
+			backend:       syncing,
+			syncingStatus: "599",
+			expectedCode:  599,
+		},
+		{
+			name:          "custom status too low",
+			backend:       syncing,
+			syncingStatus: "99",
+			expectedErr:   types.ErrInvalidRequest,
+		},
+		{
+			name:          "custom status too high",
+			backend:       syncing,
+			syncingStatus: "600",
+			expectedErr:   types.ErrInvalidRequest,
+		},
+		{
+			name:          "custom status not a number",
+			backend:       syncing,
+			syncingStatus: "ok",
+			expectedErr:   types.ErrInvalidRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := newTestHandler(tt.backend, !tt.disconnected)
+			res, err := h.Health(testContext{syncingStatus: tt.syncingStatus})
+			if tt.expectedErr != nil {
+				require.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, types.StatusResponse{Code: tt.expectedCode}, res)
+		})
+	}
+}
+
+func TestSyncing(t *testing.T) {
+	backend := testBackend{data: &nodetypes.SyncingData{
+		HeadSlot: 10, SyncDistance: 5, IsSyncing: true,
+	}}
+
+	res, err := newTestHandler(backend, true).Syncing(testContext{})
+	require.NoError(t, err)
+	require.Equal(t, types.Wrap(&nodetypes.SyncingData{
+		HeadSlot: 10, SyncDistance: 5, IsSyncing: true,
+	}), res)
+
+	res, err = newTestHandler(backend, false).Syncing(testContext{})
+	require.NoError(t, err)
+	require.Equal(t, types.Wrap(&nodetypes.SyncingData{
+		HeadSlot: 10, SyncDistance: 5, IsSyncing: true, ELOffline: true,
+	}), res)
+
+	_, err = newTestHandler(
+		testBackend{err: errTestSyncing}, true,
+	).Syncing(testContext{})
+	require.ErrorIs(t, err, errTestSyncing)
+}
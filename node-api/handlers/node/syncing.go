@@ -20,42 +20,22 @@
 
 package node
 
-// Syncing is a placeholder so that beacon API clients don't break.
-//
-// TODO: Implement with real data.
+import (
+	nodetypes "github.com/berachain/beacon-kit/node-api/handlers/node/types"
+	"github.com/berachain/beacon-kit/node-api/handlers/types"
+)
+
+// Syncing returns the sync status of the node.
 func (h *Handler[ContextT]) Syncing(ContextT) (any, error) {
-	type SyncingResponse struct {
-		Data struct {
-			HeadSlot     string `json:"head_slot"`
-			SyncDistance string `json:"sync_distance"`
-			IsSyncing    bool   `json:"is_syncing"`
-			IsOptimistic bool   `json:"is_optimistic"`
-			ELOffline    bool   `json:"el_offline"`
-		} `json:"data"`
+	data, err := h.backend.SyncingData()
+	if err != nil {
+		return nil, err
 	}
-
-	response := SyncingResponse{}
-	response.Data.HeadSlot = "0"
-	response.Data.SyncDistance = "1"
-	response.Data.IsSyncing = false
-	response.Data.IsOptimistic = true
-	response.Data.ELOffline = false
-
-	return response, nil
+	data.ELOffline = !h.executionClient.IsConnected()
+	return types.Wrap(data), nil
 }
 
-// Version is a placeholder so that beacon API clients don't break.
-//
-// TODO: Implement with real data.
+// Version returns the version of the node.
 func (h *Handler[ContextT]) Version(ContextT) (any, error) {
-	type VersionResponse struct {
-		Data struct {
-			Version string `json:"version"`
-		} `json:"data"`
-	}
-
-	response := VersionResponse{}
-	response.Data.Version = "1.0.0"
-
-	return response, nil
+	return types.Wrap(&nodetypes.VersionData{Version: h.version}), nil
 }
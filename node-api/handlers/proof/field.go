@@ -0,0 +1,96 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package proof
+
+import (
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/node-api/handlers/proof/merkle"
+	"github.com/berachain/beacon-kit/node-api/handlers/proof/types"
+	handlertypes "github.com/berachain/beacon-kit/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/node-api/handlers/utils"
+	"github.com/berachain/beacon-kit/primitives/math"
+)
+
+// GetStateFieldProof returns the merkle proof of the beacon state field at
+// the given path, e.g. `validators/12/withdrawal_credentials`, for the given
+// timestamp id. The proof can be verified against the beacon block root.
+func (h *Handler[ContextT]) GetStateFieldProof(c ContextT) (any, error) {
+	params, err := utils.BindAndValidate[types.StateFieldProofRequest](
+		c, h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	fp, err := merkle.NewStateFieldPath(params.Path)
+	if err != nil {
+		return nil, errors.Join(handlertypes.ErrInvalidRequest, err)
+	}
+	return h.proveField(params.TimestampID, fp)
+}
+
+// GetBlockFieldProof returns the merkle proof of the beacon block header
+// field at the given path, e.g. `proposer_index`, for the given timestamp id.
+// Paths under `state_root` descend into the beacon state. The proof can be
+// verified against the beacon block root.
+func (h *Handler[ContextT]) GetBlockFieldProof(c ContextT) (any, error) {
+	params, err := utils.BindAndValidate[types.BlockFieldProofRequest](
+		c, h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	fp, err := merkle.NewBlockFieldPath(params.Path)
+	if err != nil {
+		return nil, errors.Join(handlertypes.ErrInvalidRequest, err)
+	}
+	return h.proveField(params.TimestampID, fp)
+}
+
+// proveField generates the proof of the field at the given path in the
+// beacon block for the given timestamp id.
+func (h *Handler[_]) proveField(
+	timestampID string, fp *merkle.FieldPath,
+) (any, error) {
+	slot, beaconState, blockHeader, err := h.resolveTimestampID(timestampID)
+	if err != nil {
+		return nil, err
+	}
+
+	h.Logger().Info("Generating field proof", "slot", slot, "path", fp)
+	proof, leaf, gIndex, beaconBlockRoot, err := merkle.ProveFieldInBlock(
+		blockHeader, beaconState, fp,
+	)
+	if errors.Is(err, merkle.ErrFieldNotFound) {
+		return nil, errors.Join(handlertypes.ErrNotFound, err)
+	} else if err != nil {
+		return nil, err
+	}
+
+	return types.FieldProofResponse{
+		BeaconBlockHeader: blockHeader,
+		BeaconBlockRoot:   beaconBlockRoot,
+		Path:              fp.String(),
+		GeneralizedIndex:  math.U64(gIndex),
+		Leaf:              leaf,
+		LeafOffset:        fp.Offset(),
+		Proof:             proof,
+	}, nil
+}
@@ -27,6 +27,7 @@ import (
 	"github.com/berachain/beacon-kit/primitives/common"
 	"github.com/berachain/beacon-kit/primitives/encoding/ssz/merkle"
 	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/version"
 )
 
 // ProveProposerPubkeyInBlock generates a proof for the proposer pubkey in the
@@ -41,8 +42,12 @@ func ProveProposerPubkeyInBlock[
 ) ([]common.Root, common.Root, error) {
 	// Get the proof of the proposer pubkey in the beacon state.
 	proposerOffset := ValidatorPubkeyGIndexOffset * bbh.GetProposerIndex()
-	valPubkeyInStateProof, leaf, err := ProveProposerPubkeyInState(
-		bs, proposerOffset,
+	bsm, err := bs.GetMarshallable()
+	if err != nil {
+		return nil, common.Root{}, err
+	}
+	valPubkeyInStateProof, leaf, err := proveProposerPubkeyInState(
+		bsm, proposerOffset,
 	)
 	if err != nil {
 		return nil, common.Root{}, err
@@ -58,8 +63,12 @@ func ProveProposerPubkeyInBlock[
 	//
 	//nolint:gocritic // ok.
 	combinedProof := append(valPubkeyInStateProof, stateInBlockProof...)
+	zeroGIndex := math.U64(ZeroValidatorPubkeyGIndexDenebBlock)
+	if bsm.Version() >= version.Electra {
+		zeroGIndex = ZeroValidatorPubkeyGIndexElectraBlock
+	}
 	beaconRoot, err := verifyProposerInBlock(
-		bbh, proposerOffset, combinedProof, leaf,
+		bbh, zeroGIndex+proposerOffset, combinedProof, leaf,
 	)
 	if err != nil {
 		return nil, common.Root{}, err
@@ -80,13 +89,26 @@ func ProveProposerPubkeyInState[
 	if err != nil {
 		return nil, common.Root{}, err
 	}
+	return proveProposerPubkeyInState(bsm, proposerOffset)
+}
+
+// proveProposerPubkeyInState generates a proof for the proposer pubkey in
+// the given marshallable beacon state.
+func proveProposerPubkeyInState(
+	bsm types.BeaconStateMarshallable,
+	proposerOffset math.U64,
+) ([]common.Root, common.Root, error) {
 	stateProofTree, err := bsm.GetTree()
 	if err != nil {
 		return nil, common.Root{}, err
 	}
 
+	zeroGIndex := ZeroValidatorPubkeyGIndexDenebState
+	if bsm.Version() >= version.Electra {
+		zeroGIndex = ZeroValidatorPubkeyGIndexElectraState
+	}
 	//#nosec:G701 // max proposer offset is 8 * (2^40 - 1).
-	gIndex := ZeroValidatorPubkeyGIndexDenebState + int(proposerOffset)
+	gIndex := zeroGIndex + int(proposerOffset)
 	valPubkeyInStateProof, err := stateProofTree.Prove(gIndex)
 	if err != nil {
 		return nil, common.Root{}, err
@@ -105,13 +127,13 @@ func ProveProposerPubkeyInState[
 // TODO: verifying the proof is not absolutely necessary.
 func verifyProposerInBlock(
 	bbh *ctypes.BeaconBlockHeader,
-	valOffset math.U64,
+	gIndex math.U64,
 	proof []common.Root,
 	leaf common.Root,
 ) (common.Root, error) {
 	beaconRoot := bbh.HashTreeRoot()
 	if beaconRootVerified, err := merkle.VerifyProof(
-		merkle.GeneralizedIndex(ZeroValidatorPubkeyGIndexDenebBlock+valOffset),
+		merkle.GeneralizedIndex(gIndex),
 		leaf, proof, beaconRoot,
 	); err != nil {
 		return common.Root{}, err
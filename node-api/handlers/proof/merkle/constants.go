@@ -68,4 +68,32 @@ const (
 	// in the Deneb fork. This is calculated by concatenating the
 	// (ExecutionFeeRecipientGIndexDenebState, StateGIndexDenebBlock) GIndices.
 	ExecutionFeeRecipientGIndexDenebBlock = 5889
+
+	// ZeroValidatorPubkeyGIndexElectraState is the generalized index of the 0
+	// validator's pubkey in the beacon state in the Electra fork.
+	ZeroValidatorPubkeyGIndexElectraState = 721279627821056
+
+	// ZeroValidatorPubkeyGIndexElectraBlock is the generalized index of the 0
+	// validator's pubkey in the beacon block in the Electra fork.
+	ZeroValidatorPubkeyGIndexElectraBlock = 6350779162034176
+
+	// ExecutionNumberGIndexElectraState is the generalized index of the number
+	// in the latest execution payload header in the beacon state in the
+	// Electra fork.
+	ExecutionNumberGIndexElectraState = 1286
+
+	// ExecutionNumberGIndexElectraBlock is the generalized index of the number
+	// in the latest execution payload header in the beacon block in the
+	// Electra fork.
+	ExecutionNumberGIndexElectraBlock = 11526
+
+	// ExecutionFeeRecipientGIndexElectraState is the generalized index of the
+	// fee recipient in the latest execution payload header in the beacon state
+	// in the Electra fork.
+	ExecutionFeeRecipientGIndexElectraState = 1281
+
+	// ExecutionFeeRecipientGIndexElectraBlock is the generalized index of the
+	// fee recipient in the latest execution payload header in the beacon block
+	// in the Electra fork.
+	ExecutionFeeRecipientGIndexElectraBlock = 11521
 )
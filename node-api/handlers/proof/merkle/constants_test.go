@@ -23,99 +23,20 @@ package merkle_test
 import (
 	"testing"
 
-	"github.com/berachain/beacon-kit/consensus-types/types"
 	"github.com/berachain/beacon-kit/node-api/handlers/proof/merkle"
 	mlib "github.com/berachain/beacon-kit/primitives/encoding/ssz/merkle"
-	"github.com/berachain/beacon-kit/primitives/encoding/ssz/schema"
 	"github.com/stretchr/testify/require"
 )
 
-var (
-	// beaconStateSchema is the schema for the BeaconState struct defined in
-	// beacon-kit/mod/consensus-types/types/state.go.
-	beaconStateSchema = schema.DefineContainer(
-		schema.NewField("GenesisValidatorsRoot", schema.B32()),
-		schema.NewField("Slot", schema.U64()),
-		schema.NewField("Fork", schema.DefineContainer(
-			schema.NewField("PreviousVersion", schema.B4()),
-			schema.NewField("CurrentVersion", schema.B4()),
-			schema.NewField("Epoch", schema.U64()),
-		)),
-		schema.NewField("LatestBlockHeader", schema.DefineContainer(
-			schema.NewField("Slot", schema.U64()),
-			schema.NewField("ProposerIndex", schema.U64()),
-			schema.NewField("ParentBlockRoot", schema.B32()),
-			schema.NewField("StateRoot", schema.B32()),
-			schema.NewField("BodyRoot", schema.B32()),
-		)),
-		schema.NewField("BlockRoots", schema.DefineList(schema.B32(), 8192)),
-		schema.NewField("StateRoots", schema.DefineList(schema.B32(), 8192)),
-		schema.NewField("Eth1Data", schema.DefineContainer(
-			schema.NewField("DepositRoot", schema.B32()),
-			schema.NewField("DepositCount", schema.U64()),
-			schema.NewField("BlockHash", schema.B32()),
-		)),
-		schema.NewField("Eth1DepositIndex", schema.U64()),
-		schema.NewField("LatestExecutionPayloadHeader", schema.DefineContainer(
-			schema.NewField("ParentHash", schema.B32()),
-			schema.NewField("FeeRecipient", schema.B20()),
-			schema.NewField("StateRoot", schema.B32()),
-			schema.NewField("ReceiptsRoot", schema.B32()),
-			schema.NewField("LogsBloom", schema.B256()),
-			schema.NewField("Random", schema.U64()),
-			schema.NewField("Number", schema.U64()),
-			schema.NewField("GasLimit", schema.U64()),
-			schema.NewField("GasUsed", schema.U64()),
-			schema.NewField("Timestamp", schema.U64()),
-			schema.NewField("ExtraData", schema.DefineByteList(32)),
-			schema.NewField("BaseFeePerGas", schema.B32()),
-			schema.NewField("BlockHash", schema.B32()),
-			schema.NewField("TransactionsRoot", schema.B32()),
-			schema.NewField("WithdrawalsRoot", schema.B32()),
-			schema.NewField("BlobGasUsed", schema.U64()),
-			schema.NewField("ExcessBlobGas", schema.U64()),
-		)),
-		schema.NewField("Validators", schema.DefineList(schema.DefineContainer(
-			schema.NewField("Pubkey", schema.B48()),
-			schema.NewField("WithdrawalCredentials", schema.B32()),
-			schema.NewField("EffectiveBalance", schema.U64()),
-			schema.NewField("Slashed", schema.Bool()),
-			schema.NewField("ActivationEligibilityEpoch", schema.U64()),
-			schema.NewField("ActivationEpoch", schema.U64()),
-			schema.NewField("ExitEpoch", schema.U64()),
-			schema.NewField("WithdrawableEpoch", schema.U64()),
-		), types.MaxValidators)),
-		schema.NewField(
-			"Balances", schema.DefineList(schema.U64(), types.MaxValidators),
-		),
-		schema.NewField("RandaoMixes", schema.DefineList(schema.B32(), 65536)),
-		schema.NewField("NextWithdrawalIndex", schema.U64()),
-		schema.NewField("NextWithdrawalValidatorIndex", schema.U64()),
-		schema.NewField(
-			"Slashings", schema.DefineList(schema.U64(), types.MaxValidators),
-		),
-		schema.NewField("TotalSlashing", schema.U64()),
-	)
-
-	// beaconHeaderSchema is the schema for the BeaconBlockHeader struct defined
-	// in beacon-kit/mod/consensus-types/types/header.go, with the SSZ
-	// expansion of StateRoot to use the BeaconState.
-	beaconHeaderSchema = schema.DefineContainer(
-		schema.NewField("Slot", schema.U64()),
-		schema.NewField("ProposerIndex", schema.U64()),
-		schema.NewField("ParentRoot", schema.B32()),
-		schema.NewField("State", beaconStateSchema),
-		schema.NewField("BodyRoot", schema.B32()),
-	)
-)
-
 // TestGIndexProposerIndexDeneb tests the generalized index of the proposer
 // index in the beacon block on the Deneb fork.
 func TestGIndexProposerIndexDeneb(t *testing.T) {
+	headerSchema := derivedHeaderSchema(t, false)
+
 	// GIndex of the proposer index in the beacon block.
 	_, proposerIndexGIndexDenebBlock, _, err := mlib.ObjectPath[
 		mlib.GeneralizedIndex, [32]byte,
-	]("ProposerIndex").GetGeneralizedIndex(beaconHeaderSchema)
+	]("ProposerIndex").GetGeneralizedIndex(headerSchema)
 	require.NoError(t, err)
 	require.Equal(
 		t,
@@ -127,17 +48,20 @@ func TestGIndexProposerIndexDeneb(t *testing.T) {
 // TestGIndicesValidatorPubkeyDeneb tests the generalized indices used by
 // beacon state proofs for validator pubkeys on the Deneb fork.
 func TestGIndicesValidatorPubkeyDeneb(t *testing.T) {
+	stateSchema := derivedStateSchema(t, false)
+	headerSchema := derivedHeaderSchema(t, false)
+
 	// GIndex of state in the block.
 	_, stateGIndexDenebBlock, _, err := mlib.ObjectPath[
 		mlib.GeneralizedIndex, [32]byte,
-	]("State").GetGeneralizedIndex(beaconHeaderSchema)
+	]("StateRoot").GetGeneralizedIndex(headerSchema)
 	require.NoError(t, err)
 	require.Equal(t, merkle.StateGIndexDenebBlock, int(stateGIndexDenebBlock))
 
 	// GIndex of the 0 validator's pubkey in the state.
 	_, zeroValidatorPubkeyGIndexDenebState, _, err := mlib.ObjectPath[
 		mlib.GeneralizedIndex, [32]byte,
-	]("Validators/0/Pubkey").GetGeneralizedIndex(beaconStateSchema)
+	]("Validators/0/Pubkey").GetGeneralizedIndex(stateSchema)
 	require.NoError(t, err)
 	require.Equal(t,
 		merkle.ZeroValidatorPubkeyGIndexDenebState,
@@ -147,7 +71,9 @@ func TestGIndicesValidatorPubkeyDeneb(t *testing.T) {
 	// GIndex of the 0 validator's pubkey in the block.
 	_, zeroValidatorPubkeyGIndexDenebBlock, _, err := mlib.ObjectPath[
 		mlib.GeneralizedIndex, [32]byte,
-	]("State/Validators/0/Pubkey").GetGeneralizedIndex(beaconHeaderSchema)
+	]("StateRoot/Validators/0/Pubkey").GetGeneralizedIndex(
+		headerSchema,
+	)
 	require.NoError(t, err)
 	require.Equal(t,
 		merkle.ZeroValidatorPubkeyGIndexDenebBlock,
@@ -167,7 +93,7 @@ func TestGIndicesValidatorPubkeyDeneb(t *testing.T) {
 	// GIndex offset of the next validator's pubkey.
 	_, oneValidatorPubkeyGIndexDenebState, _, err := mlib.ObjectPath[
 		mlib.GeneralizedIndex, [32]byte,
-	]("Validators/1/Pubkey").GetGeneralizedIndex(beaconStateSchema)
+	]("Validators/1/Pubkey").GetGeneralizedIndex(stateSchema)
 	require.NoError(t, err)
 	require.Equal(t,
 		mlib.GeneralizedIndex(merkle.ValidatorPubkeyGIndexOffset),
@@ -178,11 +104,14 @@ func TestGIndicesValidatorPubkeyDeneb(t *testing.T) {
 // TestGInidicesExecutionDeneb tests the generalized indices used by
 // beacon state proofs from the execution payload header on the Deneb fork.
 func TestGInidicesExecutionDeneb(t *testing.T) {
+	stateSchema := derivedStateSchema(t, false)
+	headerSchema := derivedHeaderSchema(t, false)
+
 	// GIndex of the execution number in the state.
 	_, executionNumberGIndexDenebState, _, err := mlib.ObjectPath[
 		mlib.GeneralizedIndex, [32]byte,
 	]("LatestExecutionPayloadHeader/Number").GetGeneralizedIndex(
-		beaconStateSchema,
+		stateSchema,
 	)
 	require.NoError(t, err)
 	require.Equal(t,
@@ -193,8 +122,8 @@ func TestGInidicesExecutionDeneb(t *testing.T) {
 	// GIndex of the execution number in the block.
 	_, executionNumberGIndexDenebBlock, _, err := mlib.ObjectPath[
 		mlib.GeneralizedIndex, [32]byte,
-	]("State/LatestExecutionPayloadHeader/Number").GetGeneralizedIndex(
-		beaconHeaderSchema,
+	]("StateRoot/LatestExecutionPayloadHeader/Number").GetGeneralizedIndex(
+		headerSchema,
 	)
 	require.NoError(t, err)
 	require.Equal(t,
@@ -216,7 +145,7 @@ func TestGInidicesExecutionDeneb(t *testing.T) {
 	_, executionFeeRecipientGIndexDenebState, _, err := mlib.ObjectPath[
 		mlib.GeneralizedIndex, [32]byte,
 	]("LatestExecutionPayloadHeader/FeeRecipient").GetGeneralizedIndex(
-		beaconStateSchema,
+		stateSchema,
 	)
 	require.NoError(t, err)
 	require.Equal(t,
@@ -227,8 +156,8 @@ func TestGInidicesExecutionDeneb(t *testing.T) {
 	// GIndex of the execution fee recipient in the block.
 	_, executionFeeRecipientGIndexDenebBlock, _, err := mlib.ObjectPath[
 		mlib.GeneralizedIndex, [32]byte,
-	]("State/LatestExecutionPayloadHeader/FeeRecipient").GetGeneralizedIndex(
-		beaconHeaderSchema,
+	]("StateRoot/LatestExecutionPayloadHeader/FeeRecipient").GetGeneralizedIndex(
+		headerSchema,
 	)
 	require.NoError(t, err)
 	require.Equal(t,
@@ -246,3 +175,53 @@ func TestGInidicesExecutionDeneb(t *testing.T) {
 		concatExecutionFeeRecipientStateToBlock,
 	)
 }
+
+// TestGIndicesElectra tests the generalized indices used by beacon state
+// proofs on the Electra fork, whose beacon state has more fields than Deneb.
+func TestGIndicesElectra(t *testing.T) {
+	stateSchema := derivedStateSchema(t, true)
+	headerSchema := derivedHeaderSchema(t, true)
+
+	testCases := []struct {
+		path      string
+		stateGIdx int
+		blockGIdx int
+	}{
+		{
+			path:      "Validators/0/Pubkey",
+			stateGIdx: merkle.ZeroValidatorPubkeyGIndexElectraState,
+			blockGIdx: merkle.ZeroValidatorPubkeyGIndexElectraBlock,
+		},
+		{
+			path:      "LatestExecutionPayloadHeader/Number",
+			stateGIdx: merkle.ExecutionNumberGIndexElectraState,
+			blockGIdx: merkle.ExecutionNumberGIndexElectraBlock,
+		},
+		{
+			path:      "LatestExecutionPayloadHeader/FeeRecipient",
+			stateGIdx: merkle.ExecutionFeeRecipientGIndexElectraState,
+			blockGIdx: merkle.ExecutionFeeRecipientGIndexElectraBlock,
+		},
+	}
+
+	for _, tc := range testCases {
+		_, stateGIndex, _, err := mlib.ObjectPath[
+			mlib.GeneralizedIndex, [32]byte,
+		](tc.path).GetGeneralizedIndex(stateSchema)
+		require.NoError(t, err)
+		require.Equal(t, tc.stateGIdx, int(stateGIndex), tc.path)
+
+		_, blockGIndex, _, err := mlib.ObjectPath[
+			mlib.GeneralizedIndex, [32]byte,
+		]("StateRoot/" + tc.path).GetGeneralizedIndex(
+			headerSchema,
+		)
+		require.NoError(t, err)
+		require.Equal(t, tc.blockGIdx, int(blockGIndex), tc.path)
+
+		// The beacon state is at the same place in the block as in Deneb.
+		require.Equal(t, blockGIndex, mlib.GeneralizedIndices{
+			merkle.StateGIndexDenebBlock, stateGIndex,
+		}.Concat(), tc.path)
+	}
+}
@@ -27,6 +27,7 @@ import (
 	"github.com/berachain/beacon-kit/node-api/handlers/proof/types"
 	"github.com/berachain/beacon-kit/primitives/common"
 	"github.com/berachain/beacon-kit/primitives/encoding/ssz/merkle"
+	"github.com/berachain/beacon-kit/primitives/version"
 )
 
 // ProveExecutionFeeRecipientInBlock generates a proof for the fee recipient in
@@ -41,7 +42,13 @@ func ProveExecutionFeeRecipientInBlock[
 	bs types.BeaconState[BeaconStateMarshallableT],
 ) ([]common.Root, common.Root, error) {
 	// Get the proof of the execution fee recipient in the beacon state.
-	feeRecipientInStateProof, leaf, err := ProveExecutionFeeRecipientInState(bs)
+	bsm, err := bs.GetMarshallable()
+	if err != nil {
+		return nil, common.Root{}, err
+	}
+	feeRecipientInStateProof, leaf, err := proveExecutionFeeRecipientInState(
+		bsm,
+	)
 	if err != nil {
 		return nil, common.Root{}, err
 	}
@@ -56,8 +63,12 @@ func ProveExecutionFeeRecipientInBlock[
 	//
 	//nolint:gocritic // ok.
 	combinedProof := append(feeRecipientInStateProof, stateInBlockProof...)
+	gIndex := merkle.GeneralizedIndex(ExecutionFeeRecipientGIndexDenebBlock)
+	if bsm.Version() >= version.Electra {
+		gIndex = ExecutionFeeRecipientGIndexElectraBlock
+	}
 	beaconRoot, err := verifyExecutionFeeRecipientInBlock(
-		bbh, combinedProof, leaf,
+		bbh, gIndex, combinedProof, leaf,
 	)
 	if err != nil {
 		return nil, common.Root{}, err
@@ -78,14 +89,24 @@ func ProveExecutionFeeRecipientInState[
 	if err != nil {
 		return nil, common.Root{}, err
 	}
+	return proveExecutionFeeRecipientInState(bsm)
+}
+
+// proveExecutionFeeRecipientInState generates a proof for the execution fee
+// recipient in the given marshallable beacon state.
+func proveExecutionFeeRecipientInState(
+	bsm types.BeaconStateMarshallable,
+) ([]common.Root, common.Root, error) {
 	stateProofTree, err := bsm.GetTree()
 	if err != nil {
 		return nil, common.Root{}, err
 	}
 
-	feeRecipientInStateProof, err := stateProofTree.Prove(
-		ExecutionFeeRecipientGIndexDenebState,
-	)
+	gIndex := ExecutionFeeRecipientGIndexDenebState
+	if bsm.Version() >= version.Electra {
+		gIndex = ExecutionFeeRecipientGIndexElectraState
+	}
+	feeRecipientInStateProof, err := stateProofTree.Prove(gIndex)
 	if err != nil {
 		return nil, common.Root{}, err
 	}
@@ -104,12 +125,13 @@ func ProveExecutionFeeRecipientInState[
 // TODO: verifying the proof is not absolutely necessary.
 func verifyExecutionFeeRecipientInBlock(
 	bbh *ctypes.BeaconBlockHeader,
+	gIndex merkle.GeneralizedIndex,
 	proof []common.Root,
 	leaf common.Root,
 ) (common.Root, error) {
 	beaconRoot := bbh.HashTreeRoot()
 	if beaconRootVerified, err := merkle.VerifyProof(
-		ExecutionFeeRecipientGIndexDenebBlock, leaf, proof, beaconRoot,
+		gIndex, leaf, proof, beaconRoot,
 	); err != nil {
 		return common.Root{}, err
 	} else if !beaconRootVerified {
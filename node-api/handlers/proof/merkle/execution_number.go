@@ -27,6 +27,7 @@ import (
 	"github.com/berachain/beacon-kit/node-api/handlers/proof/types"
 	"github.com/berachain/beacon-kit/primitives/common"
 	"github.com/berachain/beacon-kit/primitives/encoding/ssz/merkle"
+	"github.com/berachain/beacon-kit/primitives/version"
 )
 
 // ProveExecutionNumberInBlock generates a proof for the block number of the
@@ -41,7 +42,11 @@ func ProveExecutionNumberInBlock[
 	bs types.BeaconState[BeaconStateMarshallableT],
 ) ([]common.Root, common.Root, error) {
 	// Get the proof of the execution number in the beacon state.
-	numberInStateProof, leaf, err := ProveExecutionNumberInState(bs)
+	bsm, err := bs.GetMarshallable()
+	if err != nil {
+		return nil, common.Root{}, err
+	}
+	numberInStateProof, leaf, err := proveExecutionNumberInState(bsm)
 	if err != nil {
 		return nil, common.Root{}, err
 	}
@@ -56,7 +61,13 @@ func ProveExecutionNumberInBlock[
 	//
 	//nolint:gocritic // ok.
 	combinedProof := append(numberInStateProof, stateInBlockProof...)
-	beaconRoot, err := verifyExecutionNumberInBlock(bbh, combinedProof, leaf)
+	gIndex := merkle.GeneralizedIndex(ExecutionNumberGIndexDenebBlock)
+	if bsm.Version() >= version.Electra {
+		gIndex = ExecutionNumberGIndexElectraBlock
+	}
+	beaconRoot, err := verifyExecutionNumberInBlock(
+		bbh, gIndex, combinedProof, leaf,
+	)
 	if err != nil {
 		return nil, common.Root{}, err
 	}
@@ -75,14 +86,24 @@ func ProveExecutionNumberInState[
 	if err != nil {
 		return nil, common.Root{}, err
 	}
+	return proveExecutionNumberInState(bsm)
+}
+
+// proveExecutionNumberInState generates a proof for the block number of the
+// execution payload in the given marshallable beacon state.
+func proveExecutionNumberInState(
+	bsm types.BeaconStateMarshallable,
+) ([]common.Root, common.Root, error) {
 	stateProofTree, err := bsm.GetTree()
 	if err != nil {
 		return nil, common.Root{}, err
 	}
 
-	numberInStateProof, err := stateProofTree.Prove(
-		ExecutionNumberGIndexDenebState,
-	)
+	gIndex := ExecutionNumberGIndexDenebState
+	if bsm.Version() >= version.Electra {
+		gIndex = ExecutionNumberGIndexElectraState
+	}
+	numberInStateProof, err := stateProofTree.Prove(gIndex)
 	if err != nil {
 		return nil, common.Root{}, err
 	}
@@ -100,12 +121,13 @@ func ProveExecutionNumberInState[
 // TODO: verifying the proof is not absolutely necessary.
 func verifyExecutionNumberInBlock(
 	bbh *ctypes.BeaconBlockHeader,
+	gIndex merkle.GeneralizedIndex,
 	proof []common.Root,
 	leaf common.Root,
 ) (common.Root, error) {
 	beaconRoot := bbh.HashTreeRoot()
 	if beaconRootVerified, err := merkle.VerifyProof(
-		ExecutionNumberGIndexDenebBlock, leaf, proof, beaconRoot,
+		gIndex, leaf, proof, beaconRoot,
 	); err != nil {
 		return common.Root{}, err
 	} else if !beaconRootVerified {
@@ -0,0 +1,319 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package merkle
+
+import (
+	"strings"
+
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/node-api/handlers/proof/types"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/encoding/ssz/merkle"
+	"github.com/berachain/beacon-kit/primitives/encoding/ssz/schema"
+	"github.com/berachain/beacon-kit/primitives/version"
+	fastssz "github.com/ferranbt/fastssz"
+)
+
+const (
+	// pathSeparator separates the parts of a field path.
+	pathSeparator = "/"
+
+	// lengthPathPart is the path part that selects the length of a list.
+	lengthPathPart = "__len__"
+
+	// stateField is the name of the beacon block header field that commits
+	// to the beacon state.
+	stateField = "StateRoot"
+)
+
+// FieldPath is a path to a field in the beacon block, resolved against the
+// SSZ schemas of each fork.
+type FieldPath struct {
+	// path is the normalized path of the field in the beacon block header.
+	path string
+	// deneb holds the generalized indices of the field in the Deneb fork,
+	// which are 0 if the field is not part of the Deneb beacon block.
+	deneb fieldGIndices
+	// electra holds the generalized indices of the field in the Electra fork.
+	electra fieldGIndices
+	// offset is the byte offset of the field within its leaf chunk. It is
+	// only non-zero for basic types packed together, such as balances.
+	offset uint8
+}
+
+// fieldGIndices are the generalized indices of a field in one fork.
+type fieldGIndices struct {
+	// state is the generalized index of the field in the beacon state, or 0
+	// if the field is not part of the beacon state.
+	state merkle.GeneralizedIndex
+	// block is the generalized index of the field in the beacon block.
+	block merkle.GeneralizedIndex
+}
+
+// NewBlockFieldPath resolves a path to a field in the beacon block header,
+// e.g. `proposer_index` or `state_root/eth1_data/deposit_root`. Path parts
+// may be given in snake_case or in CamelCase.
+func NewBlockFieldPath(path string) (*FieldPath, error) {
+	parts, err := normalizePath(path)
+	if err != nil {
+		return nil, err
+	}
+	return newFieldPath(path, parts)
+}
+
+// NewStateFieldPath resolves a path to a field in the beacon state, e.g.
+// `validators/12/withdrawal_credentials` or `block_roots/5`.
+func NewStateFieldPath(path string) (*FieldPath, error) {
+	parts, err := normalizePath(path)
+	if err != nil {
+		return nil, err
+	}
+	return newFieldPath(path, append([]string{stateField}, parts...))
+}
+
+// newFieldPath resolves the generalized indices of the given normalized path
+// parts of a field in the beacon block header. The path must exist in the
+// Electra schemas; fields only added in Electra have no Deneb indices.
+func newFieldPath(path string, parts []string) (*FieldPath, error) {
+	var err error
+	fp := &FieldPath{path: strings.Join(parts, pathSeparator)}
+	fp.electra, fp.offset, err = resolveFieldGIndices(
+		parts, BeaconBlockHeaderSchemaElectra, BeaconStateSchemaElectra,
+	)
+	if err != nil {
+		return nil, errors.Wrapf(ErrInvalidFieldPath, "%s: %v", path, err)
+	}
+	fp.deneb, _, _ = resolveFieldGIndices(
+		parts, BeaconBlockHeaderSchemaDeneb, BeaconStateSchemaDeneb,
+	)
+	return fp, nil
+}
+
+// resolveFieldGIndices resolves the generalized indices of the given path
+// parts against the given beacon block header and beacon state schemas.
+func resolveFieldGIndices(
+	parts []string, headerSchema, stateSchema schema.SSZType,
+) (fieldGIndices, uint8, error) {
+	var (
+		gIndices fieldGIndices
+		offset   uint8
+		err      error
+	)
+	_, gIndices.block, offset, err = merkle.ObjectPath[
+		merkle.GeneralizedIndex, [32]byte,
+	](strings.Join(parts, pathSeparator)).GetGeneralizedIndex(headerSchema)
+	if err != nil {
+		return fieldGIndices{}, 0, err
+	}
+
+	// Fields nested under the state root are proven in the beacon state.
+	if len(parts) > 1 && parts[0] == stateField {
+		_, gIndices.state, _, err = merkle.ObjectPath[
+			merkle.GeneralizedIndex, [32]byte,
+		](strings.Join(parts[1:], pathSeparator)).GetGeneralizedIndex(
+			stateSchema,
+		)
+		if err != nil {
+			return fieldGIndices{}, 0, err
+		}
+	}
+	return gIndices, offset, nil
+}
+
+// GIndex returns the generalized index of the field in the beacon block in
+// the given fork version, or 0 if the field is not part of that fork.
+func (fp *FieldPath) GIndex(forkVersion uint32) merkle.GeneralizedIndex {
+	return fp.gIndices(forkVersion).block
+}
+
+// gIndices returns the generalized indices of the field in the given fork
+// version.
+func (fp *FieldPath) gIndices(forkVersion uint32) fieldGIndices {
+	if forkVersion >= version.Electra {
+		return fp.electra
+	}
+	return fp.deneb
+}
+
+// Offset returns the byte offset of the field within its leaf chunk.
+func (fp *FieldPath) Offset() uint8 {
+	return fp.offset
+}
+
+// String returns the normalized path of the field in the beacon block.
+func (fp *FieldPath) String() string {
+	return fp.path
+}
+
+// ProveFieldInBlock generates a proof for the field at the given path in the
+// beacon block. Fields of the beacon state are proven in the state and then
+// combined with the proof of the state in the block. The proof is verified
+// against the beacon block root as a sanity check. Returns the proof and the
+// leaf it proves, the generalized index of the leaf in the fork of the beacon
+// state, along with the beacon block root.
+func ProveFieldInBlock[
+	BeaconStateMarshallableT types.BeaconStateMarshallable,
+](
+	bbh *ctypes.BeaconBlockHeader,
+	bs types.BeaconState[BeaconStateMarshallableT],
+	fp *FieldPath,
+) ([]common.Root, common.Root, merkle.GeneralizedIndex, common.Root, error) {
+	var (
+		proof    []common.Root
+		leaf     common.Root
+		gIndices fieldGIndices
+		err      error
+	)
+	if fp.electra.state == 0 {
+		// Fields of the beacon block header are the same in every fork.
+		gIndices = fp.electra
+		proof, leaf, err = proveFieldInBlockHeader(bbh, gIndices.block)
+	} else {
+		proof, leaf, gIndices, err = proveFieldInState(bbh, bs, fp)
+	}
+	if err != nil {
+		return nil, common.Root{}, 0, common.Root{}, err
+	}
+
+	beaconRoot := bbh.HashTreeRoot()
+	if verified, vErr := merkle.VerifyProof(
+		gIndices.block, leaf, proof, beaconRoot,
+	); vErr != nil {
+		return nil, common.Root{}, 0, common.Root{}, vErr
+	} else if !verified {
+		return nil, common.Root{}, 0, common.Root{}, ErrProofVerificationFailed
+	}
+
+	return proof, leaf, gIndices.block, beaconRoot, nil
+}
+
+// proveFieldInBlockHeader generates a proof for the field at the given
+// generalized index of the beacon block header.
+func proveFieldInBlockHeader(
+	bbh *ctypes.BeaconBlockHeader, gIndex merkle.GeneralizedIndex,
+) ([]common.Root, common.Root, error) {
+	blockProofTree, err := bbh.GetTree()
+	if err != nil {
+		return nil, common.Root{}, err
+	}
+	return proveInTree(blockProofTree, gIndex)
+}
+
+// proveFieldInState generates a proof for the field at the given path in the
+// beacon state, concatenated with the proof of the beacon state in the beacon
+// block. Returns the generalized indices of the field in the fork of the
+// beacon state too.
+func proveFieldInState[
+	BeaconStateMarshallableT types.BeaconStateMarshallable,
+](
+	bbh *ctypes.BeaconBlockHeader,
+	bs types.BeaconState[BeaconStateMarshallableT],
+	fp *FieldPath,
+) ([]common.Root, common.Root, fieldGIndices, error) {
+	bsm, err := bs.GetMarshallable()
+	if err != nil {
+		return nil, common.Root{}, fieldGIndices{}, err
+	}
+	gIndices := fp.gIndices(bsm.Version())
+	if gIndices.state == 0 {
+		return nil, common.Root{}, fieldGIndices{}, errors.Wrapf(
+			ErrFieldNotFound, "%s: not part of %s state",
+			fp, version.Name(bsm.Version()),
+		)
+	}
+
+	stateProofTree, err := bsm.GetTree()
+	if err != nil {
+		return nil, common.Root{}, fieldGIndices{}, err
+	}
+	fieldInStateProof, leaf, err := proveInTree(stateProofTree, gIndices.state)
+	if err != nil {
+		return nil, common.Root{}, fieldGIndices{}, err
+	}
+
+	stateInBlockProof, err := ProveBeaconStateInBlock(bbh, false)
+	if err != nil {
+		return nil, common.Root{}, fieldGIndices{}, err
+	}
+
+	//nolint:gocritic // ok.
+	return append(fieldInStateProof, stateInBlockProof...), leaf, gIndices, nil
+}
+
+// proveInTree generates a proof for the given generalized index of the tree.
+func proveInTree(
+	tree *fastssz.Node, gIndex merkle.GeneralizedIndex,
+) ([]common.Root, common.Root, error) {
+	// Prove panics on indices outside of the populated tree, such as list
+	// items past the length of the list, so look the node up first.
+	//
+	//#nosec:G701 // generalized indices of the schemas fit in an int.
+	if _, err := tree.Get(int(gIndex)); err != nil {
+		return nil, common.Root{}, errors.Wrapf(
+			ErrFieldNotFound, "generalized index %d: %v", gIndex, err,
+		)
+	}
+
+	//#nosec:G701 // generalized indices of the schemas fit in an int.
+	fieldProof, err := tree.Prove(int(gIndex))
+	if err != nil {
+		return nil, common.Root{}, errors.Wrapf(
+			ErrFieldNotFound, "generalized index %d: %v", gIndex, err,
+		)
+	}
+
+	proof := make([]common.Root, len(fieldProof.Hashes))
+	for i, hash := range fieldProof.Hashes {
+		proof[i] = common.NewRootFromBytes(hash)
+	}
+	return proof, common.NewRootFromBytes(fieldProof.Leaf), nil
+}
+
+// normalizePath splits the given path into its parts, converting snake_case
+// field names to the CamelCase names used by the SSZ schemas.
+func normalizePath(path string) ([]string, error) {
+	path = strings.Trim(path, pathSeparator)
+	if path == "" {
+		return nil, errors.Wrap(ErrInvalidFieldPath, "empty path")
+	}
+
+	parts := strings.Split(path, pathSeparator)
+	for i, part := range parts {
+		if part == "" {
+			return nil, errors.Wrapf(ErrInvalidFieldPath, "%s: empty part", path)
+		}
+		if part == lengthPathPart {
+			continue
+		}
+
+		var sb strings.Builder
+		for _, word := range strings.Split(part, "_") {
+			if word == "" {
+				continue
+			}
+			sb.WriteString(strings.ToUpper(word[:1]))
+			sb.WriteString(word[1:])
+		}
+		parts[i] = sb.String()
+	}
+	return parts, nil
+}
@@ -0,0 +1,329 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package merkle_test
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/node-api/handlers/proof/merkle"
+	"github.com/berachain/beacon-kit/node-api/handlers/proof/merkle/mock"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/version"
+	"github.com/stretchr/testify/require"
+)
+
+// TestProveFieldInBlock tests that the ProveFieldInBlock function proves the
+// expected leaf for fields of the beacon state and block header, and that the
+// generated proof verifies against the beacon block root.
+//
+//nolint:funlen // table test.
+func TestProveFieldInBlock(t *testing.T) {
+	vals := make(types.Validators, 20)
+	balances := make([]uint64, len(vals))
+	for i := range vals {
+		vals[i] = &types.Validator{
+			Pubkey:                [48]byte{byte(i)},
+			WithdrawalCredentials: types.WithdrawalCredentials{0x01, byte(i)},
+			EffectiveBalance:      math.Gwei(32e9 + i),
+		}
+		balances[i] = 32e9 + uint64(i)
+	}
+	blockRoots := []common.Root{{1}, {2}, {3}}
+	eth1Data := &types.Eth1Data{
+		DepositRoot:  common.Root{4, 5, 6},
+		DepositCount: 20,
+		BlockHash:    common.ExecutionHash{7, 8, 9},
+	}
+
+	bsm, err := (&types.BeaconState{}).New(
+		0,
+		common.Root{},
+		10,
+		(&types.Fork{}).Empty(),
+		(&types.BeaconBlockHeader{}).Empty(),
+		blockRoots,
+		[]common.Root{},
+		eth1Data,
+		0,
+		(&types.ExecutionPayloadHeader{}).Empty(),
+		vals,
+		balances,
+		[]common.Bytes32{},
+		0,
+		0,
+		[]math.Gwei{},
+		0,
+	)
+	require.NoError(t, err)
+	bs := &mock.BeaconState{BeaconStateMarshallable: bsm}
+	bbh := (&types.BeaconBlockHeader{}).New(
+		10, 7, common.Root{1, 2, 3}, bs.HashTreeRoot(), common.Root{3, 2, 1},
+	)
+
+	u64Leaf := func(v uint64) common.Root {
+		var leaf common.Root
+		binary.LittleEndian.PutUint64(leaf[:], v)
+		return leaf
+	}
+
+	testCases := []struct {
+		name         string
+		blockPath    bool
+		path         string
+		expectedLeaf common.Root
+		offset       uint8
+	}{
+		{
+			name: "validator withdrawal credentials",
+			path: "validators/12/withdrawal_credentials",
+			expectedLeaf: common.Root(
+				types.WithdrawalCredentials{0x01, 12},
+			),
+		},
+		{
+			name:         "validator effective balance in CamelCase",
+			path:         "Validators/3/EffectiveBalance",
+			expectedLeaf: u64Leaf(32e9 + 3),
+		},
+		{
+			name:         "balance packed in a chunk",
+			path:         "balances/5",
+			expectedLeaf: common.Root(packBalances(balances[4:8])),
+			offset:       8,
+		},
+		{
+			name:         "historical block root",
+			path:         "block_roots/1",
+			expectedLeaf: blockRoots[1],
+		},
+		{
+			name:         "eth1 data",
+			path:         "eth1_data",
+			expectedLeaf: eth1Data.HashTreeRoot(),
+		},
+		{
+			name:         "eth1 data deposit root",
+			path:         "eth1_data/deposit_root",
+			expectedLeaf: eth1Data.DepositRoot,
+		},
+		{
+			name:         "validators length",
+			path:         "validators/__len__",
+			expectedLeaf: u64Leaf(uint64(len(vals))),
+		},
+		{
+			name:         "block proposer index",
+			blockPath:    true,
+			path:         "proposer_index",
+			expectedLeaf: u64Leaf(7),
+		},
+		{
+			name:         "block state root",
+			blockPath:    true,
+			path:         "state_root",
+			expectedLeaf: bs.HashTreeRoot(),
+		},
+		{
+			name:         "block path into the state",
+			blockPath:    true,
+			path:         "state_root/validators/0/pubkey",
+			expectedLeaf: common.Root(vals[0].Pubkey.HashTreeRoot()),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			newPath := merkle.NewStateFieldPath
+			if tc.blockPath {
+				newPath = merkle.NewBlockFieldPath
+			}
+			fp, err := newPath(tc.path)
+			require.NoError(t, err)
+			require.Equal(t, tc.offset, fp.Offset())
+
+			proof, leaf, gIndex, root, err := merkle.ProveFieldInBlock(
+				bbh, bs, fp,
+			)
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedLeaf, leaf)
+			require.Equal(t, bbh.HashTreeRoot(), root)
+			require.Equal(t, fp.GIndex(version.Deneb), gIndex)
+			require.Len(t, proof, gIndex.Length())
+		})
+	}
+}
+
+// TestFieldPathGIndices tests that the generalized indices resolved from
+// field paths agree with the hand-written constants.
+func TestFieldPathGIndices(t *testing.T) {
+	fp, err := merkle.NewStateFieldPath("validators/0/pubkey")
+	require.NoError(t, err)
+	require.Equal(t,
+		merkle.ZeroValidatorPubkeyGIndexDenebBlock,
+		int(fp.GIndex(version.Deneb)),
+	)
+	require.Equal(t,
+		merkle.ZeroValidatorPubkeyGIndexElectraBlock,
+		int(fp.GIndex(version.Electra)),
+	)
+
+	fp, err = merkle.NewStateFieldPath("latest_execution_payload_header/number")
+	require.NoError(t, err)
+	require.Equal(t,
+		merkle.ExecutionNumberGIndexDenebBlock, int(fp.GIndex(version.Deneb)),
+	)
+	require.Equal(t,
+		merkle.ExecutionNumberGIndexElectraBlock,
+		int(fp.GIndex(version.Electra)),
+	)
+
+	fp, err = merkle.NewBlockFieldPath("proposer_index")
+	require.NoError(t, err)
+	require.Equal(t,
+		merkle.ProposerIndexGIndexDenebBlock, int(fp.GIndex(version.Deneb)),
+	)
+	require.Equal(t,
+		merkle.ProposerIndexGIndexDenebBlock, int(fp.GIndex(version.Electra)),
+	)
+
+	// Fields added in Electra have no Deneb generalized index.
+	fp, err = merkle.NewStateFieldPath("inactivity_scores/0")
+	require.NoError(t, err)
+	require.Zero(t, fp.GIndex(version.Deneb))
+	require.NotZero(t, fp.GIndex(version.Electra))
+}
+
+// TestProveFieldInBlockElectra tests that fields of an Electra beacon state
+// are proven with the Electra generalized indices, and that fields added in
+// Electra are not found in a Deneb beacon state.
+func TestProveFieldInBlockElectra(t *testing.T) {
+	vals := types.Validators{
+		&types.Validator{Pubkey: [48]byte{1}},
+		&types.Validator{Pubkey: [48]byte{2}},
+	}
+	bsm, err := (&types.BeaconState{}).New(
+		version.Electra,
+		common.Root{},
+		10,
+		(&types.Fork{}).Empty(),
+		(&types.BeaconBlockHeader{}).Empty(),
+		[]common.Root{},
+		[]common.Root{},
+		(&types.Eth1Data{}).Empty(),
+		0,
+		(&types.ExecutionPayloadHeader{}).Empty(),
+		vals,
+		[]uint64{32e9, 32e9},
+		[]common.Bytes32{},
+		0,
+		0,
+		[]math.Gwei{},
+		0,
+	)
+	require.NoError(t, err)
+	bsm.InactivityScores = []uint64{3, 7}
+	bs := &mock.BeaconState{BeaconStateMarshallable: bsm}
+	bbh := (&types.BeaconBlockHeader{}).New(
+		10, 1, common.Root{}, bs.HashTreeRoot(), common.Root{},
+	)
+
+	fp, err := merkle.NewStateFieldPath("validators/1/pubkey")
+	require.NoError(t, err)
+	_, leaf, gIndex, root, err := merkle.ProveFieldInBlock(bbh, bs, fp)
+	require.NoError(t, err)
+	require.Equal(t, common.Root(vals[1].Pubkey.HashTreeRoot()), leaf)
+	require.Equal(t, fp.GIndex(version.Electra), gIndex)
+	require.Equal(t, bbh.HashTreeRoot(), root)
+
+	fp, err = merkle.NewStateFieldPath("inactivity_scores/1")
+	require.NoError(t, err)
+	require.Equal(t, uint8(8), fp.Offset())
+	_, leaf, _, _, err = merkle.ProveFieldInBlock(bbh, bs, fp)
+	require.NoError(t, err)
+	require.Equal(t, common.Root(packBalances([]uint64{3, 7})), leaf)
+
+	// The fixed proofs verify against the block with the Electra indices.
+	_, _, err = merkle.ProveProposerPubkeyInBlock(bbh, bs)
+	require.NoError(t, err)
+	_, _, err = merkle.ProveExecutionNumberInBlock(bbh, bs)
+	require.NoError(t, err)
+	_, _, err = merkle.ProveExecutionFeeRecipientInBlock(bbh, bs)
+	require.NoError(t, err)
+
+	denebState, err := mock.NewBeaconState(
+		10, vals, 0, common.ExecutionAddress{},
+	)
+	require.NoError(t, err)
+	_, _, _, _, err = merkle.ProveFieldInBlock(bbh, denebState, fp)
+	require.ErrorIs(t, err, merkle.ErrFieldNotFound)
+}
+
+// TestInvalidFieldPath tests that paths which do not resolve to a field in
+// the schema are rejected.
+func TestInvalidFieldPath(t *testing.T) {
+	for _, path := range []string{
+		"",
+		"/",
+		"validators//pubkey",
+		"not_a_field",
+		"validators/abc",
+		"slot/0",
+		"eth1_data/__len__",
+	} {
+		_, err := merkle.NewStateFieldPath(path)
+		require.ErrorIs(t, err, merkle.ErrInvalidFieldPath, path)
+	}
+}
+
+// packBalances packs the given balances into a single 32 byte chunk.
+func packBalances(balances []uint64) [32]byte {
+	var chunk [32]byte
+	for i, balance := range balances {
+		binary.LittleEndian.PutUint64(chunk[i*8:], balance)
+	}
+	return chunk
+}
+
+// TestProveFieldInBlockOutOfRange tests that proving a list item past the
+// length of the list fails instead of panicking.
+func TestProveFieldInBlockOutOfRange(t *testing.T) {
+	vals := types.Validators{&types.Validator{}, &types.Validator{}}
+	bs, err := mock.NewBeaconState(1, vals, 0, common.ExecutionAddress{})
+	require.NoError(t, err)
+	bbh := (&types.BeaconBlockHeader{}).New(
+		1, 0, common.Root{}, bs.HashTreeRoot(), common.Root{},
+	)
+
+	for _, path := range []string{
+		"validators/2/pubkey",
+		"validators/5000/pubkey",
+		"balances/0",
+		"block_roots/3",
+	} {
+		fp, fpErr := merkle.NewStateFieldPath(path)
+		require.NoError(t, fpErr)
+		_, _, _, _, err = merkle.ProveFieldInBlock(bbh, bs, fp)
+		require.ErrorIs(t, err, merkle.ErrFieldNotFound, path)
+	}
+}
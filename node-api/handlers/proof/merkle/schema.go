@@ -0,0 +1,146 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package merkle
+
+import (
+	"slices"
+
+	"github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/primitives/encoding/ssz/schema"
+)
+
+var (
+	// beaconStateFieldsDeneb are the fields of the BeaconState struct defined
+	// in consensus-types/types/state.go for the Deneb fork.
+	//
+	//nolint:mnd // list limits are from the spec.
+	beaconStateFieldsDeneb = []*schema.Field[schema.SSZType]{
+		schema.NewField("GenesisValidatorsRoot", schema.B32()),
+		schema.NewField("Slot", schema.U64()),
+		schema.NewField("Fork", schema.DefineContainer(
+			schema.NewField("PreviousVersion", schema.B4()),
+			schema.NewField("CurrentVersion", schema.B4()),
+			schema.NewField("Epoch", schema.U64()),
+		)),
+		schema.NewField("LatestBlockHeader", schema.DefineContainer(
+			schema.NewField("Slot", schema.U64()),
+			schema.NewField("ProposerIndex", schema.U64()),
+			schema.NewField("ParentBlockRoot", schema.B32()),
+			schema.NewField("StateRoot", schema.B32()),
+			schema.NewField("BodyRoot", schema.B32()),
+		)),
+		schema.NewField("BlockRoots", schema.DefineList(schema.B32(), 8192)),
+		schema.NewField("StateRoots", schema.DefineList(schema.B32(), 8192)),
+		schema.NewField("Eth1Data", schema.DefineContainer(
+			schema.NewField("DepositRoot", schema.B32()),
+			schema.NewField("DepositCount", schema.U64()),
+			schema.NewField("BlockHash", schema.B32()),
+		)),
+		schema.NewField("Eth1DepositIndex", schema.U64()),
+		schema.NewField("LatestExecutionPayloadHeader", schema.DefineContainer(
+			schema.NewField("ParentHash", schema.B32()),
+			schema.NewField("FeeRecipient", schema.B20()),
+			schema.NewField("StateRoot", schema.B32()),
+			schema.NewField("ReceiptsRoot", schema.B32()),
+			schema.NewField("LogsBloom", schema.B256()),
+			schema.NewField("Random", schema.B32()),
+			schema.NewField("Number", schema.U64()),
+			schema.NewField("GasLimit", schema.U64()),
+			schema.NewField("GasUsed", schema.U64()),
+			schema.NewField("Timestamp", schema.U64()),
+			schema.NewField("ExtraData", schema.DefineByteList(32)),
+			schema.NewField("BaseFeePerGas", schema.B32()),
+			schema.NewField("BlockHash", schema.B32()),
+			schema.NewField("TransactionsRoot", schema.B32()),
+			schema.NewField("WithdrawalsRoot", schema.B32()),
+			schema.NewField("BlobGasUsed", schema.U64()),
+			schema.NewField("ExcessBlobGas", schema.U64()),
+		)),
+		schema.NewField("Validators", schema.DefineList(schema.DefineContainer(
+			schema.NewField("Pubkey", schema.B48()),
+			schema.NewField("WithdrawalCredentials", schema.B32()),
+			schema.NewField("EffectiveBalance", schema.U64()),
+			schema.NewField("Slashed", schema.Bool()),
+			schema.NewField("ActivationEligibilityEpoch", schema.U64()),
+			schema.NewField("ActivationEpoch", schema.U64()),
+			schema.NewField("ExitEpoch", schema.U64()),
+			schema.NewField("WithdrawableEpoch", schema.U64()),
+		), types.MaxValidators)),
+		schema.NewField(
+			"Balances", schema.DefineList(schema.U64(), types.MaxValidators),
+		),
+		schema.NewField("RandaoMixes", schema.DefineList(schema.B32(), 65536)),
+		schema.NewField("NextWithdrawalIndex", schema.U64()),
+		schema.NewField("NextWithdrawalValidatorIndex", schema.U64()),
+		schema.NewField(
+			"Slashings", schema.DefineList(schema.U64(), types.MaxValidators),
+		),
+		schema.NewField("TotalSlashing", schema.U64()),
+	}
+
+	// BeaconStateSchemaDeneb is the SSZ schema of the BeaconState struct
+	// defined in consensus-types/types/state.go for the Deneb fork.
+	BeaconStateSchemaDeneb = schema.DefineContainer(beaconStateFieldsDeneb...)
+
+	// BeaconStateSchemaElectra is the SSZ schema of the BeaconState struct
+	// defined in consensus-types/types/state.go for the Electra fork, which
+	// appends the inactivity and withdrawal request fields to Deneb's.
+	BeaconStateSchemaElectra = schema.DefineContainer(
+		append(
+			slices.Clone(beaconStateFieldsDeneb),
+			schema.NewField(
+				"InactivityScores",
+				schema.DefineList(schema.U64(), types.MaxValidators),
+			),
+			schema.NewField("NextWithdrawalRequestIndex", schema.U64()),
+			schema.NewField(
+				"PendingPartialWithdrawals",
+				schema.DefineList(schema.U64(), types.MaxValidators),
+			),
+		)...,
+	)
+
+	// BeaconBlockHeaderSchemaDeneb is the SSZ schema of the BeaconBlockHeader
+	// struct defined in consensus-types/types/header.go for the Deneb fork,
+	// with StateRoot expanded to the BeaconState it commits to.
+	BeaconBlockHeaderSchemaDeneb = beaconBlockHeaderSchema(
+		BeaconStateSchemaDeneb,
+	)
+
+	// BeaconBlockHeaderSchemaElectra is the SSZ schema of the
+	// BeaconBlockHeader for the Electra fork, with StateRoot expanded to the
+	// Electra BeaconState.
+	BeaconBlockHeaderSchemaElectra = beaconBlockHeaderSchema(
+		BeaconStateSchemaElectra,
+	)
+)
+
+// beaconBlockHeaderSchema returns the SSZ schema of the BeaconBlockHeader
+// with StateRoot expanded to the given BeaconState schema.
+func beaconBlockHeaderSchema(stateSchema schema.SSZType) schema.SSZType {
+	return schema.DefineContainer(
+		schema.NewField("Slot", schema.U64()),
+		schema.NewField("ProposerIndex", schema.U64()),
+		schema.NewField("ParentBlockRoot", schema.B32()),
+		schema.NewField("StateRoot", stateSchema),
+		schema.NewField("BodyRoot", schema.B32()),
+	)
+}
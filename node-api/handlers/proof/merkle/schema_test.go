@@ -0,0 +1,230 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package merkle_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/node-api/handlers/proof/merkle"
+	mlib "github.com/berachain/beacon-kit/primitives/encoding/ssz/merkle"
+	"github.com/berachain/beacon-kit/primitives/encoding/ssz/schema"
+	"github.com/holiman/uint256"
+	"github.com/stretchr/testify/require"
+)
+
+// The schemas below are derived from the Go definitions of the consensus
+// types rather than copied from the production schemas, so that the
+// generalized indices are checked against the containers actually hashed.
+
+var (
+	// listLimits are the SSZ limits of the list fields, as given in their
+	// DefineSSZ methods.
+	listLimits = map[string]uint64{
+		"BlockRoots":                8192,
+		"StateRoots":                8192,
+		"Validators":                types.MaxValidators,
+		"Balances":                  types.MaxValidators,
+		"RandaoMixes":               65536,
+		"Slashings":                 types.MaxValidators,
+		"InactivityScores":          types.MaxValidators,
+		"PendingPartialWithdrawals": types.MaxValidators,
+		"ExtraData":                 32,
+	}
+
+	// electraStateFields are the BeaconState fields which are only part of
+	// the Electra beacon state.
+	electraStateFields = map[string]bool{
+		"InactivityScores":           true,
+		"NextWithdrawalRequestIndex": true,
+		"PendingPartialWithdrawals":  true,
+	}
+
+	beaconStateType       = reflect.TypeOf(types.BeaconState{})
+	beaconBlockHeaderType = reflect.TypeOf(types.BeaconBlockHeader{})
+	uint256Type           = reflect.TypeOf(uint256.Int{})
+)
+
+// derivedStateSchema returns the schema of the BeaconState of the given fork.
+func derivedStateSchema(t *testing.T, electra bool) schema.SSZType {
+	t.Helper()
+	exclude := electraStateFields
+	if electra {
+		exclude = nil
+	}
+	return deriveContainer(t, beaconStateType, exclude, nil)
+}
+
+// derivedHeaderSchema returns the schema of the BeaconBlockHeader with
+// StateRoot expanded to the BeaconState of the given fork.
+func derivedHeaderSchema(t *testing.T, electra bool) schema.SSZType {
+	t.Helper()
+	return deriveContainer(t, beaconBlockHeaderType, nil,
+		map[string]schema.SSZType{
+			"StateRoot": derivedStateSchema(t, electra),
+		},
+	)
+}
+
+// deriveContainer returns the schema of the struct type typ, leaving out its
+// unexported fields and the excluded ones, and using the expanded schemas in
+// place of the given fields.
+func deriveContainer(
+	t *testing.T,
+	typ reflect.Type,
+	exclude map[string]bool,
+	expand map[string]schema.SSZType,
+) schema.SSZType {
+	t.Helper()
+	fields := make([]*schema.Field[schema.SSZType], 0, typ.NumField())
+	for i := range typ.NumField() {
+		f := typ.Field(i)
+		if !f.IsExported() || exclude[f.Name] {
+			continue
+		}
+		fieldType, ok := expand[f.Name]
+		if !ok {
+			fieldType = deriveType(t, f.Name, f.Type)
+		}
+		fields = append(fields, schema.NewField(f.Name, fieldType))
+	}
+	return schema.DefineContainer(fields...)
+}
+
+// deriveType returns the schema of the field of the given name and type.
+func deriveType(t *testing.T, name string, typ reflect.Type) schema.SSZType {
+	t.Helper()
+	if typ.Kind() == reflect.Pointer {
+		typ = typ.Elem()
+	}
+	switch {
+	case typ == uint256Type:
+		return schema.B32()
+	case typ.Kind() == reflect.Bool:
+		return schema.Bool()
+	case typ.Kind() == reflect.Uint64:
+		return schema.U64()
+	case typ.Kind() == reflect.Array && typ.Elem().Kind() == reflect.Uint8:
+		return schema.DefineByteVector(uint64(typ.Len()))
+	case typ.Kind() == reflect.Struct:
+		return deriveContainer(t, typ, nil, nil)
+	case typ.Kind() == reflect.Slice:
+		limit, ok := listLimits[name]
+		require.True(t, ok, "no list limit for %s", name)
+		if typ.Elem().Kind() == reflect.Uint8 {
+			return schema.DefineByteList(limit)
+		}
+		return schema.DefineList(deriveType(t, name, typ.Elem()), limit)
+	default:
+		require.FailNow(t, "unsupported field type", "%s: %s", name, typ)
+		return nil
+	}
+}
+
+// fieldPaths returns the paths of all the fields of the struct type typ and
+// of its nested containers, leaving out the excluded top-level fields. List
+// elements are reached through their first item.
+func fieldPaths(
+	typ reflect.Type, prefix string, exclude map[string]bool,
+) []string {
+	var paths []string
+	for i := range typ.NumField() {
+		f := typ.Field(i)
+		if !f.IsExported() || exclude[f.Name] {
+			continue
+		}
+		path := prefix + f.Name
+		paths = append(paths, path)
+
+		elem := f.Type
+		if elem.Kind() == reflect.Slice {
+			elem = elem.Elem()
+			path += "/0"
+		}
+		if elem.Kind() == reflect.Pointer {
+			elem = elem.Elem()
+		}
+		if elem.Kind() == reflect.Struct && elem != uint256Type {
+			paths = append(paths, fieldPaths(elem, path+"/", nil)...)
+		}
+	}
+	return paths
+}
+
+// TestBeaconStateSchemas checks that the production schemas resolve every
+// field of the beacon state, on its own and through the block header, to the
+// same generalized index as the schemas derived from the consensus types.
+func TestBeaconStateSchemas(t *testing.T) {
+	testCases := []struct {
+		name         string
+		electra      bool
+		stateSchema  schema.SSZType
+		headerSchema schema.SSZType
+	}{
+		{
+			name:         "Deneb",
+			stateSchema:  merkle.BeaconStateSchemaDeneb,
+			headerSchema: merkle.BeaconBlockHeaderSchemaDeneb,
+		},
+		{
+			name:         "Electra",
+			electra:      true,
+			stateSchema:  merkle.BeaconStateSchemaElectra,
+			headerSchema: merkle.BeaconBlockHeaderSchemaElectra,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			exclude := electraStateFields
+			if tc.electra {
+				exclude = nil
+			}
+			expectedState := derivedStateSchema(t, tc.electra)
+			expectedHeader := derivedHeaderSchema(t, tc.electra)
+
+			for _, path := range fieldPaths(beaconStateType, "", exclude) {
+				requireSameGIndex(t, path, expectedState, tc.stateSchema)
+				requireSameGIndex(t,
+					"StateRoot/"+path, expectedHeader, tc.headerSchema,
+				)
+			}
+			for _, path := range fieldPaths(beaconBlockHeaderType, "", nil) {
+				requireSameGIndex(t, path, expectedHeader, tc.headerSchema)
+			}
+		})
+	}
+}
+
+// requireSameGIndex requires the path to resolve to the same generalized
+// index in both schemas.
+func requireSameGIndex(
+	t *testing.T, path string, expected, actual schema.SSZType,
+) {
+	t.Helper()
+	objectPath := mlib.ObjectPath[mlib.GeneralizedIndex, [32]byte](path)
+	_, expectedGIndex, _, err := objectPath.GetGeneralizedIndex(expected)
+	require.NoError(t, err, path)
+	_, actualGIndex, _, err := objectPath.GetGeneralizedIndex(actual)
+	require.NoError(t, err, path)
+	require.Equal(t, expectedGIndex, actualGIndex, path)
+}
@@ -45,5 +45,15 @@ func (h *Handler[ContextT]) RegisterRoutes(logger log.Logger) {
 			Path:    "bkit/v1/proof/execution_fee_recipient/:timestamp_id",
 			Handler: h.GetExecutionFeeRecipient,
 		},
+		{
+			Method:  http.MethodGet,
+			Path:    "bkit/v1/proof/state/:timestamp_id",
+			Handler: h.GetStateFieldProof,
+		},
+		{
+			Method:  http.MethodGet,
+			Path:    "bkit/v1/proof/block/:timestamp_id",
+			Handler: h.GetBlockFieldProof,
+		},
 	})
 }
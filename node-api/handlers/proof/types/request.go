@@ -39,3 +39,17 @@ type ExecutionNumberRequest struct {
 type ExecutionFeeRecipientRequest struct {
 	types.TimestampIDRequest
 }
+
+// StateFieldProofRequest is the request for the
+// `/proof/state/{timestamp_id}` endpoint.
+type StateFieldProofRequest struct {
+	types.TimestampIDRequest
+	Path string `query:"path" validate:"required"`
+}
+
+// BlockFieldProofRequest is the request for the
+// `/proof/block/{timestamp_id}` endpoint.
+type BlockFieldProofRequest struct {
+	types.TimestampIDRequest
+	Path string `query:"path" validate:"required"`
+}
@@ -43,7 +43,8 @@ type BlockProposerResponse struct {
 	// ValidatorPubkeyProof can be verified against the beacon block root. Use
 	// a Generalized Index of `z + (8 * ValidatorIndex)`, where z is the
 	// Generalized Index of the 0 validator pubkey in the beacon block. In
-	// the Deneb fork, z is 3254554418216960.
+	// the Deneb fork, z is 3254554418216960 and in the Electra fork, z is
+	// 6350779162034176.
 	ValidatorPubkeyProof []common.Root `json:"validator_pubkey_proof"`
 
 	// ProposerIndexProof can be verified against the beacon block root. Use
@@ -65,7 +66,8 @@ type ExecutionNumberResponse struct {
 	ExecutionNumber math.U64 `json:"execution_number"`
 
 	// ExecutionNumberProof can be verified against the beacon block root using
-	// a Generalized Index of 5894 in the Deneb fork.
+	// a Generalized Index of 5894 in the Deneb fork and 11526 in the Electra
+	// fork.
 	ExecutionNumberProof []common.Root `json:"execution_number_proof"`
 }
 
@@ -85,6 +87,38 @@ type ExecutionFeeRecipientResponse struct {
 	ExecutionFeeRecipient common.ExecutionAddress `json:"execution_fee_recipient"`
 
 	// ExecutionFeeRecipientProof can be verified against the beacon block root
-	// using a Generalized Index of 5894 in the Deneb fork.
+	// using a Generalized Index of 5889 in the Deneb fork and 11521 in the
+	// Electra fork.
 	ExecutionFeeRecipientProof []common.Root `json:"execution_fee_recipient_proof"`
 }
+
+// FieldProofResponse is the response for the `/proof/state/{timestamp_id}`
+// and `/proof/block/{timestamp_id}` endpoints.
+type FieldProofResponse struct {
+	// BeaconBlockHeader is the block header of which the hash tree root is the
+	// beacon block root to verify against.
+	BeaconBlockHeader *ctypes.BeaconBlockHeader `json:"beacon_block_header"`
+
+	// BeaconBlockRoot is the beacon block root for this slot.
+	BeaconBlockRoot common.Root `json:"beacon_block_root"`
+
+	// Path is the resolved path of the field in the beacon block, with the
+	// beacon state nested under `StateRoot`.
+	Path string `json:"path"`
+
+	// GeneralizedIndex is the Generalized Index of the field in the beacon
+	// block, computed from the SSZ schema of the Deneb fork.
+	GeneralizedIndex math.U64 `json:"generalized_index"`
+
+	// Leaf is the chunk of the merkle tree being proven. For composite types
+	// it is the hash tree root of the field; basic types such as balances are
+	// packed together, so the field starts at LeafOffset within the leaf.
+	Leaf common.Root `json:"leaf"`
+
+	// LeafOffset is the byte offset of the field within the leaf.
+	LeafOffset uint8 `json:"leaf_offset"`
+
+	// Proof can be verified against the beacon block root using the
+	// GeneralizedIndex.
+	Proof []common.Root `json:"proof"`
+}
@@ -45,6 +45,8 @@ type BeaconState[
 type BeaconStateMarshallable interface {
 	// GetTree is kept for FastSSZ compatibility.
 	GetTree() (*fastssz.Node, error)
+	// Version returns the fork version that selects the state's SSZ layout.
+	Version() uint32
 }
 
 // Validator is the interface for a validator.
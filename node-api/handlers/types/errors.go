@@ -26,4 +26,7 @@ var (
 	ErrNotFound       = errors.New("not found")
 	ErrNotImplemented = errors.New("not implemented")
 	ErrInvalidRequest = errors.New("invalid request")
+
+	// ErrSSZNotSupported is returned when a response has no SSZ encoding.
+	ErrSSZNotSupported = errors.New("response has no SSZ encoding")
 )
@@ -29,3 +29,15 @@ func Wrap(data any) DataResponse {
 		Data: data,
 	}
 }
+
+// StatusResponse is returned by handlers that reply with a status code and an
+// empty body, such as health checks.
+type StatusResponse struct {
+	Code int
+}
+
+// Versioned is implemented by responses whose payload depends on the fork
+// version. The version is served in the Eth-Consensus-Version header.
+type Versioned interface {
+	ConsensusVersion() string
+}
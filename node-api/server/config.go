@@ -20,8 +20,24 @@
 
 package server
 
+import (
+	"net/http"
+	"time"
+)
+
 const (
 	defaultAddress = "127.0.0.1:3500"
+
+	// AuthModeNone disables authentication on the node API.
+	AuthModeNone = "none"
+	// AuthModeBearer requires a static bearer token on every request.
+	AuthModeBearer = "bearer"
+	// AuthModeJWT requires an HS256 JWT signed with the configured secret
+	// on every request.
+	AuthModeJWT = "jwt"
+
+	defaultRateLimitBurst     = 20
+	defaultRateLimitExpiresIn = 3 * time.Minute
 )
 
 // Config is the configuration for the node API server.
@@ -32,6 +48,75 @@ type Config struct {
 	Address string `mapstructure:"address"`
 	// Logging is the flag to enable API logging.
 	Logging bool `mapstructure:"logging"`
+	// TLS is the TLS configuration of the node API server.
+	TLS TLSConfig `mapstructure:"tls"`
+	// Auth is the authentication configuration of the node API server.
+	Auth AuthConfig `mapstructure:"auth"`
+	// RateLimit is the per-IP rate limiting configuration.
+	RateLimit RateLimitConfig `mapstructure:"rate-limit"`
+	// CORS is the CORS configuration of the node API server.
+	CORS CORSConfig `mapstructure:"cors"`
+	// Routes holds the route allow and deny lists.
+	Routes RoutesConfig `mapstructure:"routes"`
+}
+
+// TLSConfig is the TLS configuration for the node API server. TLS is enabled
+// when both the certificate and key files are set.
+type TLSConfig struct {
+	// CertFile is the path to the PEM encoded certificate.
+	CertFile string `mapstructure:"cert-file"`
+	// KeyFile is the path to the PEM encoded private key.
+	KeyFile string `mapstructure:"key-file"`
+}
+
+// Enabled returns true if TLS is configured.
+func (c TLSConfig) Enabled() bool {
+	return c.CertFile != "" && c.KeyFile != ""
+}
+
+// AuthConfig is the authentication configuration for the node API server.
+type AuthConfig struct {
+	// Mode is one of "none", "bearer" or "jwt".
+	Mode string `mapstructure:"mode"`
+	// BearerTokens are the tokens accepted in "bearer" mode.
+	BearerTokens []string `mapstructure:"bearer-tokens"`
+	// JWTSecretPath is the path to the hex encoded secret used to verify
+	// tokens in "jwt" mode.
+	JWTSecretPath string `mapstructure:"jwt-secret-path"`
+}
+
+// RateLimitConfig is the per-IP rate limiting configuration for the node API
+// server.
+type RateLimitConfig struct {
+	// RequestsPerSecond is the sustained number of requests allowed per IP.
+	// 0 disables rate limiting.
+	RequestsPerSecond float64 `mapstructure:"requests-per-second"`
+	// Burst is the maximum number of requests allowed at once per IP.
+	Burst int `mapstructure:"burst"`
+	// ExpiresIn is how long an idle IP is remembered by the limiter.
+	ExpiresIn time.Duration `mapstructure:"expires-in"`
+}
+
+// CORSConfig is the CORS configuration for the node API server.
+type CORSConfig struct {
+	// AllowedOrigins are the origins allowed to access the node API.
+	AllowedOrigins []string `mapstructure:"allowed-origins"`
+	// AllowedMethods are the methods allowed when accessing the node API.
+	AllowedMethods []string `mapstructure:"allowed-methods"`
+	// AllowedHeaders are the request headers allowed when accessing the
+	// node API.
+	AllowedHeaders []string `mapstructure:"allowed-headers"`
+}
+
+// RoutesConfig holds the route allow and deny lists of the node API server.
+// Patterns use path.Match syntax, and a trailing "*" matches any suffix, e.g.
+// "/eth/v1/debug/*". Deny takes precedence over allow, and an empty allow
+// list allows every route.
+type RoutesConfig struct {
+	// Allow is the list of route patterns that may be served.
+	Allow []string `mapstructure:"allow"`
+	// Deny is the list of route patterns that must not be served.
+	Deny []string `mapstructure:"deny"`
 }
 
 // DefaultConfig returns the default configuration for the node API server.
@@ -40,5 +125,51 @@ func DefaultConfig() Config {
 		Enabled: false,
 		Address: defaultAddress,
 		Logging: false,
+		TLS:     TLSConfig{},
+		Auth: AuthConfig{
+			Mode:         AuthModeNone,
+			BearerTokens: []string{},
+		},
+		RateLimit: RateLimitConfig{
+			RequestsPerSecond: 0,
+			Burst:             defaultRateLimitBurst,
+			ExpiresIn:         defaultRateLimitExpiresIn,
+		},
+		CORS: CORSConfig{
+			AllowedOrigins: []string{"*"},
+			AllowedMethods: []string{
+				http.MethodGet, http.MethodHead, http.MethodPut,
+				http.MethodPatch, http.MethodPost, http.MethodDelete,
+			},
+			AllowedHeaders: []string{},
+		},
+		Routes: RoutesConfig{
+			Allow: []string{},
+			Deny:  []string{},
+		},
+	}
+}
+
+// Validate returns an error if the configuration is inconsistent.
+func (c Config) Validate() error {
+	if (c.TLS.CertFile == "") != (c.TLS.KeyFile == "") {
+		return ErrIncompleteTLSConfig
+	}
+	switch c.Auth.Mode {
+	case "", AuthModeNone:
+	case AuthModeBearer:
+		if len(c.Auth.BearerTokens) == 0 {
+			return ErrMissingBearerTokens
+		}
+	case AuthModeJWT:
+		if c.Auth.JWTSecretPath == "" {
+			return ErrMissingJWTSecretPath
+		}
+	default:
+		return ErrInvalidAuthMode
+	}
+	if c.RateLimit.RequestsPerSecond < 0 || c.RateLimit.Burst < 0 {
+		return ErrInvalidRateLimit
 	}
+	return nil
 }
@@ -0,0 +1,48 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package server
+
+import "github.com/berachain/beacon-kit/errors"
+
+var (
+	// ErrIncompleteTLSConfig is returned when only one of the TLS certificate
+	// and key files is configured.
+	ErrIncompleteTLSConfig = errors.New(
+		"node api tls requires both cert-file and key-file")
+
+	// ErrInvalidAuthMode is returned when the auth mode is unknown.
+	ErrInvalidAuthMode = errors.New(
+		"node api auth mode must be one of none, bearer or jwt")
+
+	// ErrMissingBearerTokens is returned when bearer auth is enabled without
+	// any tokens.
+	ErrMissingBearerTokens = errors.New(
+		"node api bearer auth requires at least one token")
+
+	// ErrMissingJWTSecretPath is returned when JWT auth is enabled without a
+	// secret.
+	ErrMissingJWTSecretPath = errors.New(
+		"node api jwt auth requires a jwt-secret-path")
+
+	// ErrInvalidRateLimit is returned when the rate limit is negative.
+	ErrInvalidRateLimit = errors.New(
+		"node api rate limit must not be negative")
+)
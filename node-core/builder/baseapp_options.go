@@ -27,11 +27,14 @@ import (
 	"path/filepath"
 
 	"cosmossdk.io/store"
+	"cosmossdk.io/store/snapshots"
+	snapshottypes "cosmossdk.io/store/snapshots/types"
 	storetypes "cosmossdk.io/store/types"
 	server "github.com/berachain/beacon-kit/cli/commands/server"
 	"github.com/berachain/beacon-kit/config"
 	cometbft "github.com/berachain/beacon-kit/consensus/cometbft/service"
 	"github.com/berachain/beacon-kit/log"
+	dbm "github.com/cosmos/cosmos-db"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
 	"github.com/spf13/cast"
@@ -59,6 +62,15 @@ func DefaultServiceOptions[
 		panic(err)
 	}
 
+	snapshotStore, err := getSnapshotStore(appOpts)
+	if err != nil {
+		panic(err)
+	}
+	snapshotOpts := snapshottypes.NewSnapshotOptions(
+		cast.ToUint64(appOpts.Get(server.FlagStateSyncSnapshotInterval)),
+		cast.ToUint32(appOpts.Get(server.FlagStateSyncSnapshotKeepRecent)),
+	)
+
 	// get chainID, possibly falling back to genesis if flag is not set
 	chainID := cast.ToString(appOpts.Get(flags.FlagChainID))
 	if chainID == "" {
@@ -82,7 +94,30 @@ func DefaultServiceOptions[
 			true,
 		),
 		cometbft.SetChainID[LoggerT](chainID),
+		cometbft.SetSnapshot[LoggerT](snapshotStore, snapshotOpts),
+	}
+}
+
+// getSnapshotStore opens the store of the state sync snapshots, under the
+// data directory. The store is needed to restore snapshots even when the node
+// does not take any.
+func getSnapshotStore(appOpts config.AppOptions) (*snapshots.Store, error) {
+	var (
+		homeDir     = cast.ToString(appOpts.Get(flags.FlagHome))
+		snapshotDir = filepath.Join(homeDir, "data", "snapshots")
+	)
+	//#nosec:G301 // snapshots are served to peers anyway.
+	if err := os.MkdirAll(snapshotDir, 0o755); err != nil {
+		return nil, fmt.Errorf(
+			"failed to create snapshots directory: %w", err,
+		)
+	}
+
+	snapshotDB, err := dbm.NewDB("metadata", dbm.PebbleDBBackend, snapshotDir)
+	if err != nil {
+		return nil, err
 	}
+	return snapshots.NewStore(snapshotDB, snapshotDir)
 }
 
 func loadChainIDFromGenesis(appOpts config.AppOptions) (string, error) {
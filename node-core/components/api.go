@@ -29,12 +29,31 @@ import (
 	"github.com/berachain/beacon-kit/node-api/engines/echo"
 	"github.com/berachain/beacon-kit/node-api/handlers"
 	"github.com/berachain/beacon-kit/node-api/server"
+	"github.com/berachain/beacon-kit/primitives/net/jwt"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+// NodeAPIEngineInput is the input for the node API engine provider.
+type NodeAPIEngineInput struct {
+	depinject.In
+
+	Config *config.Config
+}
+
 // TODO: we could make engine type configurable
-func ProvideNodeAPIEngine() *echo.Engine {
-	return echo.NewDefaultEngine()
+func ProvideNodeAPIEngine(in NodeAPIEngineInput) (*echo.Engine, error) {
+	var (
+		cfg       = in.Config.NodeAPI
+		jwtSecret *jwt.Secret
+		err       error
+	)
+	if cfg.Auth.Mode == server.AuthModeJWT && cfg.Auth.JWTSecretPath != "" {
+		jwtSecret, err = LoadJWTFromFile(cfg.Auth.JWTSecretPath)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return echo.NewDefaultEngine(cfg, jwtSecret)
 }
 
 type NodeAPIBackendInput[
@@ -54,6 +73,8 @@ func ProvideNodeAPIBackend[
 	KVStoreT any,
 	NodeT interface {
 		CreateQueryContext(height int64, prove bool) (sdk.Context, error)
+		IsSyncing() bool
+		HighestPeerHeight() int64
 	},
 	StorageBackendT StorageBackend[
 		AvailabilityStoreT, BeaconBlockStoreT, DepositStoreT,
@@ -22,6 +22,9 @@ package components
 
 import (
 	"cosmossdk.io/depinject"
+	"github.com/berachain/beacon-kit/chain-spec/chain"
+	"github.com/berachain/beacon-kit/execution/client"
+	"github.com/berachain/beacon-kit/node-api/events"
 	"github.com/berachain/beacon-kit/node-api/handlers"
 	beaconapi "github.com/berachain/beacon-kit/node-api/handlers/beacon"
 	builderapi "github.com/berachain/beacon-kit/node-api/handlers/builder"
@@ -30,6 +33,8 @@ import (
 	eventsapi "github.com/berachain/beacon-kit/node-api/handlers/events"
 	nodeapi "github.com/berachain/beacon-kit/node-api/handlers/node"
 	proofapi "github.com/berachain/beacon-kit/node-api/handlers/proof"
+	"github.com/berachain/beacon-kit/node-core/services/version"
+	"github.com/berachain/beacon-kit/payload/relay"
 )
 
 type NodeAPIHandlersInput[
@@ -69,38 +74,50 @@ func ProvideNodeAPIHandlers[
 func ProvideNodeAPIBeaconHandler[
 	NodeT any,
 	NodeAPIContextT NodeAPIContext,
-](b NodeAPIBackend[NodeT]) *beaconapi.Handler[NodeAPIContextT] {
-	return beaconapi.NewHandler[NodeAPIContextT](b)
+](
+	b NodeAPIBackend[NodeT],
+	relayClient *relay.Client,
+) *beaconapi.Handler[NodeAPIContextT] {
+	return beaconapi.NewHandler[NodeAPIContextT](b, relayClient)
 }
 
 func ProvideNodeAPIBuilderHandler[
+	NodeT any,
 	NodeAPIContextT NodeAPIContext,
-]() *builderapi.Handler[NodeAPIContextT] {
-	return builderapi.NewHandler[NodeAPIContextT]()
+](b NodeAPIBackend[NodeT]) *builderapi.Handler[NodeAPIContextT] {
+	return builderapi.NewHandler[NodeAPIContextT](b)
 }
 
 func ProvideNodeAPIConfigHandler[
 	NodeAPIContextT NodeAPIContext,
-]() *configapi.Handler[NodeAPIContextT] {
-	return configapi.NewHandler[NodeAPIContextT]()
+](cs chain.ChainSpec) *configapi.Handler[NodeAPIContextT] {
+	return configapi.NewHandler[NodeAPIContextT](cs)
 }
 
 func ProvideNodeAPIDebugHandler[
+	NodeT any,
 	NodeAPIContextT NodeAPIContext,
-]() *debugapi.Handler[NodeAPIContextT] {
-	return debugapi.NewHandler[NodeAPIContextT]()
+](b NodeAPIBackend[NodeT]) *debugapi.Handler[NodeAPIContextT] {
+	return debugapi.NewHandler[NodeAPIContextT](b)
 }
 
 func ProvideNodeAPIEventsHandler[
 	NodeAPIContextT NodeAPIContext,
-]() *eventsapi.Handler[NodeAPIContextT] {
-	return eventsapi.NewHandler[NodeAPIContextT]()
+](bus *events.Bus) *eventsapi.Handler[NodeAPIContextT] {
+	return eventsapi.NewHandler[NodeAPIContextT](bus)
 }
 
 func ProvideNodeAPINodeHandler[
+	NodeT any,
 	NodeAPIContextT NodeAPIContext,
-]() *nodeapi.Handler[NodeAPIContextT] {
-	return nodeapi.NewHandler[NodeAPIContextT]()
+](
+	b NodeAPIBackend[NodeT],
+	engineClient *client.EngineClient,
+	reportingService *version.ReportingService,
+) *nodeapi.Handler[NodeAPIContextT] {
+	return nodeapi.NewHandler[NodeAPIContextT](
+		b, engineClient, reportingService.NodeVersion(),
+	)
 }
 
 func ProvideNodeAPIProofHandler[
@@ -25,7 +25,12 @@ import (
 	"github.com/berachain/beacon-kit/config"
 	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
 	"github.com/berachain/beacon-kit/log"
+	"github.com/berachain/beacon-kit/node-core/components/storage"
 	"github.com/berachain/beacon-kit/storage/block"
+	"github.com/berachain/beacon-kit/storage/db"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cast"
 )
 
 // BlockStoreInput is the input for the dep inject framework.
@@ -34,8 +39,9 @@ type BlockStoreInput[
 ] struct {
 	depinject.In
 
-	Config *config.Config
-	Logger LoggerT
+	AppOpts config.AppOptions
+	Config  *config.Config
+	Logger  LoggerT
 }
 
 // ProvideBlockStore is a function that provides the module to the
@@ -45,8 +51,21 @@ func ProvideBlockStore[
 ](
 	in BlockStoreInput[LoggerT],
 ) (*block.KVStore[*ctypes.BeaconBlock], error) {
+	if err := in.Config.BlockStoreService.Validate(); err != nil {
+		return nil, err
+	}
+
+	pdb, err := db.OpenNamedDB(
+		cast.ToString(in.AppOpts.Get(flags.FlagHome)),
+		"blocks",
+		dbm.PebbleDBBackend,
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	return block.NewStore[*ctypes.BeaconBlock](
+		storage.NewKVStoreProvider(pdb),
 		in.Logger.With("service", "block-store"),
-		in.Config.BlockStoreService.AvailabilityWindow,
 	), nil
 }
@@ -30,6 +30,7 @@ import (
 	"github.com/berachain/beacon-kit/execution/deposit"
 	"github.com/berachain/beacon-kit/execution/engine"
 	"github.com/berachain/beacon-kit/log"
+	"github.com/berachain/beacon-kit/node-api/events"
 	"github.com/berachain/beacon-kit/node-core/components/metrics"
 	"github.com/berachain/beacon-kit/primitives/crypto"
 	"github.com/berachain/beacon-kit/primitives/math"
@@ -53,6 +54,7 @@ type ChainServiceInput[
 	ChainSpec       chain.ChainSpec
 	Cfg             *config.Config
 	EngineClient    *client.EngineClient
+	EventBus        *events.Bus
 	ExecutionEngine *engine.Engine
 	LocalBuilder    LocalBuilder
 	Logger          LoggerT
@@ -64,6 +66,9 @@ type ChainServiceInput[
 	]
 	TelemetrySink         *metrics.TelemetrySink
 	BeaconDepositContract DepositContractT
+	// WithdrawalRequestContract reads the withdrawal requests made on the
+	// execution layer.
+	WithdrawalRequestContract *deposit.WrappedWithdrawalRequestContract
 }
 
 // ProvideChainService is a depinject provider for the blockchain service.
@@ -99,14 +104,18 @@ func ProvideChainService[
 		in.StorageBackend,
 		in.BlobProcessor,
 		in.BeaconDepositContract,
+		in.WithdrawalRequestContract,
 		math.U64(in.ChainSpec.Eth1FollowDistance()),
 		in.Logger.With("service", "blockchain"),
 		in.ChainSpec,
 		in.ExecutionEngine,
+		in.EngineClient,
 		in.LocalBuilder,
 		in.StateProcessor,
+		in.EventBus,
 		in.TelemetrySink,
 		// If optimistic is enabled, we want to skip post finalization FCUs.
 		in.Cfg.Validator.EnableOptimisticPayloadBuilds,
+		in.Cfg.BlockStoreService,
 	)
 }
@@ -23,27 +23,83 @@ package components
 import (
 	"os"
 
+	"cosmossdk.io/depinject"
 	"github.com/berachain/beacon-kit/chain-spec/chain"
+	"github.com/berachain/beacon-kit/cli/flags"
+	"github.com/berachain/beacon-kit/config"
 	"github.com/berachain/beacon-kit/config/spec"
+	"github.com/berachain/beacon-kit/log"
+	"github.com/spf13/cast"
 )
 
 const (
 	ChainSpecTypeEnvVar  = "CHAIN_SPEC"
+	ChainSpecFileEnvVar  = "CHAIN_SPEC_FILE"
 	DevnetChainSpecType  = "devnet"
 	BetnetChainSpecType  = "betnet"
 	BoonetChainSpecType  = "boonet"
 	TestnetChainSpecType = "testnet"
 )
 
-// ProvideChainSpec provides the chain spec based on the environment variable.
-func ProvideChainSpec() (chain.ChainSpec, error) {
-	// TODO: This is hood as fuck needs to be improved
-	// but for now we ball to get CI unblocked.
+// ChainSpecInput is the input for the dep inject framework.
+type ChainSpecInput[
+	LoggerT log.AdvancedLogger[LoggerT],
+] struct {
+	depinject.In
+
+	// AppOpts is only available when starting the node, CLI commands rely on
+	// the environment variables alone.
+	AppOpts config.AppOptions `optional:"true"`
+	Logger  LoggerT
+}
+
+// ProvideChainSpec provides the chain spec. A spec file given by the
+// chain-spec-file flag, or else by the CHAIN_SPEC_FILE environment variable,
+// takes precedence over the preset named by the CHAIN_SPEC environment
+// variable.
+func ProvideChainSpec[
+	LoggerT log.AdvancedLogger[LoggerT],
+](in ChainSpecInput[LoggerT]) (chain.ChainSpec, error) {
+	specFile := os.Getenv(ChainSpecFileEnvVar)
+	if in.AppOpts != nil {
+		if path := cast.ToString(in.AppOpts.Get(flags.ChainSpecFile)); path != "" {
+			specFile = path
+		}
+	}
+	if specFile == "" {
+		chainSpecType := os.Getenv(ChainSpecTypeEnvVar)
+		chainSpec, err := PresetChainSpec(chainSpecType)
+		if err != nil {
+			return nil, err
+		}
+		in.Logger.Info(
+			"Loaded preset chain spec",
+			"type", chainSpecType,
+			"hash", spec.Hash(chainSpec),
+		)
+		return chainSpec, nil
+	}
+
+	chainSpec, err := spec.LoadChainSpec(specFile)
+	if err != nil {
+		return nil, err
+	}
+	in.Logger.Info(
+		"Loaded chain spec from file",
+		"path", specFile,
+		"hash", spec.Hash(chainSpec),
+	)
+	return chainSpec, nil
+}
+
+// PresetChainSpec returns the compiled-in chain spec of the given type,
+// defaulting to the testnet spec.
+func PresetChainSpec(chainSpecType string) (chain.ChainSpec, error) {
 	var (
 		chainSpec chain.ChainSpec
 		err       error
 	)
-	switch os.Getenv(ChainSpecTypeEnvVar) {
+	switch chainSpecType {
 	case DevnetChainSpecType:
 		chainSpec, err = spec.DevnetChainSpec()
 	case BetnetChainSpecType:
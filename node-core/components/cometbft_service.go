@@ -30,7 +30,11 @@ import (
 	"github.com/berachain/beacon-kit/log"
 	"github.com/berachain/beacon-kit/node-core/builder"
 	"github.com/berachain/beacon-kit/node-core/components/metrics"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/storage/beacondb"
+	depositstore "github.com/berachain/beacon-kit/storage/deposit"
 	cmtcfg "github.com/cometbft/cometbft/config"
+	cmttypes "github.com/cometbft/cometbft/types"
 	dbm "github.com/cosmos/cosmos-db"
 )
 
@@ -44,19 +48,59 @@ func ProvideCometBFTService[
 	blockBuilder validator.BlockBuilderI,
 	db dbm.DB,
 	cmtCfg *cmtcfg.Config,
+	privVal cmttypes.PrivValidator,
 	appOpts config.AppOptions,
 	chainSpec chain.ChainSpec,
 	telemetrySink *metrics.TelemetrySink,
-) *cometbft.Service[LoggerT] {
-	return cometbft.NewService(
+	depositStore *depositstore.KVStore,
+	beaconStore *beacondb.KVStore,
+) (*cometbft.Service[LoggerT], error) {
+	svc := cometbft.NewService(
 		storeKey,
 		logger,
 		db,
 		blockchain,
 		blockBuilder,
 		cmtCfg,
+		privVal,
 		chainSpec,
 		telemetrySink,
 		builder.DefaultServiceOptions[LoggerT](appOpts)...,
 	)
+
+	// The deposit store lives outside of the beacon state, yet nodes
+	// restored from a snapshot need it to process the following blocks.
+	// The restored deposits are checked against the restored beacon state.
+	depositStore.SetRestoredStateFn(restoredStateFn(svc, beaconStore))
+	if err := svc.RegisterSnapshotExtensions(depositStore); err != nil {
+		return nil, err
+	}
+	return svc, nil
+}
+
+// restoredStateFn returns a function reading the deposit index and the
+// deposit root of the beacon state committed at the given height. The
+// multistore is restored from a snapshot before its extensions are, so the
+// state of the snapshot height can be read while restoring the deposit store.
+func restoredStateFn[LoggerT log.AdvancedLogger[LoggerT]](
+	svc *cometbft.Service[LoggerT],
+	beaconStore *beacondb.KVStore,
+) depositstore.RestoredStateFn {
+	return func(height uint64) (uint64, common.Root, error) {
+		//#nosec:G115 // heights never overflow an int64.
+		ctx, err := svc.CreateQueryContext(int64(height), false)
+		if err != nil {
+			return 0, common.Root{}, err
+		}
+		st := beaconStore.WithContext(ctx)
+		depositIndex, err := st.GetEth1DepositIndex()
+		if err != nil {
+			return 0, common.Root{}, err
+		}
+		eth1Data, err := st.GetEth1Data()
+		if err != nil {
+			return 0, common.Root{}, err
+		}
+		return depositIndex, eth1Data.DepositRoot, nil
+	}
 }
@@ -46,3 +46,14 @@ func ProvideDepositContract(
 		in.EngineClient,
 	)
 }
+
+// ProvideWithdrawalRequestContract provides a withdrawal request contract
+// through the dep inject framework.
+func ProvideWithdrawalRequestContract(
+	in DepositContractInput,
+) (*deposit.WrappedWithdrawalRequestContract, error) {
+	return deposit.NewWrappedWithdrawalRequestContract(
+		in.ChainSpec.WithdrawalRequestContractAddress(),
+		in.EngineClient,
+	)
+}
@@ -31,6 +31,7 @@ import (
 	"github.com/berachain/beacon-kit/log"
 	"github.com/berachain/beacon-kit/node-api/handlers"
 	"github.com/berachain/beacon-kit/node-api/handlers/beacon/types"
+	nodetypes "github.com/berachain/beacon-kit/node-api/handlers/node/types"
 	"github.com/berachain/beacon-kit/primitives/common"
 	"github.com/berachain/beacon-kit/primitives/constraints"
 	"github.com/berachain/beacon-kit/primitives/crypto"
@@ -57,6 +58,11 @@ type (
 	// AvailabilityStore is the interface for the availability store.
 	AvailabilityStore interface {
 		IndexDB
+		// GetBlobSidecar returns the sidecar stored at the given slot for the
+		// given commitment.
+		GetBlobSidecar(
+			slot math.Slot, commitment eip4844.KZGCommitment,
+		) (*datypes.BlobSidecar, error)
 		// IsDataAvailable ensures that all blobs referenced in the block are
 		// securely stored before it returns without an error.
 		IsDataAvailable(context.Context, math.Slot, *ctypes.BeaconBlockBody) bool
@@ -75,6 +81,13 @@ type (
 		// GetConsensusTime returns the timestamp of current consensus request.
 		// It is used to build next payload and to validate currentpayload.
 		GetConsensusTime() math.U64
+
+		// GetEquivocations returns the double-signing evidence included by
+		// consensus in the block.
+		GetEquivocations() []transition.Equivocation
+		// GetVotes returns the participation of validators in the commit of
+		// the previous block.
+		GetVotes() []transition.Vote
 	}
 
 	// BeaconBlock represents a generic interface for a beacon block.
@@ -137,6 +150,9 @@ type (
 		SetEth1Data(*ctypes.Eth1Data)
 		// SetDeposits sets the deposits of the beacon block body.
 		SetDeposits([]*ctypes.Deposit)
+		// SetWithdrawalRequests sets the withdrawal requests of the beacon
+		// block body.
+		SetWithdrawalRequests(ctypes.WithdrawalRequests)
 		// SetExecutionPayload sets the execution data of the beacon block body.
 		SetExecutionPayload(*ctypes.ExecutionPayload)
 		// SetGraffiti sets the graffiti of the beacon block body.
@@ -208,6 +224,16 @@ type (
 	// BlockStore is the interface for block storage.
 	BlockStore interface {
 		Set(blk *ctypes.BeaconBlock) error
+		// Close closes the block store.
+		Close() error
+		// SetSignature sets the proposer signature of the block at the given
+		// slot.
+		SetSignature(slot math.Slot, signature crypto.BLSSignature) error
+		// GetSignatureBySlot retrieves the proposer signature of the
+		// finalized block at the given slot, if known.
+		GetSignatureBySlot(slot math.Slot) (crypto.BLSSignature, error)
+		// GetBlockBySlot retrieves the finalized block at the given slot.
+		GetBlockBySlot(slot math.Slot) (*ctypes.BeaconBlock, error)
 		// GetSlotByBlockRoot retrieves the slot by a given root from the store.
 		GetSlotByBlockRoot(root common.Root) (math.Slot, error)
 		// GetSlotByStateRoot retrieves the slot by a given root from the store.
@@ -215,6 +241,11 @@ type (
 		// GetParentSlotByTimestamp retrieves the parent slot by a given
 		// timestamp from the store.
 		GetParentSlotByTimestamp(timestamp math.U64) (math.Slot, error)
+		// GetHeaderBySlot retrieves the header of the finalized block at the
+		// given slot.
+		GetHeaderBySlot(slot math.Slot) (*ctypes.BeaconBlockHeader, error)
+		// Prune prunes the blocks of the slots in [start, end).
+		Prune(start, end uint64) error
 	}
 
 	ConsensusEngine interface {
@@ -307,6 +338,47 @@ type (
 		Prune(start, end uint64) error
 		// EnqueueDeposits adds a list of deposits to the deposit store.
 		EnqueueDeposits(deposits []*ctypes.Deposit) error
+		// GetEth1BlockCursor returns the highest execution block up to which
+		// every block has either been processed or recorded as failed.
+		GetEth1BlockCursor() (uint64, error)
+		// SetEth1BlockCursor sets the highest execution block up to which
+		// every block has either been processed or recorded as failed.
+		SetEth1BlockCursor(blockNum uint64) error
+		// GetFailedEth1Blocks returns the execution blocks whose deposits
+		// failed to be fetched.
+		GetFailedEth1Blocks() ([]uint64, error)
+		// AddFailedEth1Block records that the deposits of the given
+		// execution block failed to be fetched.
+		AddFailedEth1Block(blockNum uint64) error
+		// RemoveFailedEth1Block removes the given execution block from the
+		// failed set.
+		RemoveFailedEth1Block(blockNum uint64) error
+		// MarkEth1BlocksMissing records the execution blocks after the
+		// cursor up to the given one as a missing range, and moves the
+		// cursor to it.
+		MarkEth1BlocksMissing(blockNum uint64) error
+		// TakeMissingEth1Blocks moves up to limit missing execution blocks
+		// into the failed set and returns them.
+		TakeMissingEth1Blocks(limit uint64) ([]uint64, error)
+		// GetOldestMissingEth1Block returns the lowest failed or missing
+		// execution block, and whether there is any.
+		GetOldestMissingEth1Block() (uint64, bool, error)
+		// EnqueueWithdrawalRequests adds a list of withdrawal requests to
+		// the deposit store.
+		EnqueueWithdrawalRequests(requests []*ctypes.WithdrawalRequest) error
+		// GetWithdrawalRequestsByIndex returns `numView` expected withdrawal
+		// requests.
+		GetWithdrawalRequestsByIndex(
+			startIndex uint64,
+			numView uint64,
+		) (ctypes.WithdrawalRequests, error)
+		// PruneWithdrawalRequests prunes the withdrawal requests of
+		// [start, end) from the deposit store.
+		PruneWithdrawalRequests(start, end uint64) error
+		// RecordCheckpoint records the deposit cursor, the number of
+		// deposits and the number of withdrawal requests at the given
+		// consensus height, which bound state sync snapshots.
+		RecordCheckpoint(height uint64) error
 	}
 
 	// Genesis is the interface for the genesis.
@@ -322,6 +394,7 @@ type (
 
 	// IndexDB is the interface for the range DB.
 	IndexDB interface {
+		Get(index uint64, key []byte) ([]byte, error)
 		Has(index uint64, key []byte) (bool, error)
 		Set(index uint64, key []byte, value []byte) error
 		Prune(start uint64, end uint64) error
@@ -410,12 +483,12 @@ type (
 	}
 
 	SidecarFactory interface {
-		// BuildSidecars builds sidecars for a given block and blobs bundle.
+		// BuildSidecars builds sidecars for a given block and blobs bundle,
+		// carrying the header of the block along with its signature.
 		BuildSidecars(
 			blk *ctypes.BeaconBlock,
 			blobs ctypes.BlobsBundle,
-			signer crypto.BLSSigner,
-			forkData *ctypes.ForkData,
+			signature crypto.BLSSignature,
 		) (datypes.BlobSidecars, error)
 	}
 
@@ -809,13 +882,43 @@ type (
 		GetParentSlotByTimestamp(timestamp math.U64) (math.Slot, error)
 
 		NodeAPIBeaconBackend
+		NodeAPIBuilderBackend
+		NodeAPIDebugBackend
+		NodeAPINodeBackend
 		NodeAPIProofBackend
 	}
 
+	// NodeAPIBuilderBackend is the interface for backend of the builder API.
+	NodeAPIBuilderBackend interface {
+		// ExpectedWithdrawalsAtSlot returns the withdrawals expected in the
+		// payload of the block proposed at proposalSlot, on top of the state
+		// at the given slot.
+		ExpectedWithdrawalsAtSlot(
+			slot, proposalSlot math.Slot,
+		) (engineprimitives.Withdrawals, error)
+		// GetSlotByStateRoot retrieves the slot by a given root from the store.
+		GetSlotByStateRoot(root common.Root) (math.Slot, error)
+	}
+
+	// NodeAPIDebugBackend is the interface for backend of the debug API.
+	NodeAPIDebugBackend interface {
+		BlockBackend
+		StateBackend
+		// GetSlotByStateRoot retrieves the slot by a given root from the store.
+		GetSlotByStateRoot(root common.Root) (math.Slot, error)
+	}
+
+	// NodeAPINodeBackend is the interface for backend of the node API.
+	NodeAPINodeBackend interface {
+		// SyncingData returns the sync status of the node.
+		SyncingData() (*nodetypes.SyncingData, error)
+	}
+
 	// NodeAPIBackend is the interface for backend of the beacon API.
 	NodeAPIBeaconBackend interface {
 		GenesisBackend
 		BlockBackend
+		BlobBackend
 		RandaoBackend
 		StateBackend
 		ValidatorBackend
@@ -846,16 +949,25 @@ type (
 		RandaoAtEpoch(slot math.Slot, epoch math.Epoch) (common.Bytes32, error)
 	}
 
+	BlobBackend interface {
+		BlobSidecarsAtSlot(
+			slot math.Slot, indices []uint64,
+		) (datypes.BlobSidecars, error)
+	}
+
 	BlockBackend interface {
+		BlockAtSlot(slot math.Slot) (*ctypes.BeaconBlock, error)
 		BlockRootAtSlot(slot math.Slot) (common.Root, error)
 		BlockRewardsAtSlot(slot math.Slot) (*types.BlockRewardsData, error)
 		BlockHeaderAtSlot(slot math.Slot) (*ctypes.BeaconBlockHeader, error)
+		BlockSignatureAtSlot(slot math.Slot) (crypto.BLSSignature, error)
 	}
 
 	StateBackend interface {
 		StateRootAtSlot(slot math.Slot) (common.Root, error)
 		StateForkAtSlot(slot math.Slot) (*ctypes.Fork, error)
 		StateFromSlotForProof(slot math.Slot) (*statedb.StateDB, math.Slot, error)
+		StateAtSlot(slot math.Slot) (*ctypes.BeaconState, math.Slot, error)
 	}
 
 	ValidatorBackend interface {
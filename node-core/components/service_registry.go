@@ -92,7 +92,9 @@ func ProvideServiceRegistry[
 		service.WithService(in.ReportingService),
 		service.WithService(in.EngineClient),
 		service.WithService(in.TelemetryService),
-		service.WithService(in.ChainService),
 		service.WithService(in.CometBFTService),
+		// The chain service is stopped after consensus, as it closes the
+		// block store written to on finalization.
+		service.WithService(in.ChainService),
 	)
 }
@@ -17,48 +17,149 @@
 // EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
 // MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
 // TITLE.
-
 package components
 
 import (
+	"fmt"
+	"os"
 	"path/filepath"
 
 	"cosmossdk.io/depinject"
 	"github.com/berachain/beacon-kit/config"
+	servercmtlog "github.com/berachain/beacon-kit/consensus/cometbft/service/log"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/log"
 	"github.com/berachain/beacon-kit/node-core/components/signer"
 	"github.com/berachain/beacon-kit/primitives/constants"
 	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/crypto/keystore"
+	cmtcfg "github.com/cometbft/cometbft/config"
+	"github.com/cometbft/cometbft/privval"
+	cmttypes "github.com/cometbft/cometbft/types"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
 	"github.com/spf13/cast"
 )
 
+// PrivValidatorInput is the input for the dep inject framework.
+type PrivValidatorInput[LoggerT any] struct {
+	depinject.In
+	Cfg    *config.Config
+	CmtCfg *cmtcfg.Config
+	Logger LoggerT
+}
+
+// ProvidePrivValidator provides the signer of the validator key, shared by
+// CometBFT and the beacon chain. If priv_validator_laddr is set in the
+// CometBFT config, the key is held by a remote signer dialing that address,
+// guarded by a local slashing protection. Otherwise, the key is decrypted from
+// the configured EIP-2335 keystore, if any, or read from the
+// priv_validator_key_file.
+func ProvidePrivValidator[
+	LoggerT log.AdvancedLogger[LoggerT],
+](in PrivValidatorInput[LoggerT]) (cmttypes.PrivValidator, error) {
+	cfg := in.CmtCfg
+	if cfg.PrivValidatorListenAddr == "" {
+		validatorCfg := in.Cfg.Validator
+		if validatorCfg.KeystoreFile == "" {
+			return privval.LoadOrGenFilePV(
+				cfg.PrivValidatorKeyFile(),
+				cfg.PrivValidatorStateFile(),
+				nil,
+			)
+		}
+		key, err := loadKeystoreKey(
+			cfg.RootDir,
+			validatorCfg.KeystoreFile,
+			validatorCfg.KeystorePasswordFile,
+		)
+		if err != nil {
+			return nil, err
+		}
+		return signer.NewKeystorePrivValidator(
+			key, cfg.PrivValidatorStateFile(),
+		)
+	}
+
+	chainID, err := chainIDFromGenesisFile(cfg.GenesisFile())
+	if err != nil {
+		return nil, err
+	}
+	remote, err := signer.NewRemotePrivValidator(
+		cfg.PrivValidatorListenAddr,
+		chainID,
+		servercmtlog.WrapCometLogger(in.Logger.With("service", "signer")),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return signer.NewSlashingProtection(remote, cfg.PrivValidatorStateFile())
+}
+
 // BlsSignerInput is the input for the dep inject framework.
 type BlsSignerInput struct {
 	depinject.In
-	AppOpts config.AppOptions
-	PrivKey LegacyKey `optional:"true"`
+	AppOpts       config.AppOptions
+	PrivValidator cmttypes.PrivValidator `optional:"true"`
+	PrivKey       LegacyKey              `optional:"true"`
 }
 
 // ProvideBlsSigner is a function that provides the module to the application.
 func ProvideBlsSigner(in BlsSignerInput) (crypto.BLSSigner, error) {
-	if in.PrivKey == [constants.BLSSecretKeyLength]byte{} {
-		// if no private key is provided, use privval signer
-		homeDir := cast.ToString(in.AppOpts.Get(flags.FlagHome))
-		privValKeyFile := cast.ToString(
-			in.AppOpts.Get("priv_validator_key_file"),
-		)
-		privValStateFile := cast.ToString(
-			in.AppOpts.Get("priv_validator_state_file"),
+	if in.PrivKey != [constants.BLSSecretKeyLength]byte{} {
+		return signer.NewLegacySigner(in.PrivKey)
+	}
+	if in.PrivValidator != nil {
+		// use the validator key signer shared with CometBFT
+		return &signer.BLSSigner{PrivValidator: in.PrivValidator}, nil
+	}
+
+	// if neither is provided, use the privval signer of the key file
+	homeDir := cast.ToString(in.AppOpts.Get(flags.FlagHome))
+	privValKeyFile := rootify(
+		cast.ToString(in.AppOpts.Get("priv_validator_key_file")), homeDir,
+	)
+	privValStateFile := rootify(
+		cast.ToString(in.AppOpts.Get("priv_validator_state_file")), homeDir,
+	)
+	return signer.NewBLSSigner(privValKeyFile, privValStateFile), nil
+}
+
+// loadKeystoreKey decrypts the validator key of the keystore with the password
+// of the password file. Relative paths are relative to the home directory.
+func loadKeystoreKey(
+	homeDir, keystoreFile, passwordFile string,
+) (signer.LegacyKey, error) {
+	if passwordFile == "" {
+		return signer.LegacyKey{}, signer.ErrNoKeystorePassword
+	}
+	password, err := keystore.ReadPasswordFile(rootify(passwordFile, homeDir))
+	if err != nil {
+		return signer.LegacyKey{}, err
+	}
+	return signer.LoadKeystoreKey(rootify(keystoreFile, homeDir), password)
+}
+
+// rootify joins the path with the home directory if it is not absolute.
+func rootify(path, homeDir string) string {
+	if filepath.IsAbs(path) {
+		return path
+	}
+	return filepath.Join(homeDir, path)
+}
+
+// chainIDFromGenesisFile returns the chain ID of the given genesis file.
+func chainIDFromGenesisFile(genesisFile string) (string, error) {
+	f, err := os.Open(filepath.Clean(genesisFile))
+	if err != nil {
+		return "", err
+	}
+	chainID, err := genutiltypes.ParseChainIDFromGenesis(f)
+	if err != nil {
+		return "", errors.Join(
+			f.Close(),
+			fmt.Errorf("failed to parse chain-id from genesis file: %w", err),
 		)
-		// If privValKeyFile is not an absolute path, join with homeDir
-		if !filepath.IsAbs(privValKeyFile) {
-			privValKeyFile = filepath.Join(homeDir, privValKeyFile)
-		}
-		// If privValStateFile is not an absolute path, join with homeDir
-		if !filepath.IsAbs(privValStateFile) {
-			privValStateFile = filepath.Join(homeDir, privValStateFile)
-		}
-		return signer.NewBLSSigner(privValKeyFile, privValStateFile), nil
 	}
-	return signer.NewLegacySigner(in.PrivKey)
+	return chainID, f.Close()
 }
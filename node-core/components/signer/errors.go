@@ -38,4 +38,12 @@ var (
 	ErrInvalidValidatorPrivateKeyLength = errors.New(
 		"invalid validator private key length",
 	)
+
+	// ErrNoKeystorePassword is returned when a keystore is configured without
+	// the file holding its password.
+	ErrNoKeystorePassword = errors.New("keystore password file not set")
+
+	// ErrDoubleSign is returned when signing a consensus message conflicting
+	// with one signed before.
+	ErrDoubleSign = errors.New("refusing to double sign")
 )
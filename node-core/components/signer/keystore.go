@@ -0,0 +1,89 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package signer
+
+import (
+	"os"
+
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/primitives/constants"
+	"github.com/berachain/beacon-kit/primitives/crypto/keystore"
+	"github.com/cometbft/cometbft/crypto/bls12381"
+	cmtjson "github.com/cometbft/cometbft/libs/json"
+	"github.com/cometbft/cometbft/privval"
+)
+
+// NewKeystore encrypts the BLS secret key into an EIP-2335 keystore.
+func NewKeystore(
+	key LegacyKey,
+	password string,
+) (*keystore.Keystore, error) {
+	pk, err := bls12381.NewPrivateKeyFromBytes(key[:])
+	if err != nil {
+		return nil, err
+	}
+	return keystore.Encrypt(key[:], pk.PubKey().Bytes(), password)
+}
+
+// LoadKeystoreKey decrypts the BLS secret key of the EIP-2335 keystore at the
+// given path.
+func LoadKeystoreKey(keystoreFile, password string) (LegacyKey, error) {
+	ks, err := keystore.Load(keystoreFile)
+	if err != nil {
+		return LegacyKey{}, err
+	}
+	secret, err := ks.Decrypt(password)
+	if err != nil {
+		return LegacyKey{}, errors.Wrapf(err, "decrypting %s", keystoreFile)
+	}
+	if len(secret) != constants.BLSSecretKeyLength {
+		return LegacyKey{}, ErrInvalidValidatorPrivateKeyLength
+	}
+	return LegacyKey(secret), nil
+}
+
+// NewKeystorePrivValidator returns a privval.FilePV signing with the given
+// key, decrypted from a keystore. The key is only held in memory, while the
+// last sign state is persisted at stateFilePath as usual.
+func NewKeystorePrivValidator(
+	key LegacyKey,
+	stateFilePath string,
+) (*privval.FilePV, error) {
+	pk, err := bls12381.NewPrivateKeyFromBytes(key[:])
+	if err != nil {
+		return nil, err
+	}
+	// The empty key file path makes sure the key never gets written to disk.
+	pv := privval.NewFilePV(pk, "", stateFilePath)
+
+	bz, err := os.ReadFile(stateFilePath)
+	switch {
+	case errors.Is(err, os.ErrNotExist):
+		pv.LastSignState.Save()
+		return pv, nil
+	case err != nil:
+		return nil, err
+	}
+	if err = cmtjson.Unmarshal(bz, &pv.LastSignState); err != nil {
+		return nil, errors.Wrapf(err, "reading %s", stateFilePath)
+	}
+	return pv, nil
+}
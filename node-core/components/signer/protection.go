@@ -0,0 +1,306 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package signer
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
+	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
+	cmtjson "github.com/cometbft/cometbft/libs/json"
+	"github.com/cometbft/cometbft/libs/protoio"
+	"github.com/cometbft/cometbft/privval"
+	"github.com/cometbft/cometbft/types"
+)
+
+// blockStateFileName is the name of the file recording the beacon blocks
+// signed, next to the last sign state file.
+const blockStateFileName = "priv_validator_block_state.json"
+
+// Steps of the consensus messages, as recorded by privval.FilePV.
+const (
+	stepPropose   int8 = 1
+	stepPrevote   int8 = 2
+	stepPrecommit int8 = 3
+)
+
+// SlashingProtection guards a PrivValidator, typically a remote signer,
+// against double signing consensus messages. It records the height, round
+// and step of the last message signed in a state file, in the format of the
+// privval.FilePV state file so that the state of a local validator carries
+// over to a remote signer. Votes and proposals regressing from that state are
+// refused, and those at the same height, round and step must match the
+// message signed. Beacon blocks are guarded likewise, as in EIP-3076: the
+// slot, round and signing root of the last block signed are recorded per
+// domain, and a block at an earlier slot, or with another root at the same
+// slot and round, is refused. Other arbitrary bytes are signed as is.
+type SlashingProtection struct {
+	types.PrivValidator
+
+	mu                 sync.Mutex
+	state              privval.FilePVLastSignState
+	stateFilePath      string
+	blocks             map[common.Domain]signedBlock
+	blockStateFilePath string
+}
+
+// signedBlock is the record of the last beacon block signed in a domain.
+type signedBlock struct {
+	Slot        math.Slot   `json:"slot"`
+	Round       int32       `json:"round"`
+	SigningRoot common.Root `json:"signing_root"`
+}
+
+// NewSlashingProtection guards the given PrivValidator with the last sign
+// state persisted at stateFilePath, which is created if it does not exist.
+func NewSlashingProtection(
+	pv types.PrivValidator,
+	stateFilePath string,
+) (*SlashingProtection, error) {
+	p := &SlashingProtection{
+		PrivValidator: pv,
+		stateFilePath: stateFilePath,
+		blocks:        make(map[common.Domain]signedBlock),
+		blockStateFilePath: filepath.Join(
+			filepath.Dir(stateFilePath), blockStateFileName,
+		),
+	}
+	if err := readStateFile(
+		stateFilePath, &p.state, cmtjson.Unmarshal,
+	); err != nil {
+		return nil, err
+	}
+	if err := readStateFile(
+		p.blockStateFilePath, &p.blocks, json.Unmarshal,
+	); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// readStateFile decodes the state file into v, unless it does not exist.
+func readStateFile(
+	path string,
+	v any,
+	unmarshal func([]byte, any) error,
+) error {
+	bz, err := os.ReadFile(path)
+	switch {
+	case errors.Is(err, os.ErrNotExist):
+		return nil
+	case err != nil:
+		return err
+	}
+	if err = unmarshal(bz, v); err != nil {
+		return errors.Wrapf(err, "reading %s", path)
+	}
+	return nil
+}
+
+// SignBlock signs the signing root of the beacon block at the given slot in
+// the given domain, provided that it does not conflict with the blocks signed
+// before in that domain. Another block may be signed at the same slot for a
+// later consensus round, as the proposer of that round builds a new block.
+func (p *SlashingProtection) SignBlock(
+	slot math.Slot,
+	domain common.Domain,
+	signingRoot common.Root,
+) ([]byte, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	round := p.blockRound(slot)
+	last, found := p.blocks[domain]
+	switch {
+	case !found || slot > last.Slot:
+	case slot == last.Slot && signingRoot == last.SigningRoot:
+		return p.PrivValidator.SignBytes(signingRoot[:])
+	case slot == last.Slot && round > last.Round:
+	default:
+		return nil, errors.Wrapf(
+			ErrDoubleSign,
+			"conflicting block at slot %d round %d, "+
+				"last signed block at slot %d round %d",
+			slot, round, last.Slot, last.Round,
+		)
+	}
+
+	signature, err := p.PrivValidator.SignBytes(signingRoot[:])
+	if err != nil {
+		return nil, err
+	}
+	p.blocks[domain] = signedBlock{
+		Slot: slot, Round: round, SigningRoot: signingRoot,
+	}
+	bz, err := json.MarshalIndent(p.blocks, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return signature, writeFileAtomic(p.blockStateFilePath, bz)
+}
+
+// blockRound returns the consensus round of a beacon block built at the
+// given slot. Blocks are built before their proposal is signed, so the round
+// is not known from the consensus messages yet. It is taken to follow the
+// last round signed at that height, or to be 0 at a new height. It only
+// advances once a message of a later round is signed, so that at most one
+// block is signed per round.
+func (p *SlashingProtection) blockRound(slot math.Slot) int32 {
+	//#nosec:G115 // slots match CometBFT heights, which are positive int64.
+	if p.state.Height != int64(slot.Unwrap()) {
+		return 0
+	}
+	return p.state.Round + 1
+}
+
+// SignVote signs the vote, provided that it does not conflict with the votes
+// signed before.
+func (p *SlashingProtection) SignVote(
+	chainID string,
+	vote *cmtproto.Vote,
+	signExtension bool,
+) error {
+	step := stepPrecommit
+	if vote.Type == types.PrevoteType {
+		step = stepPrevote
+	}
+	return p.sign(
+		vote.Height, vote.Round, step,
+		func(ts time.Time) { vote.Timestamp = ts },
+		func() []byte { return types.VoteSignBytes(chainID, vote) },
+		func() ([]byte, error) {
+			err := p.PrivValidator.SignVote(chainID, vote, signExtension)
+			return vote.Signature, err
+		},
+	)
+}
+
+// SignProposal signs the proposal, provided that it does not conflict with
+// the proposals signed before.
+func (p *SlashingProtection) SignProposal(
+	chainID string,
+	proposal *cmtproto.Proposal,
+) error {
+	return p.sign(
+		proposal.Height, proposal.Round, stepPropose,
+		func(ts time.Time) { proposal.Timestamp = ts },
+		func() []byte { return types.ProposalSignBytes(chainID, proposal) },
+		func() ([]byte, error) {
+			err := p.PrivValidator.SignProposal(chainID, proposal)
+			return proposal.Signature, err
+		},
+	)
+}
+
+// sign checks the height, round and step of a message against the last sign
+// state, has the message signed, then records it. A message at the same
+// height, round and step as the last one must only differ by its timestamp,
+// which is then reset to the one signed, like privval.FilePV does.
+func (p *SlashingProtection) sign(
+	height int64,
+	round int32,
+	step int8,
+	setTimestamp func(time.Time),
+	signBytes func() []byte,
+	sign func() ([]byte, error),
+) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	sameHRS, err := p.state.CheckHRS(height, round, step)
+	if err != nil {
+		return errors.Join(ErrDoubleSign, err)
+	}
+	if sameHRS {
+		var ts time.Time
+		ts, err = lastSignTimestamp(p.state.SignBytes, step)
+		if err != nil {
+			return err
+		}
+		setTimestamp(ts)
+		if !bytes.Equal(signBytes(), p.state.SignBytes) {
+			return errors.Wrapf(
+				ErrDoubleSign,
+				"conflicting data at height %d round %d step %d",
+				height, round, step,
+			)
+		}
+	}
+
+	signature, err := sign()
+	if err != nil || sameHRS {
+		return err
+	}
+	p.state.Height, p.state.Round, p.state.Step = height, round, step
+	p.state.SignBytes, p.state.Signature = signBytes(), signature
+	return p.save()
+}
+
+// lastSignTimestamp returns the timestamp of the canonical vote or proposal
+// encoded in the given sign bytes.
+func lastSignTimestamp(signBytes []byte, step int8) (time.Time, error) {
+	if step == stepPropose {
+		var proposal cmtproto.CanonicalProposal
+		err := protoio.UnmarshalDelimited(signBytes, &proposal)
+		return proposal.Timestamp, err
+	}
+	var vote cmtproto.CanonicalVote
+	err := protoio.UnmarshalDelimited(signBytes, &vote)
+	return vote.Timestamp, err
+}
+
+// save persists the last sign state, replacing the state file atomically.
+func (p *SlashingProtection) save() error {
+	bz, err := cmtjson.MarshalIndent(&p.state, "", "  ")
+	if err != nil {
+		return err
+	}
+	return writeFileAtomic(p.stateFilePath, bz)
+}
+
+// writeFileAtomic replaces the file at the given path with the given bytes,
+// through a temporary file renamed over it.
+func writeFileAtomic(path string, bz []byte) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path))
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err = tmp.Write(bz); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err = tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err = tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
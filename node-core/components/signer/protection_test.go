@@ -0,0 +1,201 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package signer_test
+
+import (
+	"bytes"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/node-core/components/signer"
+	"github.com/berachain/beacon-kit/primitives/common"
+	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
+	"github.com/cometbft/cometbft/crypto/tmhash"
+	"github.com/cometbft/cometbft/types"
+	"github.com/stretchr/testify/require"
+)
+
+const chainID = "test-chain"
+
+func newBlockID(blockHash byte) cmtproto.BlockID {
+	hash := bytes.Repeat([]byte{blockHash}, tmhash.Size)
+	return cmtproto.BlockID{
+		Hash:          hash,
+		PartSetHeader: cmtproto.PartSetHeader{Total: 1, Hash: hash},
+	}
+}
+
+func newVote(
+	height int64,
+	round int32,
+	voteType cmtproto.SignedMsgType,
+	blockHash byte,
+) *cmtproto.Vote {
+	return &cmtproto.Vote{
+		Type:      voteType,
+		Height:    height,
+		Round:     round,
+		BlockID:   newBlockID(blockHash),
+		Timestamp: time.Now().UTC(),
+	}
+}
+
+func TestSlashingProtectionVotes(t *testing.T) {
+	stateFile := filepath.Join(t.TempDir(), "priv_validator_state.json")
+	p, err := signer.NewSlashingProtection(types.NewMockPV(), stateFile)
+	require.NoError(t, err)
+
+	prevote := newVote(10, 0, types.PrevoteType, 1)
+	require.NoError(t, p.SignVote(chainID, prevote, false))
+
+	// Signing the same vote again, at a later time, is fine.
+	again := newVote(10, 0, types.PrevoteType, 1)
+	again.Timestamp = prevote.Timestamp.Add(time.Second)
+	require.NoError(t, p.SignVote(chainID, again, false))
+	require.Equal(t, prevote.Timestamp, again.Timestamp)
+	require.Equal(t, prevote.Signature, again.Signature)
+
+	// Voting for another block at the same height, round and step is not.
+	conflicting := newVote(10, 0, types.PrevoteType, 2)
+	err = p.SignVote(chainID, conflicting, false)
+	require.ErrorIs(t, err, signer.ErrDoubleSign)
+	require.Empty(t, conflicting.Signature)
+
+	// Moving forward is fine, regressing is not.
+	require.NoError(
+		t, p.SignVote(chainID, newVote(10, 0, types.PrecommitType, 1), false),
+	)
+	err = p.SignVote(chainID, newVote(10, 0, types.PrevoteType, 1), false)
+	require.ErrorIs(t, err, signer.ErrDoubleSign)
+	err = p.SignVote(chainID, newVote(9, 3, types.PrecommitType, 1), false)
+	require.ErrorIs(t, err, signer.ErrDoubleSign)
+}
+
+func TestSlashingProtectionProposals(t *testing.T) {
+	stateFile := filepath.Join(t.TempDir(), "priv_validator_state.json")
+	p, err := signer.NewSlashingProtection(types.NewMockPV(), stateFile)
+	require.NoError(t, err)
+
+	proposal := &cmtproto.Proposal{
+		Type:      types.ProposalType,
+		Height:    5,
+		Round:     1,
+		PolRound:  -1,
+		BlockID:   newBlockID(1),
+		Timestamp: time.Now().UTC(),
+	}
+	require.NoError(t, p.SignProposal(chainID, proposal))
+
+	conflicting := *proposal
+	conflicting.BlockID = newBlockID(2)
+	conflicting.Signature = nil
+	err = p.SignProposal(chainID, &conflicting)
+	require.ErrorIs(t, err, signer.ErrDoubleSign)
+}
+
+func TestSlashingProtectionBlocks(t *testing.T) {
+	stateFile := filepath.Join(t.TempDir(), "priv_validator_state.json")
+	pv := types.NewMockPV()
+	p, err := signer.NewSlashingProtection(pv, stateFile)
+	require.NoError(t, err)
+
+	domain, otherDomain := common.Domain{0x01}, common.Domain{0x02}
+	signature, err := p.SignBlock(10, domain, common.Root{0xaa})
+	require.NoError(t, err)
+
+	// Signing the same block again yields the same signature.
+	again, err := p.SignBlock(10, domain, common.Root{0xaa})
+	require.NoError(t, err)
+	require.Equal(t, signature, again)
+
+	// Another block at the same or an earlier slot is refused.
+	_, err = p.SignBlock(10, domain, common.Root{0xbb})
+	require.ErrorIs(t, err, signer.ErrDoubleSign)
+	_, err = p.SignBlock(9, domain, common.Root{0xbb})
+	require.ErrorIs(t, err, signer.ErrDoubleSign)
+
+	// Blocks are recorded per domain.
+	_, err = p.SignBlock(9, otherDomain, common.Root{0xbb})
+	require.NoError(t, err)
+
+	// The record survives a restart.
+	p, err = signer.NewSlashingProtection(pv, stateFile)
+	require.NoError(t, err)
+	_, err = p.SignBlock(10, domain, common.Root{0xbb})
+	require.ErrorIs(t, err, signer.ErrDoubleSign)
+	_, err = p.SignBlock(11, domain, common.Root{0xbb})
+	require.NoError(t, err)
+}
+
+func TestSlashingProtectionPersistence(t *testing.T) {
+	stateFile := filepath.Join(t.TempDir(), "priv_validator_state.json")
+	pv := types.NewMockPV()
+	p, err := signer.NewSlashingProtection(pv, stateFile)
+	require.NoError(t, err)
+	require.NoError(
+		t, p.SignVote(chainID, newVote(7, 2, types.PrecommitType, 1), false),
+	)
+
+	// The state survives a restart.
+	p, err = signer.NewSlashingProtection(pv, stateFile)
+	require.NoError(t, err)
+	err = p.SignVote(chainID, newVote(7, 1, types.PrecommitType, 1), false)
+	require.ErrorIs(t, err, signer.ErrDoubleSign)
+	require.NoError(
+		t, p.SignVote(chainID, newVote(8, 0, types.PrevoteType, 1), false),
+	)
+}
+
+func TestSlashingProtectionBlocksAcrossRounds(t *testing.T) {
+	stateFile := filepath.Join(t.TempDir(), "priv_validator_state.json")
+	pv := types.NewMockPV()
+	p, err := signer.NewSlashingProtection(pv, stateFile)
+	require.NoError(t, err)
+
+	domain := common.Domain{0x01}
+	_, err = p.SignBlock(10, domain, common.Root{0xaa})
+	require.NoError(t, err)
+
+	// Round 0 at height 10 fails, so another block is built for round 1.
+	require.NoError(
+		t, p.SignVote(chainID, newVote(10, 0, types.PrecommitType, 0), false),
+	)
+	_, err = p.SignBlock(10, domain, common.Root{0xbb})
+	require.NoError(t, err)
+
+	// A third block for the same round is refused, the previous one is not.
+	_, err = p.SignBlock(10, domain, common.Root{0xcc})
+	require.ErrorIs(t, err, signer.ErrDoubleSign)
+	_, err = p.SignBlock(10, domain, common.Root{0xbb})
+	require.NoError(t, err)
+
+	// The round of the block signed survives a restart.
+	p, err = signer.NewSlashingProtection(pv, stateFile)
+	require.NoError(t, err)
+	_, err = p.SignBlock(10, domain, common.Root{0xcc})
+	require.ErrorIs(t, err, signer.ErrDoubleSign)
+	require.NoError(
+		t, p.SignVote(chainID, newVote(10, 1, types.PrevoteType, 0), false),
+	)
+	_, err = p.SignBlock(10, domain, common.Root{0xcc})
+	require.NoError(t, err)
+}
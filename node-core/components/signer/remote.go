@@ -0,0 +1,65 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package signer
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cometbft/cometbft/privval"
+	"github.com/cometbft/cometbft/types"
+)
+
+const (
+	// remoteSignerRetries is the number of attempts of a request to the
+	// remote signer, as done by CometBFT.
+	remoteSignerRetries = 50
+	// remoteSignerRetryTimeout is the delay between attempts of a request to
+	// the remote signer.
+	remoteSignerRetryTimeout = 100 * time.Millisecond
+)
+
+// NewRemotePrivValidator returns a PrivValidator signing through a remote
+// signer, which dials the given address and speaks the CometBFT privval socket
+// protocol, like CometBFT does for priv_validator_laddr. It waits for the
+// remote signer to connect.
+func NewRemotePrivValidator(
+	listenAddr string,
+	chainID string,
+	logger log.Logger,
+) (types.PrivValidator, error) {
+	endpoint, err := privval.NewSignerListener(listenAddr, logger)
+	if err != nil {
+		return nil, fmt.Errorf("failed to listen for remote signer: %w", err)
+	}
+	client, err := privval.NewSignerClient(endpoint, chainID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start remote signer client: %w", err)
+	}
+	// Make sure the remote signer holds a key before going further.
+	if _, err = client.GetPubKey(); err != nil {
+		return nil, fmt.Errorf("failed to get remote signer pubkey: %w", err)
+	}
+	return privval.NewRetrySignerClient(
+		client, remoteSignerRetries, remoteSignerRetryTimeout,
+	), nil
+}
@@ -24,8 +24,10 @@ import (
 	"fmt"
 
 	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/primitives/common"
 	"github.com/berachain/beacon-kit/primitives/constants"
 	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/math"
 	"github.com/cometbft/cometbft/crypto/bls12381"
 	"github.com/cometbft/cometbft/privval"
 	"github.com/cometbft/cometbft/types"
@@ -65,7 +67,35 @@ func (f BLSSigner) PublicKey() crypto.BLSPubkey {
 
 // Sign generates a signature for a given message using the signer's secret key.
 func (f BLSSigner) Sign(msg []byte) (crypto.BLSSignature, error) {
-	sig, err := f.PrivValidator.SignBytes(msg)
+	return toBLSSignature(f.PrivValidator.SignBytes(msg))
+}
+
+// blockSigner is a PrivValidator guarding against signing conflicting beacon
+// blocks, such as SlashingProtection.
+type blockSigner interface {
+	SignBlock(
+		slot math.Slot, domain common.Domain, signingRoot common.Root,
+	) ([]byte, error)
+}
+
+// SignBlock signs the signing root of the beacon block at the given slot in
+// the given domain, through the slashing protection of the PrivValidator if
+// it has one.
+func (f BLSSigner) SignBlock(
+	slot math.Slot,
+	domain common.Domain,
+	signingRoot common.Root,
+) (crypto.BLSSignature, error) {
+	bs, ok := f.PrivValidator.(blockSigner)
+	if !ok {
+		return f.Sign(signingRoot[:])
+	}
+	return toBLSSignature(bs.SignBlock(slot, domain, signingRoot))
+}
+
+// toBLSSignature checks the length of the signature returned by the
+// PrivValidator.
+func toBLSSignature(sig []byte, err error) (crypto.BLSSignature, error) {
 	if err != nil {
 		return crypto.BLSSignature{}, err
 	} else if len(sig) != constants.BLSSignatureLength {
@@ -27,6 +27,7 @@ import (
 	"github.com/berachain/beacon-kit/config"
 	"github.com/berachain/beacon-kit/log"
 	"github.com/berachain/beacon-kit/node-core/components/metrics"
+	"github.com/berachain/beacon-kit/payload/relay"
 	"github.com/berachain/beacon-kit/primitives/crypto"
 )
 
@@ -41,6 +42,7 @@ type ValidatorServiceInput[
 	ChainSpec      chain.ChainSpec
 	LocalBuilder   LocalBuilder
 	Logger         LoggerT
+	RelayClient    *relay.Client
 	StateProcessor StateProcessor[*Context]
 	StorageBackend StorageBackendT
 	Signer         crypto.BLSSigner
@@ -77,6 +79,8 @@ func ProvideValidatorService[
 		[]validator.PayloadBuilder{
 			in.LocalBuilder,
 		},
+		&in.Cfg.Relay,
+		in.RelayClient,
 		in.TelemetrySink,
 	), nil
 }
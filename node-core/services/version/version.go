@@ -133,6 +133,14 @@ func (rs *ReportingService) Start(ctx context.Context) error {
 	return nil
 }
 
+// NodeVersion returns the version of the running node, along with the system
+// it runs on, as reported to Beacon API clients.
+func (rs *ReportingService) NodeVersion() string {
+	return fmt.Sprintf(
+		"BeaconKit/%s (%s/%s)", rs.version, runtime.GOOS, runtime.GOARCH,
+	)
+}
+
 func (rs *ReportingService) Stop() error {
 	return nil
 }
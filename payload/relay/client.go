@@ -0,0 +1,303 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package relay
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/berachain/beacon-kit/chain-spec/chain"
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/version"
+)
+
+const (
+	// headerConsensusVersion is the header carrying the fork version of
+	// versioned requests.
+	headerConsensusVersion = "Eth-Consensus-Version"
+	// maxResponseSize bounds the size of the relay responses, which carry at
+	// most a payload and its blobs.
+	maxResponseSize = 64 << 20
+)
+
+// Client requests execution payloads from external block builders through a
+// relay implementing the builder API.
+// https://github.com/ethereum/builder-specs
+type Client struct {
+	// cfg is the relay configuration.
+	cfg *Config
+	// chainSpec is the chain spec.
+	chainSpec chain.ChainSpec
+	// signer signs the validator registrations.
+	signer crypto.BLSSigner
+	// feeRecipient is the fee recipient registered with the relay.
+	feeRecipient common.ExecutionAddress
+	// baseURL is the relay URL, stripped of the relay public key.
+	baseURL *url.URL
+	// relayPubkey is the public key of the relay, if set in its URL.
+	relayPubkey *crypto.BLSPubkey
+	// domain is the domain of the builder API signatures.
+	domain common.Domain
+	// httpClient sends the requests to the relay.
+	httpClient *http.Client
+}
+
+// New returns a new relay Client. The relay URL is only parsed if the relay
+// is enabled.
+func New(
+	cfg *Config,
+	chainSpec chain.ChainSpec,
+	signer crypto.BLSSigner,
+	feeRecipient common.ExecutionAddress,
+) (*Client, error) {
+	c := &Client{
+		cfg:          cfg,
+		chainSpec:    chainSpec,
+		signer:       signer,
+		feeRecipient: feeRecipient,
+		domain:       ComputeBuilderDomain(chainSpec),
+		httpClient:   &http.Client{},
+	}
+	if !cfg.Enabled {
+		return c, nil
+	}
+
+	baseURL, err := url.Parse(cfg.URL)
+	if err != nil {
+		return nil, errors.Join(ErrInvalidURL, err)
+	}
+	if baseURL.Scheme != "http" && baseURL.Scheme != "https" {
+		return nil, errors.Wrapf(ErrInvalidURL, "scheme %q", baseURL.Scheme)
+	}
+	if user := baseURL.User.Username(); user != "" {
+		var pubkey crypto.BLSPubkey
+		if err = pubkey.UnmarshalText([]byte(user)); err != nil {
+			return nil, errors.Join(ErrInvalidURL, err)
+		}
+		c.relayPubkey = &pubkey
+		baseURL.User = nil
+	}
+	c.baseURL = baseURL
+	return c, nil
+}
+
+// ComputeBuilderDomain returns the domain of the builder API signatures,
+// which are valid across forks.
+// https://github.com/ethereum/builder-specs/blob/main/specs/bellatrix/builder.md#signing
+func ComputeBuilderDomain(chainSpec chain.ChainSpec) common.Domain {
+	return ctypes.NewForkData(
+		version.FromUint32[common.Version](
+			chainSpec.ActiveForkVersionForEpoch(0),
+		),
+		common.Root{},
+	).ComputeDomain(chainSpec.DomainTypeApplicationMask())
+}
+
+// Enabled returns whether payloads are requested from the relay.
+func (c *Client) Enabled() bool {
+	return c.cfg.Enabled
+}
+
+// Status checks that the relay is up.
+func (c *Client) Status(ctx context.Context) error {
+	_, err := c.do(
+		ctx, http.MethodGet, "/eth/v1/builder/status", "", nil, nil,
+	)
+	return err
+}
+
+// RegisterValidator registers the validator fee recipient and gas limit with
+// the relay.
+func (c *Client) RegisterValidator(ctx context.Context) error {
+	registration := &ValidatorRegistration{
+		FeeRecipient: c.feeRecipient,
+		GasLimit:     math.U64(c.cfg.GasLimit),
+		//#nosec:G115 // unix time is positive.
+		Timestamp: math.U64(time.Now().Unix()),
+		Pubkey:    c.signer.PublicKey(),
+	}
+	signingRoot := ctypes.ComputeSigningRoot(registration, c.domain)
+	signature, err := c.signer.Sign(signingRoot[:])
+	if err != nil {
+		return fmt.Errorf("failed signing validator registration: %w", err)
+	}
+
+	_, err = c.do(
+		ctx, http.MethodPost, "/eth/v1/builder/validators", "",
+		[]*SignedValidatorRegistration{{
+			Message:   registration,
+			Signature: signature,
+		}},
+		nil,
+	)
+	return err
+}
+
+// GetHeader requests the best bid for the payload of the given slot built on
+// top of the given parent. It returns ErrNoBid if the relay has none. The bid
+// signature is verified, and so is its signer if the relay public key is set.
+func (c *Client) GetHeader(
+	ctx context.Context,
+	slot math.Slot,
+	parentHash common.ExecutionHash,
+	pubkey crypto.BLSPubkey,
+) (*BuilderBid, error) {
+	var res versionedResponse[*SignedBuilderBid]
+	status, err := c.do(
+		ctx,
+		http.MethodGet,
+		fmt.Sprintf(
+			"/eth/v1/builder/header/%s/%s/%s",
+			slot.Base10(), parentHash.Hex(), pubkey.String(),
+		),
+		"",
+		nil,
+		&res,
+	)
+	switch {
+	case err != nil:
+		return nil, err
+	case status == http.StatusNoContent:
+		return nil, ErrNoBid
+	case res.Data == nil || res.Data.Message == nil ||
+		res.Data.Message.Header == nil || res.Data.Message.Value == nil:
+		return nil, ErrNilBid
+	}
+
+	bid := res.Data.Message
+	if bid.Header.ParentHash != parentHash {
+		return nil, errors.Wrapf(
+			ErrUnexpectedParentHash, "bid on top of %s", bid.Header.ParentHash,
+		)
+	}
+	if c.relayPubkey != nil && *c.relayPubkey != bid.Pubkey {
+		return nil, errors.Wrapf(
+			ErrUnexpectedBidPubkey, "bid signed by %s", bid.Pubkey,
+		)
+	}
+	signingRoot := ctypes.ComputeSigningRoot(bid, c.domain)
+	if err = c.signer.VerifySignature(
+		bid.Pubkey, signingRoot[:], res.Data.Signature,
+	); err != nil {
+		return nil, errors.Join(ErrInvalidBidSignature, err)
+	}
+	return bid, nil
+}
+
+// SubmitBlindedBlock submits the signed blinded block to the relay, which
+// reveals the payload committed to in exchange. The payload is checked
+// against the header of the blinded block.
+func (c *Client) SubmitBlindedBlock(
+	ctx context.Context,
+	blk *SignedBlindedBeaconBlock,
+) (*ExecutionPayloadAndBlobsBundle, error) {
+	var res versionedResponse[*ExecutionPayloadAndBlobsBundle]
+	if _, err := c.do(
+		ctx,
+		http.MethodPost,
+		"/eth/v1/builder/blinded_blocks",
+		version.Name(c.chainSpec.ActiveForkVersionForSlot(blk.Message.Slot)),
+		blk,
+		&res,
+	); err != nil {
+		return nil, err
+	}
+	if res.Data == nil || res.Data.ExecutionPayload == nil ||
+		res.Data.BlobsBundle == nil {
+		return nil, ErrNilPayload
+	}
+	if _, err := blk.Message.Unblind(res.Data.ExecutionPayload); err != nil {
+		return nil, err
+	}
+	return res.Data, nil
+}
+
+// do sends a request to the relay, within the configured timeout, and decodes
+// its JSON response in out, if any. It returns the response status code, which
+// is either 200 or 204.
+func (c *Client) do(
+	ctx context.Context,
+	method, path, consensusVersion string,
+	in, out any,
+) (int, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.cfg.Timeout)
+	defer cancel()
+
+	var body io.Reader
+	if in != nil {
+		bz, err := json.Marshal(in)
+		if err != nil {
+			return 0, err
+		}
+		body = bytes.NewReader(bz)
+	}
+	req, err := http.NewRequestWithContext(
+		ctx, method, c.baseURL.JoinPath(path).String(), body,
+	)
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Accept", "application/json")
+	if in != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if consensusVersion != "" {
+		req.Header.Set(headerConsensusVersion, consensusVersion)
+	}
+
+	res, err := c.httpClient.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer res.Body.Close()
+	reader := io.LimitReader(res.Body, maxResponseSize)
+
+	switch res.StatusCode {
+	case http.StatusOK:
+		if out == nil {
+			return res.StatusCode, nil
+		}
+		return res.StatusCode, json.NewDecoder(reader).Decode(out)
+	case http.StatusNoContent:
+		return res.StatusCode, nil
+	default:
+		var errRes errorResponse
+		bz, _ := io.ReadAll(reader)
+		if json.Unmarshal(bz, &errRes) != nil || errRes.Message == "" {
+			errRes.Message = strings.TrimSpace(string(bz))
+		}
+		return res.StatusCode, errors.Wrapf(
+			ErrUnexpectedStatus, "%s %s: %d %s",
+			method, path, res.StatusCode, errRes.Message,
+		)
+	}
+}
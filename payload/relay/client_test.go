@@ -0,0 +1,272 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package relay_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/config/spec"
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
+	"github.com/berachain/beacon-kit/payload/relay"
+	mockrelay "github.com/berachain/beacon-kit/payload/relay/mock"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/crypto/mocks"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	parentHash   = common.ExecutionHash{0xaa}
+	feeRecipient = common.ExecutionAddress{0xbb}
+)
+
+// fakeSign returns a deterministic stand-in for the BLS signature of msg by
+// pubkey, since BLS is only available with the bls12381 build tag.
+func fakeSign(pubkey crypto.BLSPubkey, msg []byte) crypto.BLSSignature {
+	var signature crypto.BLSSignature
+	digest := sha256.Sum256(append(pubkey[:], msg...))
+	copy(signature[:], digest[:])
+	return signature
+}
+
+// newSigner returns a signer with a random public key, whose signatures only
+// verify against that key.
+func newSigner(t *testing.T) *mocks.BLSSigner {
+	t.Helper()
+	var pubkey crypto.BLSPubkey
+	digest := sha256.Sum256([]byte(t.Name() + time.Now().String()))
+	copy(pubkey[:], digest[:])
+
+	s := mocks.NewBLSSigner(t)
+	s.EXPECT().PublicKey().Return(pubkey).Maybe()
+	s.EXPECT().Sign(mock.Anything).RunAndReturn(
+		func(msg []byte) (crypto.BLSSignature, error) {
+			return fakeSign(pubkey, msg), nil
+		},
+	).Maybe()
+	s.EXPECT().VerifySignature(mock.Anything, mock.Anything, mock.Anything).
+		RunAndReturn(func(
+			pk crypto.BLSPubkey, msg []byte, signature crypto.BLSSignature,
+		) error {
+			if fakeSign(pk, msg) != signature {
+				return errors.New("invalid signature")
+			}
+			return nil
+		}).Maybe()
+	return s
+}
+
+func newPayload() *ctypes.ExecutionPayload {
+	return &ctypes.ExecutionPayload{
+		ParentHash:   parentHash,
+		FeeRecipient: feeRecipient,
+		Number:       10,
+		GasLimit:     30_000_000,
+		Timestamp:    100,
+		ExtraData:    []byte("builder"),
+		Transactions: [][]byte{[]byte("tx1"), []byte("tx2")},
+		Withdrawals: engineprimitives.Withdrawals{
+			{Index: 0, Validator: 1, Address: feeRecipient, Amount: 10},
+		},
+		BaseFeePerGas: math.NewU256(7),
+		BlockHash:     common.ExecutionHash{0xcc},
+	}
+}
+
+// setupRelay returns a relay client for a new validator, connected to a mock
+// relay, and the mock relay itself.
+func setupRelay(
+	t *testing.T,
+	relayURL func(srvURL string, builder *mocks.BLSSigner) string,
+) (*relay.Client, *mockrelay.Relay, *mocks.BLSSigner) {
+	t.Helper()
+	cs, err := spec.DevnetChainSpec()
+	require.NoError(t, err)
+
+	builder := newSigner(t)
+	mockRelay := mockrelay.New(builder, cs)
+	srv := httptest.NewServer(mockRelay)
+	t.Cleanup(srv.Close)
+
+	cfg := relay.DefaultConfig()
+	cfg.Enabled = true
+	cfg.URL = srv.URL
+	if relayURL != nil {
+		cfg.URL = relayURL(srv.URL, builder)
+	}
+	cfg.Timeout = time.Second
+	validator := newSigner(t)
+	client, err := relay.New(&cfg, cs, validator, feeRecipient)
+	require.NoError(t, err)
+	return client, mockRelay, validator
+}
+
+func TestRegisterValidator(t *testing.T) {
+	client, mockRelay, validator := setupRelay(t, nil)
+	require.True(t, client.Enabled())
+	require.NoError(t, client.Status(context.Background()))
+
+	require.NoError(t, client.RegisterValidator(context.Background()))
+	registration, ok := mockRelay.Registration(validator.PublicKey())
+	require.True(t, ok)
+	require.Equal(t, feeRecipient, registration.FeeRecipient)
+	require.Equal(
+		t, math.U64(relay.DefaultConfig().GasLimit), registration.GasLimit,
+	)
+}
+
+func TestGetHeaderNoBid(t *testing.T) {
+	client, mockRelay, validator := setupRelay(t, nil)
+	ctx := context.Background()
+
+	// unregistered validators get no bid
+	mockRelay.SetPayload(newPayload(), &relay.BlobsBundle{}, math.NewU256(1))
+	_, err := client.GetHeader(ctx, 1, parentHash, validator.PublicKey())
+	require.ErrorIs(t, err, relay.ErrNoBid)
+
+	// nor do registered ones building on top of another parent
+	require.NoError(t, client.RegisterValidator(ctx))
+	_, err = client.GetHeader(
+		ctx, 1, common.ExecutionHash{0x01}, validator.PublicKey(),
+	)
+	require.ErrorIs(t, err, relay.ErrNoBid)
+}
+
+func TestBuilderFlow(t *testing.T) {
+	client, mockRelay, validator := setupRelay(t, nil)
+	ctx := context.Background()
+	require.NoError(t, client.RegisterValidator(ctx))
+
+	payload := newPayload()
+	mockRelay.SetPayload(payload, &relay.BlobsBundle{}, math.NewU256(1e18))
+
+	bid, err := client.GetHeader(ctx, 1, parentHash, validator.PublicKey())
+	require.NoError(t, err)
+	header, err := payload.ToHeader()
+	require.NoError(t, err)
+	require.Equal(t, header.HashTreeRoot(), bid.Header.HashTreeRoot())
+	require.Equal(t, math.NewU256(1e18), bid.Value)
+
+	blk := &ctypes.BeaconBlock{
+		Slot: 1,
+		Body: &ctypes.BeaconBlockBody{Eth1Data: &ctypes.Eth1Data{}},
+	}
+	blinded := ctypes.NewBlindedBeaconBlock(blk, bid.Header)
+	revealed, err := client.SubmitBlindedBlock(
+		ctx, &relay.SignedBlindedBeaconBlock{Message: blinded},
+	)
+	require.NoError(t, err)
+	require.Equal(
+		t, payload.HashTreeRoot(), revealed.ExecutionPayload.HashTreeRoot(),
+	)
+	require.Len(t, mockRelay.SubmittedBlocks(), 1)
+
+	// the relay does not reveal payloads other than the one it offered
+	blinded.Body.ExecutionPayloadHeader.GasUsed++
+	_, err = client.SubmitBlindedBlock(
+		ctx, &relay.SignedBlindedBeaconBlock{Message: blinded},
+	)
+	require.ErrorIs(t, err, relay.ErrUnexpectedStatus)
+}
+
+func TestGetHeaderRelayPubkey(t *testing.T) {
+	withPubkey := func(pubkey string) func(string, *mocks.BLSSigner) string {
+		return func(srvURL string, builder *mocks.BLSSigner) string {
+			u, err := url.Parse(srvURL)
+			require.NoError(t, err)
+			if pubkey == "" {
+				pubkey = builder.PublicKey().String()
+			}
+			u.User = url.User(pubkey)
+			return u.String()
+		}
+	}
+	ctx := context.Background()
+
+	// bids signed by the relay are accepted
+	client, mockRelay, validator := setupRelay(t, withPubkey(""))
+	require.NoError(t, client.RegisterValidator(ctx))
+	mockRelay.SetPayload(newPayload(), &relay.BlobsBundle{}, math.NewU256(1))
+	_, err := client.GetHeader(ctx, 1, parentHash, validator.PublicKey())
+	require.NoError(t, err)
+
+	// bids signed by anyone else are not
+	client, mockRelay, validator = setupRelay(
+		t, withPubkey(newSigner(t).PublicKey().String()),
+	)
+	require.NoError(t, client.RegisterValidator(ctx))
+	mockRelay.SetPayload(newPayload(), &relay.BlobsBundle{}, math.NewU256(1))
+	_, err = client.GetHeader(ctx, 1, parentHash, validator.PublicKey())
+	require.ErrorIs(t, err, relay.ErrUnexpectedBidPubkey)
+}
+
+func TestClientTimeout(t *testing.T) {
+	cs, err := spec.DevnetChainSpec()
+	require.NoError(t, err)
+	srv := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			<-r.Context().Done()
+			w.WriteHeader(http.StatusNoContent)
+		},
+	))
+	t.Cleanup(srv.Close)
+
+	cfg := relay.DefaultConfig()
+	cfg.Enabled = true
+	cfg.URL = srv.URL
+	cfg.Timeout = 10 * time.Millisecond
+	validator := newSigner(t)
+	client, err := relay.New(&cfg, cs, validator, feeRecipient)
+	require.NoError(t, err)
+
+	_, err = client.GetHeader(
+		context.Background(), 1, parentHash, validator.PublicKey(),
+	)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestNewInvalidURL(t *testing.T) {
+	cs, err := spec.DevnetChainSpec()
+	require.NoError(t, err)
+
+	cfg := relay.DefaultConfig()
+	cfg.URL = "ftp://relay"
+	_, err = relay.New(&cfg, cs, newSigner(t), feeRecipient)
+	require.NoError(t, err) // the URL is not parsed if the relay is disabled
+
+	cfg.Enabled = true
+	_, err = relay.New(&cfg, cs, newSigner(t), feeRecipient)
+	require.ErrorIs(t, err, relay.ErrInvalidURL)
+
+	cfg.URL = "http://0x1234@relay"
+	_, err = relay.New(&cfg, cs, newSigner(t), feeRecipient)
+	require.ErrorIs(t, err, relay.ErrInvalidURL)
+}
@@ -0,0 +1,60 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package relay
+
+import "time"
+
+const (
+	// defaultTimeout is the default timeout of requests to the relay.
+	defaultTimeout = 800 * time.Millisecond
+	// defaultGasLimit is the default gas limit registered with the relay.
+	defaultGasLimit = 30_000_000
+	// defaultRegistrationInterval is the default interval between
+	// registrations of the validator with the relay.
+	defaultRegistrationInterval = 5 * time.Minute
+)
+
+type Config struct {
+	// Enabled determines if payloads are requested from the relay.
+	Enabled bool `mapstructure:"enabled"`
+	// URL is the URL of the relay. The relay public key, if set as the user
+	// of the URL, is checked against the key signing bids.
+	URL string `mapstructure:"url"`
+	// Timeout is the timeout of requests to the relay. Past it, the payload
+	// built by the local builder is proposed. It must leave time to build the
+	// block within timeout_proposal in the CometBFT configuration.
+	Timeout time.Duration `mapstructure:"timeout"`
+	// GasLimit is the gas limit the validator registers with the relay.
+	GasLimit uint64 `mapstructure:"gas-limit"`
+	// RegistrationInterval is the interval between registrations of the
+	// validator with the relay.
+	RegistrationInterval time.Duration `mapstructure:"registration-interval"`
+}
+
+func DefaultConfig() Config {
+	return Config{
+		Enabled:              false,
+		URL:                  "",
+		Timeout:              defaultTimeout,
+		GasLimit:             defaultGasLimit,
+		RegistrationInterval: defaultRegistrationInterval,
+	}
+}
@@ -0,0 +1,53 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package relay
+
+import "github.com/berachain/beacon-kit/errors"
+
+var (
+	// ErrNoBid is returned when the relay has no bid for the requested slot.
+	ErrNoBid = errors.New("relay has no bid")
+
+	// ErrInvalidURL is returned when the relay URL can not be parsed.
+	ErrInvalidURL = errors.New("invalid relay url")
+
+	// ErrUnexpectedStatus is returned when the relay replies with a status
+	// code other than the expected one.
+	ErrUnexpectedStatus = errors.New("unexpected relay response status")
+
+	// ErrUnexpectedParentHash is returned when a bid is built on top of a
+	// parent other than the requested one.
+	ErrUnexpectedParentHash = errors.New("bid on top of unexpected parent")
+
+	// ErrUnexpectedBidPubkey is returned when a bid is signed by a key other
+	// than the relay one.
+	ErrUnexpectedBidPubkey = errors.New("bid not signed by the relay")
+
+	// ErrInvalidBidSignature is returned when the signature of a bid does not
+	// verify.
+	ErrInvalidBidSignature = errors.New("invalid bid signature")
+
+	// ErrNilBid is returned when the relay replies with an empty bid.
+	ErrNilBid = errors.New("nil bid")
+
+	// ErrNilPayload is returned when the relay replies with an empty payload.
+	ErrNilPayload = errors.New("nil payload")
+)
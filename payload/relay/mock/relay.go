@@ -0,0 +1,242 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+// Package mock provides a relay offering a preset payload, to exercise the
+// external block builder flow without an actual builder network.
+package mock
+
+import (
+	"encoding/json"
+	"net/http"
+	"sync"
+
+	"github.com/berachain/beacon-kit/chain-spec/chain"
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/payload/relay"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/version"
+)
+
+// Relay is a builder API relay offering a preset payload to the registered
+// validators. It implements http.Handler.
+type Relay struct {
+	// signer signs the bids.
+	signer crypto.BLSSigner
+	// domain is the domain of the builder API signatures.
+	domain common.Domain
+	// mux routes the builder API requests.
+	mux *http.ServeMux
+
+	mu            sync.Mutex
+	registrations map[crypto.BLSPubkey]*relay.ValidatorRegistration
+	payload       *ctypes.ExecutionPayload
+	blobsBundle   *relay.BlobsBundle
+	value         *math.U256
+	submitted     []*relay.SignedBlindedBeaconBlock
+}
+
+// New returns a new Relay signing bids with the given signer.
+func New(signer crypto.BLSSigner, chainSpec chain.ChainSpec) *Relay {
+	r := &Relay{
+		signer:        signer,
+		domain:        relay.ComputeBuilderDomain(chainSpec),
+		mux:           http.NewServeMux(),
+		registrations: make(map[crypto.BLSPubkey]*relay.ValidatorRegistration),
+	}
+	r.mux.HandleFunc("GET /eth/v1/builder/status", r.status)
+	r.mux.HandleFunc("POST /eth/v1/builder/validators", r.registerValidators)
+	r.mux.HandleFunc(
+		"GET /eth/v1/builder/header/{slot}/{parent_hash}/{pubkey}",
+		r.getHeader,
+	)
+	r.mux.HandleFunc(
+		"POST /eth/v1/builder/blinded_blocks", r.submitBlindedBlock,
+	)
+	return r
+}
+
+// SetPayload sets the payload offered, along with its blobs and value.
+func (r *Relay) SetPayload(
+	payload *ctypes.ExecutionPayload,
+	blobsBundle *relay.BlobsBundle,
+	value *math.U256,
+) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.payload, r.blobsBundle, r.value = payload, blobsBundle, value
+}
+
+// Registration returns the registration of the given validator, if any.
+func (r *Relay) Registration(
+	pubkey crypto.BLSPubkey,
+) (*relay.ValidatorRegistration, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	registration, ok := r.registrations[pubkey]
+	return registration, ok
+}
+
+// SubmittedBlocks returns the blinded blocks submitted so far.
+func (r *Relay) SubmittedBlocks() []*relay.SignedBlindedBeaconBlock {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]*relay.SignedBlindedBeaconBlock(nil), r.submitted...)
+}
+
+// ServeHTTP serves the builder API.
+func (r *Relay) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	r.mux.ServeHTTP(w, req)
+}
+
+func (*Relay) status(w http.ResponseWriter, _ *http.Request) {
+	w.WriteHeader(http.StatusOK)
+}
+
+// registerValidators stores the registrations whose signature verifies.
+func (r *Relay) registerValidators(w http.ResponseWriter, req *http.Request) {
+	var registrations []*relay.SignedValidatorRegistration
+	if err := json.NewDecoder(req.Body).Decode(&registrations); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, registration := range registrations {
+		if registration == nil || registration.Message == nil {
+			writeError(w, http.StatusBadRequest, "nil registration")
+			return
+		}
+		signingRoot := ctypes.ComputeSigningRoot(registration.Message, r.domain)
+		if err := r.signer.VerifySignature(
+			registration.Message.Pubkey,
+			signingRoot[:],
+			registration.Signature,
+		); err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		r.registrations[registration.Message.Pubkey] = registration.Message
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// getHeader bids for the preset payload, provided that it builds on top of
+// the requested parent and that the proposer is registered.
+func (r *Relay) getHeader(w http.ResponseWriter, req *http.Request) {
+	var (
+		parentHash common.ExecutionHash
+		pubkey     crypto.BLSPubkey
+	)
+	if err := parentHash.UnmarshalText(
+		[]byte(req.PathValue("parent_hash")),
+	); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if err := pubkey.UnmarshalText([]byte(req.PathValue("pubkey"))); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	_, registered := r.registrations[pubkey]
+	if !registered || r.payload == nil || r.payload.ParentHash != parentHash {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	header, err := r.payload.ToHeader()
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	bid := &relay.BuilderBid{
+		Header:             header,
+		BlobKzgCommitments: r.blobsBundle.GetCommitments(),
+		Value:              r.value,
+		Pubkey:             r.signer.PublicKey(),
+	}
+	signingRoot := ctypes.ComputeSigningRoot(bid, r.domain)
+	signature, err := r.signer.Sign(signingRoot[:])
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	writeData(w, &relay.SignedBuilderBid{Message: bid, Signature: signature})
+}
+
+// submitBlindedBlock reveals the preset payload in exchange of a blinded
+// block committing to it.
+func (r *Relay) submitBlindedBlock(w http.ResponseWriter, req *http.Request) {
+	var blk relay.SignedBlindedBeaconBlock
+	if err := json.NewDecoder(req.Body).Decode(&blk); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if blk.Message == nil || blk.Message.Body == nil {
+		writeError(w, http.StatusBadRequest, "nil blinded block")
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.payload == nil {
+		writeError(w, http.StatusBadRequest, "no payload offered")
+		return
+	}
+	if _, err := blk.Message.Unblind(r.payload); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	r.submitted = append(r.submitted, &blk)
+	writeData(w, &relay.ExecutionPayloadAndBlobsBundle{
+		ExecutionPayload: r.payload,
+		BlobsBundle:      r.blobsBundle,
+	})
+}
+
+func writeData(w http.ResponseWriter, data any) {
+	w.Header().Set("Content-Type", "application/json")
+	//#nosec:G104 // the response is best effort.
+	_ = json.NewEncoder(w).Encode(struct {
+		Version string `json:"version"`
+		Data    any    `json:"data"`
+	}{
+		Version: version.Name(version.Deneb),
+		Data:    data,
+	})
+}
+
+func writeError(w http.ResponseWriter, code int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	//#nosec:G104 // the response is best effort.
+	_ = json.NewEncoder(w).Encode(struct {
+		Code    int    `json:"code"`
+		Message string `json:"message"`
+	}{
+		Code:    code,
+		Message: message,
+	})
+}
@@ -0,0 +1,159 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package relay
+
+import (
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/eip4844"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/karalabe/ssz"
+)
+
+// maxBlobCommitmentsPerBid is the maximum number of KZG commitments in a bid,
+// as in the block body.
+const maxBlobCommitmentsPerBid = 16
+
+// BlobsBundle is the blobs bundle revealed along with the execution payload.
+type BlobsBundle = engineprimitives.BlobsBundleV1[
+	eip4844.KZGCommitment, eip4844.KZGProof, eip4844.Blob,
+]
+
+// ValidatorRegistration is the message a validator signs to register its
+// preferences for the payloads built on its behalf.
+// https://github.com/ethereum/builder-specs/blob/main/specs/bellatrix/builder.md#validatorregistrationv1
+type ValidatorRegistration struct {
+	// FeeRecipient is the address receiving the fees of the payload.
+	FeeRecipient common.ExecutionAddress `json:"fee_recipient"`
+	// GasLimit is the gas limit of the payload.
+	GasLimit math.U64 `json:"gas_limit"`
+	// Timestamp is the unix time of the registration.
+	Timestamp math.U64 `json:"timestamp"`
+	// Pubkey is the public key of the validator.
+	Pubkey crypto.BLSPubkey `json:"pubkey"`
+}
+
+// SizeSSZ returns the size of the ValidatorRegistration in SSZ.
+func (*ValidatorRegistration) SizeSSZ(*ssz.Sizer) uint32 {
+	//nolint:mnd // fee recipient, gas limit, timestamp and pubkey.
+	return 20 + 8 + 8 + 48
+}
+
+// DefineSSZ defines the SSZ serialization of the ValidatorRegistration.
+func (r *ValidatorRegistration) DefineSSZ(codec *ssz.Codec) {
+	ssz.DefineStaticBytes(codec, &r.FeeRecipient)
+	ssz.DefineUint64(codec, &r.GasLimit)
+	ssz.DefineUint64(codec, &r.Timestamp)
+	ssz.DefineStaticBytes(codec, &r.Pubkey)
+}
+
+// HashTreeRoot returns the SSZ hash tree root of the ValidatorRegistration.
+func (r *ValidatorRegistration) HashTreeRoot() common.Root {
+	return ssz.HashSequential(r)
+}
+
+// SignedValidatorRegistration is a validator registration signed by the
+// validator.
+type SignedValidatorRegistration struct {
+	Message   *ValidatorRegistration `json:"message"`
+	Signature crypto.BLSSignature    `json:"signature"`
+}
+
+// BuilderBid is the offer of a builder to provide the payload of a block.
+// https://github.com/ethereum/builder-specs/blob/main/specs/deneb/builder.md#builderbid
+type BuilderBid struct {
+	// Header is the header of the offered payload.
+	Header *ctypes.ExecutionPayloadHeader `json:"header"`
+	// BlobKzgCommitments are the commitments to the blobs of the payload.
+	BlobKzgCommitments []eip4844.KZGCommitment `json:"blob_kzg_commitments"`
+	// Value is the value in Wei paid to the proposer.
+	Value *math.U256 `json:"value"`
+	// Pubkey is the public key of the bid signer.
+	Pubkey crypto.BLSPubkey `json:"pubkey"`
+}
+
+// SizeSSZ returns the size of the BuilderBid in SSZ.
+func (b *BuilderBid) SizeSSZ(siz *ssz.Sizer, fixed bool) uint32 {
+	//nolint:mnd // header and commitments offsets, value and pubkey.
+	var size uint32 = 4 + 4 + 32 + 48
+	if fixed {
+		return size
+	}
+	size += ssz.SizeDynamicObject(siz, b.Header)
+	size += ssz.SizeSliceOfStaticBytes(siz, b.BlobKzgCommitments)
+	return size
+}
+
+// DefineSSZ defines the SSZ serialization of the BuilderBid.
+func (b *BuilderBid) DefineSSZ(codec *ssz.Codec) {
+	// Define the static data (fields and dynamic offsets)
+	ssz.DefineDynamicObjectOffset(codec, &b.Header)
+	ssz.DefineSliceOfStaticBytesOffset(
+		codec, &b.BlobKzgCommitments, maxBlobCommitmentsPerBid,
+	)
+	ssz.DefineUint256(codec, &b.Value)
+	ssz.DefineStaticBytes(codec, &b.Pubkey)
+
+	// Define the dynamic data (fields)
+	ssz.DefineDynamicObjectContent(codec, &b.Header)
+	ssz.DefineSliceOfStaticBytesContent(
+		codec, &b.BlobKzgCommitments, maxBlobCommitmentsPerBid,
+	)
+}
+
+// HashTreeRoot returns the SSZ hash tree root of the BuilderBid.
+func (b *BuilderBid) HashTreeRoot() common.Root {
+	return ssz.HashSequential(b)
+}
+
+// SignedBuilderBid is a builder bid signed by the builder.
+type SignedBuilderBid struct {
+	Message   *BuilderBid         `json:"message"`
+	Signature crypto.BLSSignature `json:"signature"`
+}
+
+// SignedBlindedBeaconBlock is a blinded beacon block signed by its proposer,
+// which commits the proposer to the payload of the bid.
+type SignedBlindedBeaconBlock struct {
+	Message   *ctypes.BlindedBeaconBlock `json:"message"`
+	Signature crypto.BLSSignature        `json:"signature"`
+}
+
+// ExecutionPayloadAndBlobsBundle is the payload revealed by the relay in
+// exchange of a signed blinded block.
+type ExecutionPayloadAndBlobsBundle struct {
+	ExecutionPayload *ctypes.ExecutionPayload `json:"execution_payload"`
+	BlobsBundle      *BlobsBundle             `json:"blobs_bundle"`
+}
+
+// versionedResponse is the envelope of the relay responses.
+type versionedResponse[T any] struct {
+	Version string `json:"version"`
+	Data    T      `json:"data"`
+}
+
+// errorResponse is the body of the relay error responses.
+type errorResponse struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
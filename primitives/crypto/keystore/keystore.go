@@ -0,0 +1,339 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keystore
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/berachain/beacon-kit/errors"
+	"golang.org/x/crypto/pbkdf2"
+	"golang.org/x/crypto/scrypt"
+	"golang.org/x/text/unicode/norm"
+)
+
+const (
+	// Version is the version of the EIP-2335 keystores.
+	Version = 4
+
+	kdfScrypt      = "scrypt"
+	kdfPBKDF2      = "pbkdf2"
+	prfHMACSHA256  = "hmac-sha256"
+	checksumSHA256 = "sha256"
+	cipherAES128   = "aes-128-ctr"
+
+	// The scrypt parameters recommended by EIP-2335.
+	scryptN     = 1 << 18
+	scryptR     = 8
+	scryptP     = 1
+	derivedKLen = 32
+	saltLen     = 32
+)
+
+// Keystore is a secret key encrypted with a password, as specified by
+// EIP-2335.
+type Keystore struct {
+	Crypto      Crypto `json:"crypto"`
+	Description string `json:"description"`
+	Pubkey      string `json:"pubkey"`
+	Path        string `json:"path"`
+	UUID        string `json:"uuid"`
+	Version     uint   `json:"version"`
+}
+
+// Crypto holds the modules used to encrypt the secret key.
+type Crypto struct {
+	KDF      Module `json:"kdf"`
+	Checksum Module `json:"checksum"`
+	Cipher   Module `json:"cipher"`
+}
+
+// Module is a cryptographic function, with its parameters and output.
+type Module struct {
+	Function string          `json:"function"`
+	Params   json.RawMessage `json:"params"`
+	Message  string          `json:"message"`
+}
+
+// kdfParams are the parameters of both the scrypt and pbkdf2 functions.
+type kdfParams struct {
+	DKLen int    `json:"dklen"`
+	Salt  string `json:"salt"`
+	// scrypt parameters.
+	N int `json:"n,omitempty"`
+	R int `json:"r,omitempty"`
+	P int `json:"p,omitempty"`
+	// pbkdf2 parameters.
+	C   int    `json:"c,omitempty"`
+	PRF string `json:"prf,omitempty"`
+}
+
+// cipherParams are the parameters of the aes-128-ctr function.
+type cipherParams struct {
+	IV string `json:"iv"`
+}
+
+// Encrypt encrypts the secret key with the password, deriving the encryption
+// key with scrypt. The public key is stored in clear, to identify the
+// keystore without decrypting it.
+func Encrypt(secret, pubkey []byte, password string) (*Keystore, error) {
+	salt, err := randomBytes(saltLen)
+	if err != nil {
+		return nil, err
+	}
+	iv, err := randomBytes(aes.BlockSize)
+	if err != nil {
+		return nil, err
+	}
+	id, err := newUUID()
+	if err != nil {
+		return nil, err
+	}
+
+	params := kdfParams{
+		DKLen: derivedKLen,
+		Salt:  hex.EncodeToString(salt),
+		N:     scryptN,
+		R:     scryptR,
+		P:     scryptP,
+	}
+	kdf := Module{Function: kdfScrypt}
+	if kdf.Params, err = json.Marshal(params); err != nil {
+		return nil, err
+	}
+	key, err := deriveKey(kdf, password)
+	if err != nil {
+		return nil, err
+	}
+	cipherText, err := aes128CTR(key[:16], iv, secret)
+	if err != nil {
+		return nil, err
+	}
+
+	ks := &Keystore{
+		Crypto: Crypto{
+			KDF: kdf,
+			Checksum: Module{
+				Function: checksumSHA256,
+				Params:   json.RawMessage("{}"),
+				Message:  hex.EncodeToString(checksum(key, cipherText)),
+			},
+			Cipher: Module{
+				Function: cipherAES128,
+				Message:  hex.EncodeToString(cipherText),
+			},
+		},
+		Pubkey:  hex.EncodeToString(pubkey),
+		UUID:    id,
+		Version: Version,
+	}
+	ks.Crypto.Cipher.Params, err = json.Marshal(
+		cipherParams{IV: hex.EncodeToString(iv)},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return ks, nil
+}
+
+// Decrypt returns the secret key of the keystore, provided that the password
+// is the one it was encrypted with.
+func (ks *Keystore) Decrypt(password string) ([]byte, error) {
+	if ks.Version != Version {
+		return nil, errors.Wrapf(ErrUnsupportedVersion, "%d", ks.Version)
+	}
+	if f := ks.Crypto.Checksum.Function; f != checksumSHA256 {
+		return nil, errors.Wrapf(ErrUnsupportedFunction, "checksum %s", f)
+	}
+	if f := ks.Crypto.Cipher.Function; f != cipherAES128 {
+		return nil, errors.Wrapf(ErrUnsupportedFunction, "cipher %s", f)
+	}
+
+	key, err := deriveKey(ks.Crypto.KDF, password)
+	if err != nil {
+		return nil, err
+	}
+	cipherText, err := hex.DecodeString(ks.Crypto.Cipher.Message)
+	if err != nil {
+		return nil, errors.Wrap(err, "cipher message")
+	}
+	expected, err := hex.DecodeString(ks.Crypto.Checksum.Message)
+	if err != nil {
+		return nil, errors.Wrap(err, "checksum message")
+	}
+	if subtle.ConstantTimeCompare(checksum(key, cipherText), expected) != 1 {
+		return nil, ErrInvalidPassword
+	}
+
+	var params cipherParams
+	if err = json.Unmarshal(ks.Crypto.Cipher.Params, &params); err != nil {
+		return nil, errors.Wrap(err, "cipher params")
+	}
+	iv, err := hex.DecodeString(params.IV)
+	if err != nil {
+		return nil, errors.Wrap(err, "cipher iv")
+	}
+	return aes128CTR(key[:16], iv, cipherText)
+}
+
+// Load reads the keystore at the given path.
+func Load(path string) (*Keystore, error) {
+	bz, err := os.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return nil, err
+	}
+	ks := new(Keystore)
+	if err = json.Unmarshal(bz, ks); err != nil {
+		return nil, errors.Wrapf(err, "reading keystore %s", path)
+	}
+	return ks, nil
+}
+
+// Save writes the keystore at the given path, readable by its owner only. It
+// refuses to overwrite an existing file.
+func (ks *Keystore) Save(path string) error {
+	bz, err := json.MarshalIndent(ks, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err = os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return err
+	}
+	f, err := os.OpenFile(
+		filepath.Clean(path), os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o600,
+	)
+	if err != nil {
+		return err
+	}
+	if _, err = f.Write(bz); err != nil {
+		return errors.Join(err, f.Close())
+	}
+	return f.Close()
+}
+
+// ReadPasswordFile reads a password from the given file, dropping the
+// trailing line break if any.
+func ReadPasswordFile(path string) (string, error) {
+	bz, err := os.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimRight(string(bz), "\r\n"), nil
+}
+
+// deriveKey derives the decryption key from the password with the KDF.
+func deriveKey(kdf Module, password string) ([]byte, error) {
+	var params kdfParams
+	if err := json.Unmarshal(kdf.Params, &params); err != nil {
+		return nil, errors.Wrap(err, "kdf params")
+	}
+	salt, err := hex.DecodeString(params.Salt)
+	if err != nil {
+		return nil, errors.Wrap(err, "kdf salt")
+	}
+	if params.DKLen < derivedKLen {
+		return nil, errors.Wrapf(ErrInvalidParams, "dklen %d", params.DKLen)
+	}
+
+	pass := processPassword(password)
+	switch kdf.Function {
+	case kdfScrypt:
+		return scrypt.Key(
+			pass, salt, params.N, params.R, params.P, params.DKLen,
+		)
+	case kdfPBKDF2:
+		if params.PRF != prfHMACSHA256 {
+			return nil, errors.Wrapf(
+				ErrUnsupportedFunction, "prf %s", params.PRF,
+			)
+		}
+		return pbkdf2.Key(
+			pass, salt, params.C, params.DKLen, sha256.New,
+		), nil
+	default:
+		return nil, errors.Wrapf(
+			ErrUnsupportedFunction, "kdf %s", kdf.Function,
+		)
+	}
+}
+
+// processPassword normalizes the password to NFKD and strips its control
+// codes, as specified by EIP-2335.
+func processPassword(password string) []byte {
+	return []byte(strings.Map(func(r rune) rune {
+		if r < 0x20 || (r >= 0x7f && r <= 0x9f) {
+			return -1
+		}
+		return r
+	}, norm.NFKD.String(password)))
+}
+
+// checksum is the checksum of the cipher text, proving the knowledge of the
+// decryption key.
+func checksum(key, cipherText []byte) []byte {
+	h := sha256.New()
+	h.Write(key[16:32])
+	h.Write(cipherText)
+	return h.Sum(nil)
+}
+
+// aes128CTR encrypts or decrypts the text with AES-128 in counter mode.
+func aes128CTR(key, iv, text []byte) ([]byte, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(iv) != aes.BlockSize {
+		return nil, errors.Wrapf(ErrInvalidParams, "iv length %d", len(iv))
+	}
+	out := make([]byte, len(text))
+	cipher.NewCTR(block, iv).XORKeyStream(out, text)
+	return out, nil
+}
+
+// newUUID returns a random version 4 UUID.
+func newUUID() (string, error) {
+	b, err := randomBytes(16) //nolint:mnd // 128 bits.
+	if err != nil {
+		return "", err
+	}
+	b[6] = (b[6] & 0x0f) | 0x40 //nolint:mnd // version 4.
+	b[8] = (b[8] & 0x3f) | 0x80 //nolint:mnd // RFC 4122 variant.
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[:4], b[4:6], b[6:8], b[8:10], b[10:]),
+		nil
+}
+
+func randomBytes(n int) ([]byte, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
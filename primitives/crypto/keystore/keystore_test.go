@@ -0,0 +1,150 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keystore_test
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"path/filepath"
+	"testing"
+
+	"github.com/berachain/beacon-kit/primitives/crypto/keystore"
+	"github.com/stretchr/testify/require"
+)
+
+// The test vectors of EIP-2335.
+const (
+	testPassword = "\U0001d531\U0001d522\U0001d530\U0001d531\U0001d52d" +
+		"\U0001d51e\U0001d530\U0001d530\U0001d534\U0001d52c\U0001d52f" +
+		"\U0001d521\U0001f511"
+	testSecret = "000000000019d6689c085ae165831e934ff763ae46a2a6c172b3f1b60a8ce26f"
+
+	scryptKeystore = `{
+    "crypto": {
+        "kdf": {
+            "function": "scrypt",
+            "params": {
+                "dklen": 32,
+                "n": 262144,
+                "p": 1,
+                "r": 8,
+                "salt": "d4e56740f876aef8c010b86a40d5f56745a118d0906a34e69aec8c0db1cb8fa3"
+            },
+            "message": ""
+        },
+        "checksum": {
+            "function": "sha256",
+            "params": {},
+            "message": "d2217fe5f3e9a1e34581ef8a78f7c9928e436d36dacc5e846690a5581e8ea484"
+        },
+        "cipher": {
+            "function": "aes-128-ctr",
+            "params": {
+                "iv": "264daa3f303d7259501c93d997d84fe6"
+            },
+            "message": "06ae90d55fe0a6e9c5c3bc5b170827b2e5cce3929ed3f116c2811e6366dfe20f"
+        }
+    },
+    "description": "This is a test keystore that uses scrypt to secure the secret.",
+    "pubkey": "9612d7a727c9d0a22e185a1c768478dfe919cada9266988cb32359c11f2b7b27f4ae4040902382ae2910c15e2b420d07",
+    "path": "m/12381/60/3141592653/589793238",
+    "uuid": "1d85ae20-35c5-4611-98e8-aa14a633906f",
+    "version": 4
+}`
+
+	pbkdf2Keystore = `{
+    "crypto": {
+        "kdf": {
+            "function": "pbkdf2",
+            "params": {
+                "dklen": 32,
+                "c": 262144,
+                "prf": "hmac-sha256",
+                "salt": "d4e56740f876aef8c010b86a40d5f56745a118d0906a34e69aec8c0db1cb8fa3"
+            },
+            "message": ""
+        },
+        "checksum": {
+            "function": "sha256",
+            "params": {},
+            "message": "8a9f5d9912ed7e75ea794bc5a89bca5f193721d30868ade6f73043c6ea6febf1"
+        },
+        "cipher": {
+            "function": "aes-128-ctr",
+            "params": {
+                "iv": "264daa3f303d7259501c93d997d84fe6"
+            },
+            "message": "cee03fde2af33149775b7223e7845e4fb2c8ae1792e5f99fe9ecf474cc8c16ad"
+        }
+    },
+    "description": "This is a test keystore that uses PBKDF2 to secure the secret.",
+    "pubkey": "9612d7a727c9d0a22e185a1c768478dfe919cada9266988cb32359c11f2b7b27f4ae4040902382ae2910c15e2b420d07",
+    "path": "m/12381/60/0/0",
+    "uuid": "64625def-3331-4eea-ab6f-782f3ed16a83",
+    "version": 4
+}`
+)
+
+func TestDecryptTestVectors(t *testing.T) {
+	for name, raw := range map[string]string{
+		"scrypt": scryptKeystore,
+		"pbkdf2": pbkdf2Keystore,
+	} {
+		t.Run(name, func(t *testing.T) {
+			var ks keystore.Keystore
+			require.NoError(t, json.Unmarshal([]byte(raw), &ks))
+
+			secret, err := ks.Decrypt(testPassword)
+			require.NoError(t, err)
+			require.Equal(t, testSecret, hex.EncodeToString(secret))
+
+			_, err = ks.Decrypt("wrong password")
+			require.ErrorIs(t, err, keystore.ErrInvalidPassword)
+		})
+	}
+}
+
+func TestEncryptSaveLoad(t *testing.T) {
+	secret, err := hex.DecodeString(testSecret)
+	require.NoError(t, err)
+	pubkey := []byte{0x96, 0x12}
+
+	ks, err := keystore.Encrypt(secret, pubkey, "password")
+	require.NoError(t, err)
+	require.Equal(t, uint(keystore.Version), ks.Version)
+	require.Equal(t, "9612", ks.Pubkey)
+
+	path := filepath.Join(t.TempDir(), "keystore.json")
+	require.NoError(t, ks.Save(path))
+	require.Error(t, ks.Save(path), "overwrote an existing keystore")
+
+	loaded, err := keystore.Load(path)
+	require.NoError(t, err)
+	require.Equal(t, ks.UUID, loaded.UUID)
+
+	// Control codes are stripped from passwords.
+	decrypted, err := loaded.Decrypt("pass\x7fword\n")
+	require.NoError(t, err)
+	require.Equal(t, secret, decrypted)
+
+	_, err = loaded.Decrypt("Password")
+	require.ErrorIs(t, err, keystore.ErrInvalidPassword)
+}
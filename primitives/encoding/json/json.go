@@ -43,3 +43,9 @@ var Unmarshal = json.Unmarshal
 // value. It implements Marshaler and Unmarshaler and can be used to delay JSON
 // decoding or precompute a JSON encoding.
 type RawMessage = json.RawMessage
+
+// Decoder is an alias for json.Decoder, reading and decoding JSON values from
+// an input stream.
+type Decoder = json.Decoder
+
+var NewDecoder = json.NewDecoder
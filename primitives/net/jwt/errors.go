@@ -35,4 +35,14 @@ var (
 
 	// ErrCreateJWT is returned when a JWT token fails to be created.
 	ErrCreateJWT = errors.New("failed to create JWT token")
+
+	// ErrInvalidJWT is returned when a JWT token fails to be verified.
+	ErrInvalidJWT = errors.New("invalid JWT token")
+
+	// ErrMissingIssuedAt is returned when a JWT token has no "iat" claim.
+	ErrMissingIssuedAt = errors.New("JWT token is missing the iat claim")
+
+	// ErrStaleJWT is returned when a JWT token was issued too far from the
+	// local time.
+	ErrStaleJWT = errors.New("JWT token iat is out of the allowed range")
 )
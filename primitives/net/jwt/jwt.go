@@ -39,6 +39,10 @@ var HexRegexp = regexp.MustCompile(`^(?:0x)?[0-9a-fA-F]*$`)
 // https://github.com/ethereum/execution-apis/blob/main/src/engine/authentication.md
 const EthereumJWTLength = 32
 
+// MaxIssuedAtDrift is the maximum allowed distance between a token's "iat"
+// claim and the local time, as defined by the Engine API specification.
+const MaxIssuedAtDrift = 60 * time.Second
+
 // Secret represents a JSON Web Token as a fixed-size byte array.
 type Secret [EthereumJWTLength]byte
 
@@ -85,6 +89,28 @@ func (s *Secret) BuildSignedToken() (string, error) {
 	return str, nil
 }
 
+// VerifySignedToken checks that the given token is an HS256 JWT signed with
+// the secret whose "iat" claim is within MaxIssuedAtDrift of the local time.
+func (s *Secret) VerifySignedToken(tokenStr string) error {
+	token, err := gjwt.Parse(
+		tokenStr,
+		func(*gjwt.Token) (any, error) { return s[:], nil },
+		gjwt.WithValidMethods([]string{gjwt.SigningMethodHS256.Alg()}),
+	)
+	if err != nil {
+		return errors.Wrapf(ErrInvalidJWT, "%w", err)
+	}
+	iat, err := token.Claims.GetIssuedAt()
+	if err != nil || iat == nil {
+		return ErrMissingIssuedAt
+	}
+	if drift := time.Since(iat.Time); drift > MaxIssuedAtDrift ||
+		drift < -MaxIssuedAtDrift {
+		return ErrStaleJWT
+	}
+	return nil
+}
+
 // String returns the JWT secret as a string with the first 8 characters
 // visible and the rest masked out for security.
 func (s *Secret) String() string {
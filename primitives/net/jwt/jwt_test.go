@@ -23,9 +23,11 @@ package jwt_test
 import (
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/berachain/beacon-kit/primitives/encoding/hex"
 	"github.com/berachain/beacon-kit/primitives/net/jwt"
+	gjwt "github.com/golang-jwt/jwt/v5"
 	"github.com/stretchr/testify/require"
 )
 
@@ -184,6 +186,43 @@ func TestBuildSignedToken(t *testing.T) {
 	require.Len(t, parts, 3, "Token should have three parts")
 }
 
+func TestVerifySignedToken(t *testing.T) {
+	secret, err := jwt.NewRandom()
+	require.NoError(t, err)
+	other, err := jwt.NewRandom()
+	require.NoError(t, err)
+
+	token, err := secret.BuildSignedToken()
+	require.NoError(t, err)
+	require.NoError(t, secret.VerifySignedToken(token))
+
+	// A token signed with a different secret is rejected.
+	require.ErrorIs(t, other.VerifySignedToken(token), jwt.ErrInvalidJWT)
+
+	// A token without an iat claim is rejected.
+	noIAT, err := gjwt.NewWithClaims(
+		gjwt.SigningMethodHS256, gjwt.MapClaims{},
+	).SignedString(secret.Bytes())
+	require.NoError(t, err)
+	require.ErrorIs(t, secret.VerifySignedToken(noIAT), jwt.ErrMissingIssuedAt)
+
+	// A token issued too long ago is rejected.
+	stale, err := gjwt.NewWithClaims(gjwt.SigningMethodHS256, gjwt.MapClaims{
+		"iat": &gjwt.NumericDate{
+			Time: time.Now().Add(-2 * jwt.MaxIssuedAtDrift),
+		},
+	}).SignedString(secret.Bytes())
+	require.NoError(t, err)
+	require.ErrorIs(t, secret.VerifySignedToken(stale), jwt.ErrStaleJWT)
+
+	// A token signed with another algorithm is rejected.
+	none, err := gjwt.NewWithClaims(gjwt.SigningMethodNone, gjwt.MapClaims{
+		"iat": &gjwt.NumericDate{Time: time.Now()},
+	}).SignedString(gjwt.UnsafeAllowNoneSignatureType)
+	require.NoError(t, err)
+	require.ErrorIs(t, secret.VerifySignedToken(none), jwt.ErrInvalidJWT)
+}
+
 func TestNewFromHexEdgeCases(t *testing.T) {
 	tests := []struct {
 		name    string
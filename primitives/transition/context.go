@@ -47,6 +47,12 @@ type Context struct {
 	// ConsensusTime returns the timestamp of current consensus request.
 	// It is used to build next payload and to validate currentpayload.
 	ConsensusTime math.U64
+	// Equivocations is the double-signing evidence included by consensus
+	// in the current block.
+	Equivocations []Equivocation
+	// Votes is the participation of validators in the commit of the
+	// previous block, as reported by consensus.
+	Votes []Vote
 }
 
 // GetOptimisticEngine returns whether to optimistically assume the execution
@@ -86,6 +92,18 @@ func (c *Context) GetConsensusTime() math.U64 {
 	return c.ConsensusTime
 }
 
+// GetEquivocations returns the double-signing evidence included by consensus
+// in the current block.
+func (c *Context) GetEquivocations() []Equivocation {
+	return c.Equivocations
+}
+
+// GetVotes returns the participation of validators in the commit of the
+// previous block.
+func (c *Context) GetVotes() []Vote {
+	return c.Votes
+}
+
 // Unwrap returns the underlying standard context.
 func (c *Context) Unwrap() context.Context {
 	return c.Context
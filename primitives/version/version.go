@@ -45,3 +45,25 @@ func FromUint32[VersionT ~[4]byte](version uint32) VersionT {
 func ToUint32[VersionT ~[4]byte](version VersionT) uint32 {
 	return binary.LittleEndian.Uint32(version[:])
 }
+
+// Name returns the lower case name of the given fork version, as used by the
+// Beacon API for the `version` field and the `Eth-Consensus-Version` header.
+// Unknown versions are reported as "unknown".
+func Name(version uint32) string {
+	switch version {
+	case Phase0:
+		return "phase0"
+	case Altair:
+		return "altair"
+	case Bellatrix:
+		return "bellatrix"
+	case Capella:
+		return "capella"
+	case Deneb, DenebPlus:
+		return "deneb"
+	case Electra:
+		return "electra"
+	default:
+		return "unknown"
+	}
+}
@@ -32,6 +32,7 @@ import (
 	"cosmossdk.io/store/metrics"
 	storetypes "cosmossdk.io/store/types"
 	"github.com/berachain/beacon-kit/chain-spec/chain"
+	"github.com/berachain/beacon-kit/config/spec"
 	"github.com/berachain/beacon-kit/consensus-types/types"
 	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
 	"github.com/berachain/beacon-kit/log/noop"
@@ -42,6 +43,7 @@ import (
 	cryptomocks "github.com/berachain/beacon-kit/primitives/crypto/mocks"
 	"github.com/berachain/beacon-kit/primitives/math"
 	"github.com/berachain/beacon-kit/primitives/transition"
+	"github.com/berachain/beacon-kit/primitives/version"
 	"github.com/berachain/beacon-kit/state-transition/core"
 	"github.com/berachain/beacon-kit/state-transition/core/mocks"
 	statedb "github.com/berachain/beacon-kit/state-transition/core/state"
@@ -120,13 +122,32 @@ func setupChain(t *testing.T, chainSpecType string) chain.Spec[
 ] {
 	t.Helper()
 
-	t.Setenv(components.ChainSpecTypeEnvVar, chainSpecType)
-	cs, err := components.ProvideChainSpec()
+	cs, err := components.PresetChainSpec(chainSpecType)
 	require.NoError(t, err)
 
 	return cs
 }
 
+// testSpecData is the chain spec data that tests adjust, e.g. to activate a
+// fork at a given epoch.
+type testSpecData = chain.SpecData[
+	common.DomainType, math.Epoch, math.Slot, any,
+]
+
+// setupForkChain returns a Betnet chain spec adjusted by the given mutator.
+func setupForkChain(
+	t *testing.T,
+	mutate func(*testSpecData),
+) chain.Spec[bytes.B4, math.U64, math.U64, any] {
+	t.Helper()
+	specData := spec.BaseSpec()
+	specData.DepositEth1ChainID = spec.BetnetEth1ChainID
+	mutate(&specData)
+	cs, err := chain.NewChainSpec(specData)
+	require.NoError(t, err)
+	return cs
+}
+
 func setupState(
 	t *testing.T, cs chain.Spec[
 		bytes.B4, math.U64, math.U64, any,
@@ -177,6 +198,45 @@ func setupState(
 	return sp, beaconState, depositStore, ctx
 }
 
+// initGenesisState stores the given deposits and initializes the state with
+// the validators of the first numGenesis of them.
+func initGenesisState(
+	t *testing.T,
+	cs chain.Spec[bytes.B4, math.U64, math.U64, any],
+	deposits types.Deposits,
+	numGenesis int,
+) (
+	*TestStateProcessorT,
+	*TestBeaconStateT,
+	*depositstore.KVStore,
+	*transition.Context,
+) {
+	t.Helper()
+	sp, st, ds, ctx := setupState(t, cs)
+	require.NoError(t, ds.EnqueueDeposits(deposits))
+	_, err := sp.InitializePreminedBeaconStateFromEth1(
+		st,
+		deposits[:numGenesis],
+		new(types.ExecutionPayloadHeader).Empty(),
+		version.FromUint32[common.Version](version.Deneb),
+	)
+	require.NoError(t, err)
+	return sp, st, ds, ctx
+}
+
+// threeDeposits returns the deposits of three validators with the given
+// balance and credentials.
+func threeDeposits(
+	amount math.Gwei,
+	credentials types.WithdrawalCredentials,
+) types.Deposits {
+	return types.Deposits{
+		{Pubkey: [48]byte{0x00}, Credentials: credentials, Amount: amount, Index: 0},
+		{Pubkey: [48]byte{0x01}, Credentials: credentials, Amount: amount, Index: 1},
+		{Pubkey: [48]byte{0x02}, Credentials: credentials, Amount: amount, Index: 2},
+	}
+}
+
 func progressStateToSlot(
 	t *testing.T,
 	beaconState *TestBeaconStateT,
@@ -223,6 +283,70 @@ func buildNextBlock(
 	}
 }
 
+// buildTestBlock builds the block following the latest one of the state,
+// carrying the given deposits and the given withdrawals on top of the EVM
+// inflation one. Its payload has the given number and follows the latest
+// payload by one second.
+func buildTestBlock(
+	t *testing.T,
+	st *TestBeaconStateT,
+	depRoot common.Root,
+	payloadNumber math.U64,
+	deposits []*types.Deposit,
+	withdrawals ...*engineprimitives.Withdrawal,
+) *types.BeaconBlock {
+	t.Helper()
+	latest, err := st.GetLatestExecutionPayloadHeader()
+	require.NoError(t, err)
+	return buildNextBlock(t, st, &types.BeaconBlockBody{
+		ExecutionPayload: &types.ExecutionPayload{
+			Number:       payloadNumber,
+			Timestamp:    latest.GetTimestamp() + 1,
+			ExtraData:    []byte("testing"),
+			Transactions: [][]byte{},
+			Withdrawals: append(
+				[]*engineprimitives.Withdrawal{st.EVMInflationWithdrawal()},
+				withdrawals...,
+			),
+			BaseFeePerGas: math.NewU256(0),
+		},
+		Eth1Data: &types.Eth1Data{DepositRoot: depRoot},
+		Deposits: deposits,
+	})
+}
+
+// transitionTestBlock builds and processes the next block without deposits,
+// proposed by the validator at index 1 which is never slashed nor exited in
+// these tests.
+func transitionTestBlock(
+	t *testing.T,
+	sp *TestStateProcessorT,
+	st *TestBeaconStateT,
+	ctx *transition.Context,
+	depRoot common.Root,
+	payloadNumber math.U64,
+	withdrawals ...*engineprimitives.Withdrawal,
+) (transition.ValidatorUpdates, error) {
+	t.Helper()
+	blk := buildTestBlock(t, st, depRoot, payloadNumber, nil, withdrawals...)
+	blk.ProposerIndex = 1
+	return sp.Transition(ctx, st, blk)
+}
+
+// transitionNextBlock processes the next empty block, which must succeed.
+func transitionNextBlock(
+	t *testing.T,
+	sp *TestStateProcessorT,
+	st *TestBeaconStateT,
+	ctx *transition.Context,
+	depRoot common.Root,
+) transition.ValidatorUpdates {
+	t.Helper()
+	valUpdates, err := transitionTestBlock(t, sp, st, ctx, depRoot, 0)
+	require.NoError(t, err)
+	return valUpdates
+}
+
 func generateTestExecutionAddress(
 	t *testing.T,
 	rndSeed int,
@@ -262,24 +386,7 @@ func moveToEndOfEpoch(
 	blk := tip
 	currEpoch := cs.SlotToEpoch(blk.GetSlot())
 	for currEpoch == cs.SlotToEpoch(blk.GetSlot()+1) {
-		blk = buildNextBlock(
-			t,
-			st,
-			&types.BeaconBlockBody{
-				ExecutionPayload: &types.ExecutionPayload{
-					Timestamp:    blk.Body.ExecutionPayload.Timestamp + 1,
-					ExtraData:    []byte("testing"),
-					Transactions: [][]byte{},
-					Withdrawals: []*engineprimitives.Withdrawal{
-						st.EVMInflationWithdrawal(),
-					},
-					BaseFeePerGas: math.NewU256(0),
-				},
-				Eth1Data: &types.Eth1Data{DepositRoot: depRoot},
-				Deposits: []*types.Deposit{},
-			},
-		)
-
+		blk = buildTestBlock(t, st, depRoot, 0, []*types.Deposit{})
 		vals, err := sp.Transition(ctx, st, blk)
 		require.NoError(t, err)
 		require.Empty(t, vals) // no vals changes expected before next epoch
@@ -76,6 +76,26 @@ var (
 	// in a block does not match the expected value.
 	ErrPenaltiesLengthMismatch = errors.New("penalties length mismatch")
 
+	// ErrWithdrawalRequestsBeforeFork is returned when a block includes
+	// withdrawal requests before they are enabled.
+	ErrWithdrawalRequestsBeforeFork = errors.New(
+		"withdrawal requests included before fork")
+
+	// ErrExceedsBlockWithdrawalRequestLimit is returned when a block includes
+	// more withdrawal requests than allowed.
+	ErrExceedsBlockWithdrawalRequestLimit = errors.New(
+		"block exceeds withdrawal request limit")
+
+	// ErrWithdrawalRequestIndexOutOfOrder is returned when the withdrawal
+	// requests in a block do not follow the last processed one.
+	ErrWithdrawalRequestIndexOutOfOrder = errors.New(
+		"withdrawal request index out of order")
+
+	// ErrWithdrawalRequestsMismatch is returned when the withdrawal requests
+	// in a block do not match the local ones.
+	ErrWithdrawalRequestsMismatch = errors.New(
+		"withdrawal requests mismatch")
+
 	// ErrExceedsBlockBlobLimit is returned when the block exceeds the blob
 	// limit.
 	ErrExceedsBlockBlobLimit = errors.New("block exceeds blob limit")
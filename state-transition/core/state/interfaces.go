@@ -103,6 +103,24 @@ type KVStore[T any] interface {
 	SetSlashingAtIndex(index uint64, amount math.Gwei) error
 	// GetSlashingAtIndex retrieves the slashing at the given index.
 	GetSlashingAtIndex(index uint64) (math.Gwei, error)
+	// GetInactivityScore retrieves the inactivity score of a validator.
+	GetInactivityScore(idx math.ValidatorIndex) (uint64, error)
+	// SetInactivityScore sets the inactivity score of a validator.
+	SetInactivityScore(idx math.ValidatorIndex, score uint64) error
+	// GetNextWithdrawalRequestIndex retrieves the index of the next
+	// withdrawal request to process.
+	GetNextWithdrawalRequestIndex() (uint64, error)
+	// SetNextWithdrawalRequestIndex sets the index of the next withdrawal
+	// request to process.
+	SetNextWithdrawalRequestIndex(index uint64) error
+	// GetPendingPartialWithdrawal retrieves the amount a validator is yet
+	// to be paid out on its partial withdrawal requests.
+	GetPendingPartialWithdrawal(idx math.ValidatorIndex) (math.Gwei, error)
+	// SetPendingPartialWithdrawal sets the amount a validator is yet to be
+	// paid out on its partial withdrawal requests.
+	SetPendingPartialWithdrawal(
+		idx math.ValidatorIndex, amount math.Gwei,
+	) error
 	// GetTotalValidators retrieves the total validators.
 	GetTotalValidators() (uint64, error)
 	// GetTotalActiveBalances retrieves the total active balances.
@@ -30,6 +30,7 @@ import (
 	"github.com/berachain/beacon-kit/errors"
 	"github.com/berachain/beacon-kit/primitives/common"
 	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/version"
 	"github.com/berachain/beacon-kit/storage/beacondb"
 )
 
@@ -121,6 +122,8 @@ func (s *StateDB) ExpectedWithdrawals() (engineprimitives.Withdrawals, error) {
 	var (
 		validator         *ctypes.Validator
 		balance           math.Gwei
+		pending           math.Gwei
+		amount            math.Gwei
 		withdrawalAddress common.ExecutionAddress
 		withdrawals       = make([]*engineprimitives.Withdrawal, 0)
 		withdrawal        *engineprimitives.Withdrawal
@@ -192,6 +195,12 @@ func (s *StateDB) ExpectedWithdrawals() (engineprimitives.Withdrawals, error) {
 			return nil, err
 		}
 
+		pending, err = s.GetPendingPartialWithdrawal(validatorIndex)
+		if err != nil {
+			return nil, err
+		}
+		amount = s.partialWithdrawalAmount(validator, balance, pending, slot)
+
 		// Set the amount of the withdrawal depending on the balance of the
 		// validator.
 		//nolint:gocritic,nestif // ok.
@@ -211,11 +220,7 @@ func (s *StateDB) ExpectedWithdrawals() (engineprimitives.Withdrawals, error) {
 
 			// Increment the withdrawal index to process the next withdrawal.
 			withdrawalIndex++
-		} else if validator.IsPartiallyWithdrawable(
-			balance, math.Gwei(s.cs.MaxEffectiveBalance(
-				IsPostFork3(s.cs.DepositEth1ChainID(), slot),
-			)),
-		) {
+		} else if amount > 0 {
 			withdrawalAddress, err = validator.
 				GetWithdrawalCredentials().ToExecutionAddress()
 			if err != nil {
@@ -226,9 +231,7 @@ func (s *StateDB) ExpectedWithdrawals() (engineprimitives.Withdrawals, error) {
 				math.U64(withdrawalIndex),
 				validatorIndex,
 				withdrawalAddress,
-				balance-math.Gwei(s.cs.MaxEffectiveBalance(
-					IsPostFork3(s.cs.DepositEth1ChainID(), slot),
-				)),
+				amount,
 			))
 
 			// Increment the withdrawal index to process the next withdrawal.
@@ -268,6 +271,39 @@ func (s *StateDB) ExpectedWithdrawals() (engineprimitives.Withdrawals, error) {
 	return withdrawals, nil
 }
 
+// partialWithdrawalAmount returns the amount the sweep pays out to a
+// validator that is not fully withdrawable: the balance in excess of the max
+// effective balance, plus the pending amount of its partial withdrawal
+// requests, as long as one effective balance increment above the ejection
+// balance is left.
+func (s *StateDB) partialWithdrawalAmount(
+	validator *ctypes.Validator,
+	balance, pending math.Gwei,
+	slot math.Slot,
+) math.Gwei {
+	var (
+		amount              math.Gwei
+		maxEffectiveBalance = math.Gwei(s.cs.MaxEffectiveBalance(
+			IsPostFork3(s.cs.DepositEth1ChainID(), slot),
+		))
+	)
+	if validator.IsPartiallyWithdrawable(balance, maxEffectiveBalance) {
+		amount = balance - maxEffectiveBalance
+	}
+	if pending == 0 || !validator.HasEth1WithdrawalCredentials() {
+		return amount
+	}
+
+	minBalance := math.Gwei(
+		s.cs.EjectionBalance() + s.cs.EffectiveBalanceIncrement(),
+	)
+	if withdrawable := min(balance, maxEffectiveBalance); withdrawable >
+		minBalance {
+		amount += min(pending, withdrawable-minBalance)
+	}
+	return amount
+}
+
 // EVMInflationWithdrawal returns the withdrawal used for EVM balance inflation.
 //
 // NOTE: The withdrawal index and validator index are both set to 0 as they are
@@ -378,8 +414,9 @@ func (s *StateDB) GetMarshallable() (*ctypes.BeaconState, error) {
 	}
 
 	var bs *ctypes.BeaconState
-	return bs.New(
-		s.cs.ActiveForkVersionForSlot(slot),
+	forkVersion := s.cs.ActiveForkVersionForSlot(slot)
+	bs, err = bs.New(
+		forkVersion,
 		genesisValidatorsRoot,
 		slot,
 		fork,
@@ -397,6 +434,28 @@ func (s *StateDB) GetMarshallable() (*ctypes.BeaconState, error) {
 		slashings,
 		totalSlashings,
 	)
+	if err != nil || forkVersion < version.Electra {
+		return bs, err
+	}
+
+	// The fields below are only part of the Electra state.
+	bs.InactivityScores, err = s.GetInactivityScores(uint64(len(validators)))
+	if err != nil {
+		return empty, err
+	}
+
+	bs.NextWithdrawalRequestIndex, err = s.GetNextWithdrawalRequestIndex()
+	if err != nil {
+		return empty, err
+	}
+
+	bs.PendingPartialWithdrawals, err = s.GetPendingPartialWithdrawals(
+		uint64(len(validators)),
+	)
+	if err != nil {
+		return empty, err
+	}
+	return bs, nil
 }
 
 // HashTreeRoot is the interface for the beacon store.
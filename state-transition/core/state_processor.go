@@ -223,6 +223,18 @@ func (sp *StateProcessor[
 		return err
 	}
 
+	if err := sp.processWithdrawalRequests(st, blk); err != nil {
+		return err
+	}
+
+	if err := sp.processEquivocations(ctx, st); err != nil {
+		return err
+	}
+
+	if err := sp.processVotes(ctx, st); err != nil {
+		return err
+	}
+
 	// If we are skipping validate, we can skip calculating the state
 	// root to save compute.
 	if ctx.GetSkipValidateResult() {
@@ -267,6 +279,9 @@ func (sp *StateProcessor[
 	if err = sp.processRegistryUpdates(st); err != nil {
 		return nil, err
 	}
+	if err = sp.processSlashings(st); err != nil {
+		return nil, err
+	}
 	if err = sp.processEffectiveBalanceUpdates(st, slot); err != nil {
 		return nil, err
 	}
@@ -0,0 +1,161 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/primitives/constants"
+	"github.com/berachain/beacon-kit/primitives/math"
+	statedb "github.com/berachain/beacon-kit/state-transition/core/state"
+)
+
+// isChurnLimitActive returns whether validator activations and exits are
+// limited by the churn limits at the given epoch. They are gated behind
+// ChurnLimitForkEpoch so that networks predating it keep their app hashes.
+func (sp *StateProcessor[
+	_, _,
+]) isChurnLimitActive(epoch math.Epoch) bool {
+	return epoch >= sp.cs.ChurnLimitForkEpoch()
+}
+
+// processActivationQueue activates at the next epoch the validators at the
+// head of the activation queue, up to ActivationChurnLimit of them. The queue
+// is made of the validators eligible for activation at the given epoch,
+// ordered by ActivationEligibilityEpoch and then by index, as in the spec.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#registry-updates
+func (sp *StateProcessor[
+	_, _,
+]) processActivationQueue(
+	st *statedb.StateDB,
+	vals ctypes.Validators,
+	currEpoch math.Epoch,
+) error {
+	queue := make([]*ctypes.Validator, 0)
+	for _, val := range vals {
+		if val.IsEligibleForActivation(currEpoch) {
+			queue = append(queue, val)
+		}
+	}
+	// vals are ordered by index, so a stable sort breaks ties by index.
+	slices.SortStableFunc(queue, func(lhs, rhs *ctypes.Validator) int {
+		return cmp.Compare(
+			lhs.GetActivationEligibilityEpoch(),
+			rhs.GetActivationEligibilityEpoch(),
+		)
+	})
+
+	//#nosec:G701 // the churn limit is a small number.
+	churn := min(len(queue), int(sp.cs.ActivationChurnLimit()))
+	for _, val := range queue[:churn] {
+		val.SetActivationEpoch(currEpoch + 1)
+		idx, err := st.ValidatorIndexByPubkey(val.GetPubkey())
+		if err != nil {
+			return fmt.Errorf(
+				"activation queue, failed loading validator index: %w", err,
+			)
+		}
+		if err = st.UpdateValidatorAtIndex(idx, val); err != nil {
+			return fmt.Errorf(
+				"activation queue, failed activating validator idx %d: %w",
+				idx,
+				err,
+			)
+		}
+	}
+	return nil
+}
+
+// exitQueue assigns exit epochs to the validators exiting at a given epoch.
+// Validators exit at the next epoch until ChurnLimitForkEpoch. From then on
+// exits are queued so that at most ExitChurnLimit validators exit per epoch,
+// as in initiate_validator_exit. The registry is scanned once, on the first
+// exit, and the queue is then advanced locally, so that exiting many
+// validators does not rescan the registry for each of them. Hence all the
+// exits of the state must go through the same queue while it is in use.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#initiate_validator_exit
+type exitQueue struct {
+	st      *statedb.StateDB
+	limited bool
+	limit   uint64
+	loaded  bool
+
+	// exitEpoch is the epoch of the tail of the queue and churn the number
+	// of validators exiting at it.
+	exitEpoch math.Epoch
+	churn     uint64
+}
+
+// newExitQueue returns the exit queue of validators exiting at the given
+// epoch.
+func (sp *StateProcessor[
+	_, _,
+]) newExitQueue(st *statedb.StateDB, epoch math.Epoch) *exitQueue {
+	return &exitQueue{
+		st:        st,
+		limited:   sp.isChurnLimitActive(epoch),
+		limit:     sp.cs.ExitChurnLimit(),
+		exitEpoch: epoch + 1,
+	}
+}
+
+// next returns the exit epoch of the next exiting validator.
+func (q *exitQueue) next() (math.Epoch, error) {
+	if !q.limited {
+		return q.exitEpoch, nil
+	}
+	if !q.loaded {
+		if err := q.load(); err != nil {
+			return 0, err
+		}
+	}
+	if q.churn >= q.limit {
+		q.exitEpoch++
+		q.churn = 0
+	}
+	q.churn++
+	return q.exitEpoch, nil
+}
+
+// load sets the tail of the queue to the latest exit epoch of the registry,
+// if later than the next epoch, and counts the validators exiting at it.
+func (q *exitQueue) load() error {
+	vals, err := q.st.GetValidators()
+	if err != nil {
+		return err
+	}
+	farFutureEpoch := math.Epoch(constants.FarFutureEpoch)
+	for _, val := range vals {
+		if e := val.GetExitEpoch(); e != farFutureEpoch && e > q.exitEpoch {
+			q.exitEpoch = e
+		}
+	}
+	for _, val := range vals {
+		if val.GetExitEpoch() == q.exitEpoch {
+			q.churn++
+		}
+	}
+	q.loaded = true
+	return nil
+}
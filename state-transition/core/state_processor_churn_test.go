@@ -0,0 +1,301 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/chain-spec/chain"
+	"github.com/berachain/beacon-kit/consensus-types/types"
+	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
+	"github.com/berachain/beacon-kit/primitives/bytes"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/constants"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/transition"
+	depositstore "github.com/berachain/beacon-kit/storage/deposit"
+	"github.com/stretchr/testify/require"
+)
+
+// turnEpoch moves the chain to the end of the current epoch, then processes
+// the block turning epoch and returns it along with its validators updates.
+func turnEpoch(
+	t *testing.T,
+	tip *types.BeaconBlock,
+	cs chain.Spec[bytes.B4, math.U64, math.U64, any],
+	sp *TestStateProcessorT,
+	st *TestBeaconStateT,
+	ctx *transition.Context,
+	depRoot common.Root,
+	withdrawals ...*engineprimitives.Withdrawal,
+) (*types.BeaconBlock, transition.ValidatorUpdates) {
+	t.Helper()
+	tip = moveToEndOfEpoch(t, tip, cs, sp, st, ctx, depRoot)
+	blk := buildTestBlock(t, st, depRoot, 0, nil, withdrawals...)
+	valDiff, err := sp.Transition(ctx, st, blk)
+	require.NoError(t, err)
+	return blk, valDiff
+}
+
+// initActivationQueueState initializes the state with one genesis validator
+// and processes a block depositing four more validators. The first of them
+// has a balance too small to join the activation queue, while the others do.
+func initActivationQueueState(
+	t *testing.T,
+	cs chain.Spec[bytes.B4, math.U64, math.U64, any],
+) (
+	*TestStateProcessorT,
+	*TestBeaconStateT,
+	*depositstore.KVStore,
+	*transition.Context,
+	*types.BeaconBlock,
+	types.Deposits,
+) {
+	t.Helper()
+	var (
+		maxBalance      = math.Gwei(cs.MaxEffectiveBalance(false))
+		ejectionBalance = math.Gwei(cs.EjectionBalance())
+		credentials     = types.NewCredentialsFromExecutionAddress(
+			common.ExecutionAddress{0x01},
+		)
+		deposits = types.Deposits{
+			{Pubkey: [48]byte{0x01}, Credentials: credentials, Amount: maxBalance, Index: 0},
+			{Pubkey: [48]byte{0x02}, Credentials: credentials, Amount: ejectionBalance, Index: 1},
+			{Pubkey: [48]byte{0x03}, Credentials: credentials, Amount: maxBalance, Index: 2},
+			{Pubkey: [48]byte{0x04}, Credentials: credentials, Amount: maxBalance, Index: 3},
+			{Pubkey: [48]byte{0x05}, Credentials: credentials, Amount: maxBalance, Index: 4},
+		}
+	)
+	sp, st, ds, ctx := initGenesisState(t, cs, deposits, 1)
+
+	blk := buildTestBlock(t, st, deposits.HashTreeRoot(), 0, deposits[1:])
+	valDiff, err := sp.Transition(ctx, st, blk)
+	require.NoError(t, err)
+	require.Empty(t, valDiff)
+	return sp, st, ds, ctx, blk, deposits
+}
+
+// TestTransitionActivationChurnLimit shows that once the churn limit fork is
+// active, validators are activated ActivationChurnLimit per epoch, in order
+// of ActivationEligibilityEpoch rather than of validator index.
+func TestTransitionActivationChurnLimit(t *testing.T) {
+	cs := setupForkChain(t, func(sd *testSpecData) {
+		sd.ChurnLimitForkEpoch = 0
+		sd.ActivationChurnLimit = 1
+		sd.ExitChurnLimit = 1
+		sd.ValidatorSetCap = 256
+	})
+	sp, st, ds, ctx, blk, deposits := initActivationQueueState(t, cs)
+
+	var (
+		maxBalance = math.Gwei(cs.MaxEffectiveBalance(false))
+		increment  = math.Gwei(cs.EffectiveBalanceIncrement())
+		depRoot    = deposits.HashTreeRoot()
+	)
+
+	// Epoch 1: all validators but 0x02 join the activation queue.
+	blk, valDiff := turnEpoch(t, blk, cs, sp, st, ctx, depRoot)
+	require.Empty(t, valDiff)
+
+	// top up 0x02 so that it joins the activation queue one epoch later than
+	// the validators following it by index. Top up twice the increment to
+	// account for hysteresis.
+	topUp := &types.Deposit{
+		Pubkey:      deposits[1].Pubkey,
+		Credentials: deposits[1].Credentials,
+		Amount:      2 * increment,
+		Index:       uint64(len(deposits)),
+	}
+	deposits = append(deposits, topUp)
+	depRoot = deposits.HashTreeRoot()
+	blk = buildTestBlock(t, st, depRoot, 0, []*types.Deposit{topUp})
+	require.NoError(t, ds.EnqueueDeposits(blk.Body.Deposits))
+	valDiff, err := sp.Transition(ctx, st, blk)
+	require.NoError(t, err)
+	require.Empty(t, valDiff)
+
+	// Epochs 2 to 4: a single validator is activated per epoch. 0x05 is
+	// activated before 0x02 since it joined the queue earlier.
+	for _, pk := range []bytes.B48{{0x03}, {0x04}, {0x05}} {
+		blk, valDiff = turnEpoch(t, blk, cs, sp, st, ctx, depRoot)
+		require.Equal(
+			t,
+			transition.ValidatorUpdates{
+				{Pubkey: pk, EffectiveBalance: maxBalance},
+			},
+			valDiff,
+		)
+	}
+
+	idx, err := st.ValidatorIndexByPubkey(deposits[1].Pubkey)
+	require.NoError(t, err)
+	val, err := st.ValidatorByIndex(idx)
+	require.NoError(t, err)
+	require.Equal(t, math.Epoch(3), val.ActivationEligibilityEpoch)
+	require.Equal(
+		t,
+		math.Epoch(constants.FarFutureEpoch),
+		val.ActivationEpoch,
+	)
+
+	// Epoch 5: the activation queue is drained.
+	_, valDiff = turnEpoch(t, blk, cs, sp, st, ctx, depRoot)
+	require.Equal(
+		t,
+		transition.ValidatorUpdates{
+			{
+				Pubkey:           deposits[1].Pubkey,
+				EffectiveBalance: deposits[1].Amount + topUp.Amount,
+			},
+		},
+		valDiff,
+	)
+}
+
+// TestTransitionActivationChurnLimitBeforeFork shows that before the churn
+// limit fork all validators eligible for activation are activated at once.
+func TestTransitionActivationChurnLimitBeforeFork(t *testing.T) {
+	cs := setupForkChain(t, func(sd *testSpecData) {
+		sd.ChurnLimitForkEpoch = 2
+		sd.ActivationChurnLimit = 1
+		sd.ExitChurnLimit = 1
+		sd.ValidatorSetCap = 256
+	})
+	sp, st, _, ctx, blk, deposits := initActivationQueueState(t, cs)
+
+	var (
+		maxBalance = math.Gwei(cs.MaxEffectiveBalance(false))
+		depRoot    = deposits.HashTreeRoot()
+	)
+
+	blk, valDiff := turnEpoch(t, blk, cs, sp, st, ctx, depRoot)
+	require.Empty(t, valDiff)
+
+	// Epoch 2 activations are processed at the end of epoch 1, pre fork.
+	_, valDiff = turnEpoch(t, blk, cs, sp, st, ctx, depRoot)
+	require.ElementsMatch(
+		t,
+		transition.ValidatorUpdates{
+			{Pubkey: [48]byte{0x03}, EffectiveBalance: maxBalance},
+			{Pubkey: [48]byte{0x04}, EffectiveBalance: maxBalance},
+			{Pubkey: [48]byte{0x05}, EffectiveBalance: maxBalance},
+		},
+		valDiff,
+	)
+}
+
+// TestTransitionExitChurnLimit shows that once the churn limit fork is
+// active, validators ejected to enforce the validator set cap exit at most
+// ExitChurnLimit per epoch, and are not ejected twice while exiting.
+//
+//nolint:maintidx // Okay for test.
+func TestTransitionExitChurnLimit(t *testing.T) {
+	cs := setupForkChain(t, func(sd *testSpecData) {
+		sd.ChurnLimitForkEpoch = 0
+		sd.ActivationChurnLimit = 4
+		sd.ExitChurnLimit = 1
+		sd.ValidatorSetCap = 4
+	})
+	var (
+		maxBalance      = math.Gwei(cs.MaxEffectiveBalance(false))
+		ejectionBalance = math.Gwei(cs.EjectionBalance())
+		minBalance      = ejectionBalance + math.Gwei(
+			cs.EffectiveBalanceIncrement(),
+		)
+		withdrawalAddr = common.ExecutionAddress{0x01}
+		credentials    = types.NewCredentialsFromExecutionAddress(
+			withdrawalAddr,
+		)
+		deposits = types.Deposits{
+			{Pubkey: [48]byte{0x01}, Credentials: credentials, Amount: minBalance, Index: 0},
+			{Pubkey: [48]byte{0x02}, Credentials: credentials, Amount: minBalance, Index: 1},
+			{Pubkey: [48]byte{0x03}, Credentials: credentials, Amount: minBalance, Index: 2},
+			{Pubkey: [48]byte{0x04}, Credentials: credentials, Amount: minBalance, Index: 3},
+			{Pubkey: [48]byte{0x05}, Credentials: credentials, Amount: maxBalance, Index: 4},
+			{Pubkey: [48]byte{0x06}, Credentials: credentials, Amount: maxBalance, Index: 5},
+			{Pubkey: [48]byte{0x07}, Credentials: credentials, Amount: maxBalance, Index: 6},
+		}
+		depRoot = deposits.HashTreeRoot()
+	)
+
+	// genesis fills the validator set up to its cap
+	sp, st, _, ctx := initGenesisState(
+		t, cs, deposits, int(cs.ValidatorSetCap()),
+	)
+
+	// bigger validators join and are activated together at epoch 2
+	blk := buildTestBlock(
+		t, st, depRoot, 0, deposits[cs.ValidatorSetCap():],
+	)
+	valDiff, err := sp.Transition(ctx, st, blk)
+	require.NoError(t, err)
+	require.Empty(t, valDiff)
+
+	blk, valDiff = turnEpoch(t, blk, cs, sp, st, ctx, depRoot)
+	require.Empty(t, valDiff)
+
+	// Epoch 2: three genesis validators are ejected to make room for the new
+	// ones, but only one of them leaves the validator set right away.
+	blk, valDiff = turnEpoch(t, blk, cs, sp, st, ctx, depRoot)
+	require.ElementsMatch(
+		t,
+		transition.ValidatorUpdates{
+			{Pubkey: [48]byte{0x05}, EffectiveBalance: maxBalance},
+			{Pubkey: [48]byte{0x06}, EffectiveBalance: maxBalance},
+			{Pubkey: [48]byte{0x07}, EffectiveBalance: maxBalance},
+			{Pubkey: [48]byte{0x01}, EffectiveBalance: 0},
+		},
+		valDiff,
+	)
+
+	for i, expectedExitEpoch := range []math.Epoch{
+		2, 3, 4, math.Epoch(constants.FarFutureEpoch),
+	} {
+		var val *types.Validator
+		val, err = st.ValidatorByIndex(math.ValidatorIndex(i))
+		require.NoError(t, err)
+		require.Equal(t, expectedExitEpoch, val.ExitEpoch)
+	}
+
+	// Epoch 3: the next ejected validator leaves the validator set, while the
+	// first one is withdrawn. No further validator is ejected since those
+	// still exiting do not count towards the cap.
+	_, valDiff = turnEpoch(
+		t, blk, cs, sp, st, ctx, depRoot,
+		&engineprimitives.Withdrawal{
+			Index:     0,
+			Validator: 0,
+			Address:   withdrawalAddr,
+			Amount:    minBalance,
+		},
+	)
+	require.Equal(
+		t,
+		transition.ValidatorUpdates{
+			{Pubkey: [48]byte{0x02}, EffectiveBalance: 0},
+		},
+		valDiff,
+	)
+
+	val, err := st.ValidatorByIndex(3)
+	require.NoError(t, err)
+	require.Equal(t, math.Epoch(constants.FarFutureEpoch), val.ExitEpoch)
+}
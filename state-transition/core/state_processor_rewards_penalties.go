@@ -21,12 +21,75 @@
 package core
 
 import (
+	"math/bits"
+
+	"cosmossdk.io/collections"
 	"github.com/berachain/beacon-kit/config/spec"
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/errors"
 	"github.com/berachain/beacon-kit/primitives/constants"
 	"github.com/berachain/beacon-kit/primitives/math"
 	statedb "github.com/berachain/beacon-kit/state-transition/core/state"
 )
 
+// isRewardsActive returns whether validators are rewarded and penalized based
+// on their consensus voting participation at the given slot. Rewards and
+// penalties are gated behind RewardsForkEpoch so that networks predating it
+// keep their app hashes.
+func (sp *StateProcessor[
+	_, _,
+]) isRewardsActive(slot math.Slot) bool {
+	return sp.cs.SlotToEpoch(slot) >= sp.cs.RewardsForkEpoch()
+}
+
+// processVotes updates the inactivity scores of the validators based on their
+// participation in the commit of the previous block. A missed vote increases
+// the score by one, while a cast vote decreases it by one down to zero.
+func (sp *StateProcessor[
+	ContextT, _,
+]) processVotes(
+	ctx ContextT,
+	st *statedb.StateDB,
+) error {
+	slot, err := st.GetSlot()
+	if err != nil {
+		return err
+	}
+	if !sp.isRewardsActive(slot) {
+		return nil
+	}
+
+	var (
+		idx   math.ValidatorIndex
+		score uint64
+	)
+	for _, vote := range ctx.GetVotes() {
+		idx, err = st.ValidatorIndexByCometBFTAddress(vote.Address)
+		if errors.Is(err, collections.ErrNotFound) {
+			// The validator may have been removed from the registry
+			// since it voted.
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		score, err = st.GetInactivityScore(idx)
+		if err != nil {
+			return err
+		}
+		if vote.Missed {
+			score++
+		} else {
+			score -= min(1, score)
+		}
+		if err = st.SetInactivityScore(idx, score); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (sp *StateProcessor[
 	_, _,
 ]) processRewardsAndPenalties(st *statedb.StateDB) error {
@@ -35,8 +98,16 @@ func (sp *StateProcessor[
 		return err
 	}
 
-	// processRewardsAndPenalties does not really do anything right now.
-	// However we cannot simply drop it because appHash accounts
+	if sp.cs.SlotToEpoch(slot) == math.U64(constants.GenesisEpoch) {
+		return nil
+	}
+
+	if sp.isRewardsActive(slot) {
+		return sp.processInactivityRewardsAndPenalties(st, slot)
+	}
+
+	// Before the rewards fork, processRewardsAndPenalties does not really do
+	// anything. However we cannot simply drop it because appHash accounts
 	// for the list of operations carried out over the state
 	// even if the operations does not affect the final state
 	// (rewards and penalties are always zero at this stage of beaconKit)
@@ -52,10 +123,6 @@ func (sp *StateProcessor[
 		return nil
 	}
 
-	if sp.cs.SlotToEpoch(slot) == math.U64(constants.GenesisEpoch) {
-		return nil
-	}
-
 	// this has been simplified to make clear that
 	// we are not really doing anything here
 	valCount, err := st.GetTotalValidators()
@@ -77,3 +144,80 @@ func (sp *StateProcessor[
 
 	return nil
 }
+
+// processInactivityRewardsAndPenalties penalizes the active validators whose
+// inactivity score exceeds MinEpochsToInactivityPenalty epochs worth of missed
+// votes, proportionally to their effective balance and score, and rewards the
+// active validators that have not been missing votes with
+// BaseRewardPerIncrement per effective balance increment.
+func (sp *StateProcessor[
+	_, _,
+]) processInactivityRewardsAndPenalties(
+	st *statedb.StateDB,
+	slot math.Slot,
+) error {
+	valCount, err := st.GetTotalValidators()
+	if err != nil {
+		return err
+	}
+
+	var (
+		epoch         = sp.cs.SlotToEpoch(slot)
+		slotsPerEpoch = sp.cs.SlotsPerEpoch()
+		threshold     = sp.cs.MinEpochsToInactivityPenalty() * slotsPerEpoch
+		increment     = sp.cs.EffectiveBalanceIncrement()
+		baseReward    = sp.cs.BaseRewardPerIncrement()
+		val           *ctypes.Validator
+		score         uint64
+	)
+	for i := range valCount {
+		idx := math.ValidatorIndex(i)
+		val, err = st.ValidatorByIndex(idx)
+		if err != nil {
+			return err
+		}
+		if !val.IsActive(epoch) {
+			continue
+		}
+		score, err = st.GetInactivityScore(idx)
+		if err != nil {
+			return err
+		}
+		effectiveBalance := val.GetEffectiveBalance().Unwrap()
+
+		switch {
+		case score > threshold:
+			if err = st.DecreaseBalance(
+				idx, sp.inactivityPenalty(effectiveBalance, score),
+			); err != nil {
+				return err
+			}
+		case score == 0 && baseReward > 0:
+			if err = st.IncreaseBalance(
+				idx, math.Gwei(effectiveBalance/increment*baseReward),
+			); err != nil {
+				return err
+			}
+		default:
+			// Validators missing votes within the tolerated threshold
+			// are neither rewarded nor penalized.
+		}
+	}
+	return nil
+}
+
+// inactivityPenalty returns the penalty of a validator with the given
+// effective balance and inactivity score, expressed in missed slots. The
+// penalty grows with the number of epochs worth of missed votes.
+func (sp *StateProcessor[
+	_, _,
+]) inactivityPenalty(effectiveBalance, score uint64) math.Gwei {
+	quotient := sp.cs.InactivityPenaltyQuotient()
+	hi, lo := bits.Mul64(effectiveBalance, score)
+	if hi >= quotient {
+		// The penalty does not fit in 64 bits, so it drains the balance.
+		return math.Gwei(effectiveBalance)
+	}
+	penalty, _ := bits.Div64(hi, lo, quotient)
+	return math.Gwei(min(penalty/sp.cs.SlotsPerEpoch(), effectiveBalance))
+}
@@ -0,0 +1,233 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/chain-spec/chain"
+	"github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/primitives/bytes"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/constants"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/transition"
+	cmtcrypto "github.com/cometbft/cometbft/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+// rewardsDeposits returns the deposits of three validators one increment
+// below the max effective balance, so that rewards do not trigger partial
+// withdrawals.
+func rewardsDeposits(
+	cs chain.Spec[bytes.B4, math.U64, math.U64, any],
+) types.Deposits {
+	return threeDeposits(
+		math.Gwei(
+			cs.MaxEffectiveBalance(false)-cs.EffectiveBalanceIncrement(),
+		),
+		types.NewCredentialsFromExecutionAddress(common.ExecutionAddress{}),
+	)
+}
+
+// votesFor returns the votes of the given deposits' validators, with the
+// validator at index 0 missing its vote.
+func votesFor(deposits types.Deposits) []transition.Vote {
+	votes := make([]transition.Vote, 0, len(deposits))
+	for i, d := range deposits {
+		votes = append(votes, transition.Vote{
+			Address: cmtcrypto.AddressHash(d.Pubkey[:]),
+			Missed:  i == 0,
+		})
+	}
+	return votes
+}
+
+// transitionToSlot processes empty blocks until the state reaches the given
+// slot.
+func transitionToSlot(
+	t *testing.T,
+	sp *TestStateProcessorT,
+	st *TestBeaconStateT,
+	ctx *transition.Context,
+	deposits types.Deposits,
+	target math.Slot,
+) {
+	t.Helper()
+	for {
+		slot, err := st.GetSlot()
+		require.NoError(t, err)
+		if slot >= target {
+			return
+		}
+		transitionNextBlock(t, sp, st, ctx, deposits.HashTreeRoot())
+	}
+}
+
+func TestTransitionInactivityPenaltiesAndRewards(t *testing.T) {
+	const baseReward = 10
+	cs := setupForkChain(t, func(sd *testSpecData) {
+		sd.ElectraForkEpoch = 0
+		sd.RewardsForkEpoch = 0
+		sd.BaseRewardPerIncrement = baseReward
+	})
+	genDeposits := rewardsDeposits(cs)
+	sp, st, _, ctx := initGenesisState(t, cs, genDeposits, len(genDeposits))
+	ctx.Votes = votesFor(genDeposits)
+
+	var (
+		initBalance = math.Gwei(
+			cs.MaxEffectiveBalance(false) - cs.EffectiveBalanceIncrement(),
+		)
+		slotsPerEpoch = cs.SlotsPerEpoch()
+		epochReward   = initBalance / math.Gwei(cs.EffectiveBalanceIncrement()) *
+			baseReward
+		threshold = cs.MinEpochsToInactivityPenalty() * slotsPerEpoch
+	)
+
+	// STEP 1: below the threshold, the inactive validator is neither
+	// rewarded nor penalized while the others are rewarded every epoch
+	// but the genesis one.
+	penaltyEpoch := threshold/slotsPerEpoch + 1
+	transitionToSlot(t, sp, st, ctx, genDeposits,
+		math.Slot(penaltyEpoch*slotsPerEpoch-1),
+	)
+
+	score, err := st.GetInactivityScore(0)
+	require.NoError(t, err)
+	require.Equal(t, penaltyEpoch*slotsPerEpoch-1, score)
+	score, err = st.GetInactivityScore(1)
+	require.NoError(t, err)
+	require.Zero(t, score)
+
+	balance, err := st.GetBalance(0)
+	require.NoError(t, err)
+	require.Equal(t, initBalance, balance)
+	balance, err = st.GetBalance(1)
+	require.NoError(t, err)
+	require.Equal(
+		t, initBalance+math.Gwei(penaltyEpoch-2)*epochReward, balance,
+	)
+
+	// STEP 2: the epoch is processed by the next block, before its votes are
+	// counted, with a score exceeding the threshold so that the inactive
+	// validator is penalized.
+	transitionToSlot(t, sp, st, ctx, genDeposits,
+		math.Slot(penaltyEpoch*slotsPerEpoch),
+	)
+	score = penaltyEpoch*slotsPerEpoch - 1
+	require.Greater(t, score, threshold)
+	penalty := initBalance.Unwrap() * score /
+		cs.InactivityPenaltyQuotient() / slotsPerEpoch
+	require.NotZero(t, penalty)
+
+	balance, err = st.GetBalance(0)
+	require.NoError(t, err)
+	require.Equal(t, initBalance-math.Gwei(penalty), balance)
+	balance, err = st.GetBalance(2)
+	require.NoError(t, err)
+	require.Equal(
+		t, initBalance+math.Gwei(penaltyEpoch-1)*epochReward, balance,
+	)
+
+	// STEP 3: a vote cast decreases the inactivity score.
+	ctx.Votes[0].Missed = false
+	transitionNextBlock(t, sp, st, ctx, genDeposits.HashTreeRoot())
+	score, err = st.GetInactivityScore(0)
+	require.NoError(t, err)
+	require.Equal(t, penaltyEpoch*slotsPerEpoch-1, score)
+}
+
+func TestTransitionInactivityBeforeRewardsFork(t *testing.T) {
+	cs := setupForkChain(t, func(sd *testSpecData) {
+		sd.ElectraForkEpoch = 0
+		sd.RewardsForkEpoch = 10
+		sd.BaseRewardPerIncrement = 10
+	})
+	genDeposits := rewardsDeposits(cs)
+	sp, st, _, ctx := initGenesisState(t, cs, genDeposits, len(genDeposits))
+	ctx.Votes = votesFor(genDeposits)
+
+	transitionToSlot(t, sp, st, ctx, genDeposits,
+		math.Slot(2*cs.SlotsPerEpoch()),
+	)
+
+	initBalance := math.Gwei(
+		cs.MaxEffectiveBalance(false) - cs.EffectiveBalanceIncrement(),
+	)
+	for idx := range math.ValidatorIndex(len(genDeposits)) {
+		score, err := st.GetInactivityScore(idx)
+		require.NoError(t, err)
+		require.Zero(t, score)
+
+		balance, err := st.GetBalance(idx)
+		require.NoError(t, err)
+		require.Equal(t, initBalance, balance)
+	}
+}
+
+func TestTransitionEjection(t *testing.T) {
+	for _, tc := range []struct {
+		name             string
+		rewardsForkEpoch math.Epoch
+		ejected          bool
+	}{
+		{name: "after rewards fork", rewardsForkEpoch: 0, ejected: true},
+		{name: "before rewards fork", rewardsForkEpoch: 100, ejected: false},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			cs := setupForkChain(t, func(sd *testSpecData) {
+				sd.ElectraForkEpoch = 0
+				sd.RewardsForkEpoch = tc.rewardsForkEpoch
+				sd.BaseRewardPerIncrement = 0
+			})
+			genDeposits := rewardsDeposits(cs)
+			sp, st, _, ctx := initGenesisState(t, cs, genDeposits, len(genDeposits))
+
+			// Bring validator 0 down to the ejection balance.
+			ejectionBalance := math.Gwei(cs.EjectionBalance())
+			val, err := st.ValidatorByIndex(0)
+			require.NoError(t, err)
+			val.SetEffectiveBalance(ejectionBalance)
+			require.NoError(t, st.UpdateValidatorAtIndex(0, val))
+			require.NoError(t, st.SetBalance(0, ejectionBalance))
+
+			transitionToSlot(
+				t, sp, st, ctx, genDeposits, math.Slot(cs.SlotsPerEpoch()),
+			)
+
+			farFutureEpoch := math.Epoch(constants.FarFutureEpoch)
+			val, err = st.ValidatorByIndex(0)
+			require.NoError(t, err)
+			require.Equal(
+				t, tc.ejected, val.GetExitEpoch() != farFutureEpoch,
+			)
+			for idx := range math.ValidatorIndex(len(genDeposits)) {
+				if idx == 0 {
+					continue
+				}
+				val, err = st.ValidatorByIndex(idx)
+				require.NoError(t, err)
+				require.Equal(t, farFutureEpoch, val.GetExitEpoch())
+			}
+		})
+	}
+}
@@ -21,11 +21,210 @@
 package core
 
 import (
+	"math/bits"
+
+	"cosmossdk.io/collections"
 	"github.com/berachain/beacon-kit/config/spec"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/primitives/constants"
 	"github.com/berachain/beacon-kit/primitives/math"
 	statedb "github.com/berachain/beacon-kit/state-transition/core/state"
 )
 
+// isSlashingActive returns whether validators are slashed for double-signing
+// at the given slot. Slashing is gated behind SlashingForkEpoch so that
+// networks predating it keep their app hashes.
+func (sp *StateProcessor[
+	_, _,
+]) isSlashingActive(slot math.Slot) bool {
+	return sp.cs.SlotToEpoch(slot) >= sp.cs.SlashingForkEpoch()
+}
+
+// processEquivocations slashes the validators that consensus reports as
+// having double-signed.
+func (sp *StateProcessor[
+	ContextT, _,
+]) processEquivocations(
+	ctx ContextT,
+	st *statedb.StateDB,
+) error {
+	slot, err := st.GetSlot()
+	if err != nil {
+		return err
+	}
+	if !sp.isSlashingActive(slot) {
+		return nil
+	}
+
+	var (
+		idx   math.ValidatorIndex
+		exits = sp.newExitQueue(st, sp.cs.SlotToEpoch(slot))
+	)
+	for _, equivocation := range ctx.GetEquivocations() {
+		idx, err = st.ValidatorIndexByCometBFTAddress(equivocation.Address)
+		if errors.Is(err, collections.ErrNotFound) {
+			// The validator may have been removed from the registry
+			// since it double-signed.
+			sp.logger.Warn(
+				"Skipping equivocation of unknown validator",
+				"address", equivocation.Address,
+				"height", equivocation.Height,
+			)
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		if err = sp.slashValidator(st, idx, exits); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// slashValidator as defined in the Ethereum 2.0 specification, without the
+// whistleblower and proposer rewards. The validator exits through the given
+// exit queue.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#slash_validator
+func (sp *StateProcessor[
+	_, _,
+]) slashValidator(
+	st *statedb.StateDB,
+	idx math.ValidatorIndex,
+	exits *exitQueue,
+) error {
+	slot, err := st.GetSlot()
+	if err != nil {
+		return err
+	}
+	epoch := sp.cs.SlotToEpoch(slot)
+
+	val, err := st.ValidatorByIndex(idx)
+	if err != nil {
+		return err
+	}
+	if !val.IsSlashable(epoch) {
+		// The validator was already slashed, or can already withdraw.
+		return nil
+	}
+
+	// The validator exits at the epoch of the exit queue, just like
+	// validators ejected by the validator set cap.
+	if val.GetExitEpoch() == math.Epoch(constants.FarFutureEpoch) {
+		var exitEpoch math.Epoch
+		if exitEpoch, err = exits.next(); err != nil {
+			return err
+		}
+		val.SetExitEpoch(exitEpoch)
+		val.SetWithdrawableEpoch(exitEpoch + 1)
+	}
+	val.SetSlashed(true)
+	val.SetWithdrawableEpoch(max(
+		val.GetWithdrawableEpoch(),
+		epoch+math.Epoch(sp.cs.EpochsPerSlashingsVector()),
+	))
+	if err = st.UpdateValidatorAtIndex(idx, val); err != nil {
+		return err
+	}
+
+	// Record the slashed balance to compute the proportional penalty once
+	// the validator is halfway to being withdrawable.
+	effectiveBalance := val.GetEffectiveBalance()
+	index := epoch.Unwrap() % sp.cs.EpochsPerSlashingsVector()
+	slashing, err := st.GetSlashingAtIndex(index)
+	if err != nil {
+		return err
+	}
+	if err = st.UpdateSlashingAtIndex(
+		index, slashing+effectiveBalance,
+	); err != nil {
+		return err
+	}
+
+	sp.logger.Info(
+		"Slashing validator for double-signing",
+		"index", idx, "effective_balance", effectiveBalance,
+	)
+	return st.DecreaseBalance(
+		idx, effectiveBalance/math.Gwei(sp.cs.MinSlashingPenaltyQuotient()),
+	)
+}
+
+// processSlashings as defined in the Ethereum 2.0 specification.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#slashings
+func (sp *StateProcessor[
+	_, _,
+]) processSlashings(
+	st *statedb.StateDB,
+) error {
+	slot, err := st.GetSlot()
+	if err != nil {
+		return err
+	}
+	if !sp.isSlashingActive(slot) {
+		return nil
+	}
+
+	totalBalance, err := st.GetTotalActiveBalances(sp.cs.SlotsPerEpoch())
+	if err != nil {
+		return err
+	}
+	if totalBalance == 0 {
+		return nil
+	}
+	totalSlashing, err := st.GetTotalSlashing()
+	if err != nil {
+		return err
+	}
+
+	// Saturate rather than overflow, the adjusted total is capped by the
+	// total balance anyway.
+	multiplier := sp.cs.ProportionalSlashingMultiplier()
+	adjustedTotalSlashing := totalBalance
+	if hi, lo := bits.Mul64(totalSlashing.Unwrap(), multiplier); hi == 0 &&
+		lo < totalBalance.Unwrap() {
+		adjustedTotalSlashing = math.Gwei(lo)
+	}
+
+	vals, err := st.GetValidators()
+	if err != nil {
+		return err
+	}
+
+	var (
+		epoch      = sp.cs.SlotToEpoch(slot)
+		increment  = sp.cs.EffectiveBalanceIncrement()
+		halfVector = math.Epoch(sp.cs.EpochsPerSlashingsVector() / 2)
+		idx        math.ValidatorIndex
+	)
+	for _, val := range vals {
+		if !val.IsSlashed() ||
+			epoch+halfVector != val.GetWithdrawableEpoch() {
+			continue
+		}
+
+		// The product may not fit in 64 bits, but the quotient always does
+		// since the adjusted total slashing never exceeds the total balance.
+		hi, lo := bits.Mul64(
+			val.GetEffectiveBalance().Unwrap()/increment,
+			adjustedTotalSlashing.Unwrap(),
+		)
+		quotient, _ := bits.Div64(hi, lo, totalBalance.Unwrap())
+
+		idx, err = st.ValidatorIndexByPubkey(val.GetPubkey())
+		if err != nil {
+			return err
+		}
+		if err = st.DecreaseBalance(
+			idx, math.Gwei(quotient*increment),
+		); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // processSlashingsReset as defined in the Ethereum 2.0 specification.
 // https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#slashings-balances-updates
 func (sp *StateProcessor[
@@ -33,11 +232,11 @@ func (sp *StateProcessor[
 ]) processSlashingsReset(
 	st *statedb.StateDB,
 ) error {
-	// processSlashingsReset does not really do anything right now.
-	// However we cannot simply drop it because appHash accounts
+	// Before the slashing fork, processSlashingsReset does not really do
+	// anything. However we cannot simply drop it because appHash accounts
 	// for the list of operations carried out over the state
 	// even if the operations does not affect the final state
-	// (currently no slashing on beaconKit)
+	// (there was no slashing on beaconKit)
 
 	slot, err := st.GetSlot()
 	if err != nil {
@@ -45,6 +244,8 @@ func (sp *StateProcessor[
 	}
 
 	switch {
+	case sp.isSlashingActive(slot):
+		// slashings must be reset for the proportional penalties
 	case sp.cs.DepositEth1ChainID() == spec.BartioChainID:
 		// go head doing the processing
 	case sp.cs.DepositEth1ChainID() == spec.BoonetEth1ChainID &&
@@ -0,0 +1,138 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/transition"
+	cmtcrypto "github.com/cometbft/cometbft/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTransitionSlashEquivocatingValidator(t *testing.T) {
+	cs := setupForkChain(t, func(sd *testSpecData) {
+		sd.SlashingForkEpoch = 0
+	})
+	genDeposits := threeDeposits(
+		math.Gwei(cs.MaxEffectiveBalance(false)),
+		types.NewCredentialsFromExecutionAddress(common.ExecutionAddress{}),
+	)
+	sp, st, _, ctx := initGenesisState(t, cs, genDeposits, len(genDeposits))
+	depRoot := genDeposits.HashTreeRoot()
+
+	var (
+		maxBalance = math.Gwei(cs.MaxEffectiveBalance(false))
+		pubkey     = genDeposits[0].Pubkey
+		vector     = math.Epoch(cs.EpochsPerSlashingsVector())
+	)
+
+	// STEP 1: a block carrying double-signing evidence slashes the validator.
+	ctx.Equivocations = []transition.Equivocation{
+		{Address: cmtcrypto.AddressHash(pubkey[:]), Height: 1},
+	}
+	require.Empty(t, transitionNextBlock(t, sp, st, ctx, depRoot))
+
+	idx, err := st.ValidatorIndexByPubkey(pubkey)
+	require.NoError(t, err)
+	val, err := st.ValidatorByIndex(idx)
+	require.NoError(t, err)
+	require.True(t, val.IsSlashed())
+	require.Equal(t, math.Epoch(1), val.GetExitEpoch())
+	require.Equal(t, vector, val.GetWithdrawableEpoch())
+
+	initialPenalty := maxBalance / math.Gwei(cs.MinSlashingPenaltyQuotient())
+	balance, err := st.GetBalance(idx)
+	require.NoError(t, err)
+	require.Equal(t, maxBalance-initialPenalty, balance)
+
+	totalSlashing, err := st.GetTotalSlashing()
+	require.NoError(t, err)
+	require.Equal(t, maxBalance, totalSlashing)
+
+	// STEP 2: evidence for an already slashed validator is a no-op.
+	require.Empty(t, transitionNextBlock(t, sp, st, ctx, depRoot))
+	balance, err = st.GetBalance(idx)
+	require.NoError(t, err)
+	require.Equal(t, maxBalance-initialPenalty, balance)
+
+	// STEP 3: the validator leaves the consensus set at the epoch turn.
+	ctx.Equivocations = nil
+	for {
+		slot, errSlot := st.GetSlot()
+		require.NoError(t, errSlot)
+		valUpdates := transitionNextBlock(t, sp, st, ctx, depRoot)
+		if (slot.Unwrap()+1)%cs.SlotsPerEpoch() == 0 {
+			require.Equal(t, transition.ValidatorUpdates{
+				{Pubkey: pubkey, EffectiveBalance: 0},
+			}, valUpdates)
+			break
+		}
+		require.Empty(t, valUpdates)
+	}
+
+	// STEP 4: the proportional penalty applies halfway to withdrawability.
+	// The validators left active hold twice the slashed balance.
+	penaltyEpoch := vector - vector/2
+	for {
+		slot, errSlot := st.GetSlot()
+		require.NoError(t, errSlot)
+		if cs.SlotToEpoch(slot) > penaltyEpoch {
+			break
+		}
+		transitionNextBlock(t, sp, st, ctx, depRoot)
+	}
+
+	proportionalPenalty := maxBalance / 2
+	balance, err = st.GetBalance(idx)
+	require.NoError(t, err)
+	require.Equal(t, maxBalance-initialPenalty-proportionalPenalty, balance)
+}
+
+func TestTransitionEquivocationBeforeSlashingFork(t *testing.T) {
+	cs := setupForkChain(t, func(sd *testSpecData) {
+		sd.SlashingForkEpoch = 10
+	})
+	genDeposits := threeDeposits(
+		math.Gwei(cs.MaxEffectiveBalance(false)),
+		types.NewCredentialsFromExecutionAddress(common.ExecutionAddress{}),
+	)
+	sp, st, _, ctx := initGenesisState(t, cs, genDeposits, len(genDeposits))
+
+	pubkey := genDeposits[0].Pubkey
+	ctx.Equivocations = []transition.Equivocation{
+		{Address: cmtcrypto.AddressHash(pubkey[:]), Height: 1},
+	}
+	transitionNextBlock(t, sp, st, ctx, genDeposits.HashTreeRoot())
+
+	idx, err := st.ValidatorIndexByPubkey(pubkey)
+	require.NoError(t, err)
+	val, err := st.ValidatorByIndex(idx)
+	require.NoError(t, err)
+	require.False(t, val.IsSlashed())
+
+	balance, err := st.GetBalance(idx)
+	require.NoError(t, err)
+	require.Equal(t, math.Gwei(cs.MaxEffectiveBalance(false)), balance)
+}
@@ -29,6 +29,7 @@ import (
 	"github.com/berachain/beacon-kit/config/spec"
 	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
 	"github.com/berachain/beacon-kit/primitives/bytes"
+	"github.com/berachain/beacon-kit/primitives/constants"
 	"github.com/berachain/beacon-kit/primitives/math"
 	"github.com/berachain/beacon-kit/primitives/transition"
 	statedb "github.com/berachain/beacon-kit/state-transition/core/state"
@@ -69,22 +70,44 @@ func (sp *StateProcessor[
 		sp.cs.EjectionBalance() + sp.cs.EffectiveBalanceIncrement(),
 	)
 
-	// We do not currently have a cap on validator churn,
-	// so we can process validators activations in a single loop
-	var idx math.ValidatorIndex
+	// Until the churn limit fork there is no cap on validator churn, so we
+	// can process validators activations in a single loop. From then on,
+	// activations are dequeued from the activation queue below.
+	churnLimited := sp.isChurnLimitActive(currEpoch)
+
+	// From the rewards fork, penalties may bring the balance of an active
+	// validator down to EjectionBalance, in which case it is ejected as in
+	// the spec process_registry_updates.
+	var (
+		ejecting        = sp.isRewardsActive(slot)
+		ejectionBalance = math.Gwei(sp.cs.EjectionBalance())
+		farFutureEpoch  = math.Epoch(constants.FarFutureEpoch)
+		exits           = sp.newExitQueue(st, currEpoch)
+		idx             math.ValidatorIndex
+	)
 	for si, val := range vals {
 		valModified := false
 		if val.IsEligibleForActivationQueue(minEffectiveBalance) {
 			val.SetActivationEligibilityEpoch(nextEpoch)
 			valModified = true
 		}
-		if val.IsEligibleForActivation(currEpoch) {
+		if !churnLimited && val.IsEligibleForActivation(currEpoch) {
 			val.SetActivationEpoch(nextEpoch)
 			valModified = true
 		}
-		// Note: without slashing and voluntary withdrawals, there is no way
-		// for an activa validator to have its balance less or equal to
-		// EjectionBalance
+		if ejecting && val.IsActive(currEpoch) &&
+			val.GetEffectiveBalance() <= ejectionBalance &&
+			val.GetExitEpoch() == farFutureEpoch {
+			var exitEpoch math.Epoch
+			if exitEpoch, err = exits.next(); err != nil {
+				return fmt.Errorf(
+					"registry update, failed computing exit epoch: %w", err,
+				)
+			}
+			val.SetExitEpoch(exitEpoch)
+			val.SetWithdrawableEpoch(exitEpoch + 1)
+			valModified = true
+		}
 
 		if valModified {
 			idx, err = st.ValidatorIndexByPubkey(val.GetPubkey())
@@ -105,6 +128,12 @@ func (sp *StateProcessor[
 		}
 	}
 
+	if churnLimited {
+		if err = sp.processActivationQueue(st, vals, currEpoch); err != nil {
+			return err
+		}
+	}
+
 	// validators registry will be possibly further modified in order to enforce
 	// validators set cap. We will do that at the end of processEpoch, once all
 	// Eth 2.0 like transitions has been done (notable EffectiveBalances
@@ -126,7 +155,8 @@ func (sp *StateProcessor[
 	if err != nil {
 		return err
 	}
-	nextEpoch := sp.cs.SlotToEpoch(slot) + 1
+	currEpoch := sp.cs.SlotToEpoch(slot)
+	nextEpoch := currEpoch + 1
 
 	nextEpochVals, err := getActiveVals(sp.cs, st, nextEpoch)
 	if err != nil {
@@ -136,6 +166,17 @@ func (sp *StateProcessor[
 		)
 	}
 
+	// With the churn limit, validators may stay active for a few epochs
+	// after initiating their exit. They are already leaving the set, so they
+	// neither count towards the cap nor can be ejected again.
+	if sp.isChurnLimitActive(currEpoch) {
+		nextEpochVals = slices.DeleteFunc(
+			nextEpochVals, func(val *ctypes.Validator) bool {
+				return val.GetExitEpoch() != math.Epoch(constants.FarFutureEpoch)
+			},
+		)
+	}
+
 	if uint64(len(nextEpochVals)) <= sp.cs.ValidatorSetCap() {
 		// nothing to eject
 		return nil
@@ -161,13 +202,22 @@ func (sp *StateProcessor[
 		}
 	})
 
-	// We do not currently have a cap on validators churn, so we stop
-	// validators next epoch and we withdraw them the epoch after
-	var idx math.ValidatorIndex
+	// Until the churn limit fork we stop validators next epoch, otherwise at
+	// the epoch of the exit queue, and we withdraw them the epoch after
+	var (
+		idx       math.ValidatorIndex
+		exitEpoch math.Epoch
+		exits     = sp.newExitQueue(st, currEpoch)
+	)
 	for li := range uint64(len(nextEpochVals)) - sp.cs.ValidatorSetCap() {
 		valToEject := nextEpochVals[li]
-		valToEject.SetExitEpoch(nextEpoch)
-		valToEject.SetWithdrawableEpoch(nextEpoch + 1)
+		if exitEpoch, err = exits.next(); err != nil {
+			return fmt.Errorf(
+				"validators cap, failed computing exit epoch: %w", err,
+			)
+		}
+		valToEject.SetExitEpoch(exitEpoch)
+		valToEject.SetWithdrawableEpoch(exitEpoch + 1)
 		idx, err = st.ValidatorIndexByPubkey(valToEject.GetPubkey())
 		if err != nil {
 			return fmt.Errorf(
@@ -0,0 +1,259 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core
+
+import (
+	"cosmossdk.io/collections"
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/primitives/constants"
+	"github.com/berachain/beacon-kit/primitives/math"
+	statedb "github.com/berachain/beacon-kit/state-transition/core/state"
+)
+
+// isWithdrawalRequestsActive returns whether withdrawal requests made on the
+// execution layer are processed at the given slot. They are gated behind
+// WithdrawalRequestsForkEpoch so that networks predating it keep their app
+// hashes.
+func (sp *StateProcessor[
+	_, _,
+]) isWithdrawalRequestsActive(slot math.Slot) bool {
+	return sp.cs.SlotToEpoch(slot) >= sp.cs.WithdrawalRequestsForkEpoch()
+}
+
+// processWithdrawalRequests processes the requests made through the
+// withdrawal request contract, in the style of EIP-7002. Like deposits, the
+// requests are included in the block body by the proposer and every
+// validator checks them against the ones it fetched from the execution
+// layer, so that the outcome of the block does not depend on what a node
+// has fetched.
+func (sp *StateProcessor[
+	_, _,
+]) processWithdrawalRequests(
+	st *statedb.StateDB,
+	blk *ctypes.BeaconBlock,
+) error {
+	requests := blk.GetBody().GetWithdrawalRequests()
+	if !sp.isWithdrawalRequestsActive(blk.GetSlot()) {
+		if len(requests) != 0 {
+			return errors.Wrapf(
+				ErrWithdrawalRequestsBeforeFork,
+				"slot %d, requests %d", blk.GetSlot(), len(requests),
+			)
+		}
+		return nil
+	}
+
+	if err := sp.validateWithdrawalRequests(st, requests); err != nil {
+		return err
+	}
+	if len(requests) == 0 {
+		return nil
+	}
+
+	exits := sp.newExitQueue(st, sp.cs.SlotToEpoch(blk.GetSlot()))
+	for _, req := range requests {
+		if err := sp.processWithdrawalRequest(st, req, exits); err != nil {
+			return err
+		}
+	}
+	return st.SetNextWithdrawalRequestIndex(requests[len(requests)-1].Index + 1)
+}
+
+// validateWithdrawalRequests checks that the requests in a block are the
+// ones following the last processed request, as known to the local deposit
+// store.
+func (sp *StateProcessor[
+	_, _,
+]) validateWithdrawalRequests(
+	st *statedb.StateDB,
+	requests ctypes.WithdrawalRequests,
+) error {
+	//#nosec:G701 // can't overflow.
+	if maxRequests := sp.cs.MaxWithdrawalRequestsPerBlock(); uint64(
+		len(requests),
+	) > maxRequests {
+		return errors.Wrapf(
+			ErrExceedsBlockWithdrawalRequestLimit,
+			"expected: %d, got: %d", maxRequests, len(requests),
+		)
+	}
+
+	requestIndex, err := st.GetNextWithdrawalRequestIndex()
+	if err != nil {
+		return err
+	}
+	for i, req := range requests {
+		// request indices should be contiguous
+		//#nosec:G701 // can't overflow.
+		if req.Index != requestIndex+uint64(i) {
+			return errors.Wrapf(ErrWithdrawalRequestIndexOutOfOrder,
+				"withdrawal request index: %d, expected index: %d",
+				req.Index, requestIndex+uint64(i),
+			)
+		}
+	}
+
+	local, err := sp.ds.GetWithdrawalRequestsByIndex(
+		requestIndex, uint64(len(requests)),
+	)
+	if err != nil {
+		return err
+	}
+	if len(local) != len(requests) ||
+		!local.HashTreeRoot().Equals(requests.HashTreeRoot()) {
+		return errors.Wrapf(
+			ErrWithdrawalRequestsMismatch,
+			"from index %d, local requests: %d, block requests: %d",
+			requestIndex, len(local), len(requests),
+		)
+	}
+	return nil
+}
+
+// processWithdrawalRequest as defined in the Electra specification, extended
+// with withdrawal credentials changes. Requests that cannot be honoured are
+// skipped rather than rejected, as they are valid contract calls. Full exits
+// go through the given exit queue.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/electra/beacon-chain.md#new-process_withdrawal_request
+func (sp *StateProcessor[
+	_, _,
+]) processWithdrawalRequest(
+	st *statedb.StateDB,
+	req *ctypes.WithdrawalRequest,
+	exits *exitQueue,
+) error {
+	idx, err := st.ValidatorIndexByPubkey(req.ValidatorPubkey)
+	if errors.Is(err, collections.ErrNotFound) {
+		sp.logger.Warn(
+			"Skipping withdrawal request of unknown validator",
+			"pubkey", req.ValidatorPubkey,
+		)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	val, err := st.ValidatorByIndex(idx)
+	if err != nil {
+		return err
+	}
+
+	// Only the withdrawal address of the validator may make requests for it.
+	withdrawalAddr, err := val.GetWithdrawalCredentials().ToExecutionAddress()
+	if err != nil || withdrawalAddr != req.SourceAddress {
+		sp.logger.Warn(
+			"Skipping withdrawal request from unauthorized address",
+			"pubkey", req.ValidatorPubkey, "source", req.SourceAddress,
+		)
+		//nolint:nilerr // invalid requests are skipped, not rejected.
+		return nil
+	}
+
+	if req.IsCredentialsChange() {
+		val.SetWithdrawalCredentials(
+			ctypes.NewCredentialsFromExecutionAddress(req.NewWithdrawalAddress),
+		)
+		sp.logger.Info(
+			"Changing withdrawal credentials on withdrawal request",
+			"index", idx, "address", req.NewWithdrawalAddress,
+		)
+		return st.UpdateValidatorAtIndex(idx, val)
+	}
+
+	slot, err := st.GetSlot()
+	if err != nil {
+		return err
+	}
+	epoch := sp.cs.SlotToEpoch(slot)
+	if !val.IsActive(epoch) ||
+		val.GetExitEpoch() != math.Epoch(constants.FarFutureEpoch) {
+		// The validator is not active yet, or is already exiting.
+		return nil
+	}
+
+	if !req.IsFullExit() {
+		return sp.processPartialWithdrawalRequest(st, idx, req.Amount)
+	}
+
+	// The validator exits at the epoch of the exit queue, just like
+	// validators ejected by the validator set cap.
+	exitEpoch, err := exits.next()
+	if err != nil {
+		return err
+	}
+	val.SetExitEpoch(exitEpoch)
+	val.SetWithdrawableEpoch(exitEpoch + 1)
+	sp.logger.Info(
+		"Exiting validator on withdrawal request",
+		"index", idx, "exit_epoch", exitEpoch,
+	)
+	return st.UpdateValidatorAtIndex(idx, val)
+}
+
+// processPartialWithdrawalRequest adds the requested amount to the pending
+// partial withdrawal of the validator, which the withdrawal sweep pays out.
+// The amount is capped so that the validator keeps one effective balance
+// increment above the ejection balance.
+func (sp *StateProcessor[
+	_, _,
+]) processPartialWithdrawalRequest(
+	st *statedb.StateDB,
+	idx math.ValidatorIndex,
+	amount math.Gwei,
+) error {
+	balance, err := st.GetBalance(idx)
+	if err != nil {
+		return err
+	}
+	pending, err := st.GetPendingPartialWithdrawal(idx)
+	if err != nil {
+		return err
+	}
+
+	slot, err := st.GetSlot()
+	if err != nil {
+		return err
+	}
+	maxEffectiveBalance := math.Gwei(sp.cs.MaxEffectiveBalance(
+		statedb.IsPostFork3(sp.cs.DepositEth1ChainID(), slot),
+	))
+	minBalance := math.Gwei(
+		sp.cs.EjectionBalance() + sp.cs.EffectiveBalanceIncrement(),
+	)
+
+	// Balance in excess of the max effective balance is paid out by the
+	// sweep regardless of requests.
+	withdrawable := min(balance, maxEffectiveBalance)
+	if withdrawable <= minBalance+pending {
+		sp.logger.Warn(
+			"Skipping partial withdrawal request without withdrawable balance",
+			"index", idx, "amount", amount,
+		)
+		return nil
+	}
+	toWithdraw := min(amount, withdrawable-minBalance-pending)
+	sp.logger.Info(
+		"Queueing partial withdrawal on withdrawal request",
+		"index", idx, "amount", toWithdraw,
+	)
+	return st.SetPendingPartialWithdrawal(idx, pending+toWithdraw)
+}
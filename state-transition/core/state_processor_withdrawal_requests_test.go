@@ -0,0 +1,447 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/chain-spec/chain"
+	"github.com/berachain/beacon-kit/consensus-types/types"
+	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
+	"github.com/berachain/beacon-kit/primitives/bytes"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/constants"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/transition"
+	"github.com/berachain/beacon-kit/state-transition/core"
+	"github.com/stretchr/testify/require"
+)
+
+// requestsDeposits returns the deposits of three validators at max effective
+// balance withdrawing to the given address.
+func requestsDeposits(
+	cs chain.Spec[bytes.B4, math.U64, math.U64, any],
+	withdrawalAddr common.ExecutionAddress,
+) types.Deposits {
+	return threeDeposits(
+		math.Gwei(cs.MaxEffectiveBalance(false)),
+		types.NewCredentialsFromExecutionAddress(withdrawalAddr),
+	)
+}
+
+// transitionRequestsBlock processes the next block including the given
+// withdrawal requests, proposed by the validator at index 1.
+func transitionRequestsBlock(
+	t *testing.T,
+	sp *TestStateProcessorT,
+	st *TestBeaconStateT,
+	ctx *transition.Context,
+	depRoot common.Root,
+	requests types.WithdrawalRequests,
+	withdrawals ...*engineprimitives.Withdrawal,
+) (transition.ValidatorUpdates, error) {
+	t.Helper()
+	blk := buildTestBlock(t, st, depRoot, 0, nil, withdrawals...)
+	blk.ProposerIndex = 1
+	blk.Body.WithdrawalRequests = requests
+	return sp.Transition(ctx, st, blk)
+}
+
+func TestTransitionWithdrawalRequestExitsValidator(t *testing.T) {
+	var (
+		withdrawalAddr = common.ExecutionAddress{0xaa}
+		otherAddr      = common.ExecutionAddress{0xbb}
+	)
+	cs := setupForkChain(t, func(sd *testSpecData) {
+		sd.ElectraForkEpoch = 0
+		sd.WithdrawalRequestsForkEpoch = 0
+	})
+	genDeposits := requestsDeposits(cs, withdrawalAddr)
+	sp, st, ds, ctx := initGenesisState(t, cs, genDeposits, len(genDeposits))
+	depRoot := genDeposits.HashTreeRoot()
+	maxBalance := math.Gwei(cs.MaxEffectiveBalance(false))
+
+	// STEP 1: the first block includes a full exit request for validator 0,
+	// along with requests that must be ignored: one from an address other
+	// than the withdrawal one and a duplicate.
+	requests := types.WithdrawalRequests{
+		types.NewWithdrawalRequest(
+			withdrawalAddr, genDeposits[0].Pubkey,
+			types.FullExitRequestAmount, 0,
+		),
+		types.NewWithdrawalRequest(
+			otherAddr, genDeposits[1].Pubkey,
+			types.FullExitRequestAmount, 1,
+		),
+		types.NewWithdrawalRequest(
+			withdrawalAddr, genDeposits[0].Pubkey,
+			types.FullExitRequestAmount, 2,
+		),
+	}
+	require.NoError(t, ds.EnqueueWithdrawalRequests(requests))
+	valUpdates, err := transitionRequestsBlock(
+		t, sp, st, ctx, depRoot, requests,
+	)
+	require.NoError(t, err)
+	require.Empty(t, valUpdates)
+
+	nextIndex, err := st.GetNextWithdrawalRequestIndex()
+	require.NoError(t, err)
+	require.Equal(t, uint64(len(requests)), nextIndex)
+
+	val, err := st.ValidatorByIndex(0)
+	require.NoError(t, err)
+	require.Equal(t, math.Epoch(1), val.GetExitEpoch())
+	require.Equal(t, math.Epoch(2), val.GetWithdrawableEpoch())
+	val, err = st.ValidatorByIndex(1)
+	require.NoError(t, err)
+	require.Equal(t, math.Epoch(constants.FarFutureEpoch), val.GetExitEpoch())
+
+	// STEP 2: the validator leaves the consensus set at the epoch turn.
+	for {
+		slot, errSlot := st.GetSlot()
+		require.NoError(t, errSlot)
+		valUpdates = transitionNextBlock(t, sp, st, ctx, depRoot)
+		if (slot.Unwrap()+1)%cs.SlotsPerEpoch() == 0 {
+			require.Equal(t, transition.ValidatorUpdates{
+				{Pubkey: genDeposits[0].Pubkey, EffectiveBalance: 0},
+			}, valUpdates)
+			break
+		}
+		require.Empty(t, valUpdates)
+	}
+
+	// STEP 3: the withdrawal sweep pays the validator out once it becomes
+	// withdrawable.
+	for {
+		slot, errSlot := st.GetSlot()
+		require.NoError(t, errSlot)
+		if (slot.Unwrap()+1)%cs.SlotsPerEpoch() == 0 {
+			break
+		}
+		transitionNextBlock(t, sp, st, ctx, depRoot)
+	}
+	_, err = transitionTestBlock(
+		t, sp, st, ctx, depRoot, 0,
+		&engineprimitives.Withdrawal{
+			Index:     0,
+			Validator: 0,
+			Address:   withdrawalAddr,
+			Amount:    maxBalance,
+		},
+	)
+	require.NoError(t, err)
+
+	balance, err := st.GetBalance(0)
+	require.NoError(t, err)
+	require.Zero(t, balance)
+}
+
+// TestTransitionWithdrawalRequestsExitQueue shows that full exits requested
+// in the same block are queued by the exit churn limit.
+func TestTransitionWithdrawalRequestsExitQueue(t *testing.T) {
+	withdrawalAddr := common.ExecutionAddress{0xaa}
+	cs := setupForkChain(t, func(sd *testSpecData) {
+		sd.ElectraForkEpoch = 0
+		sd.WithdrawalRequestsForkEpoch = 0
+		sd.ChurnLimitForkEpoch = 0
+		sd.ExitChurnLimit = 1
+	})
+	genDeposits := requestsDeposits(cs, withdrawalAddr)
+	sp, st, ds, ctx := initGenesisState(t, cs, genDeposits, len(genDeposits))
+	depRoot := genDeposits.HashTreeRoot()
+
+	requests := types.WithdrawalRequests{
+		types.NewWithdrawalRequest(
+			withdrawalAddr, genDeposits[0].Pubkey,
+			types.FullExitRequestAmount, 0,
+		),
+		types.NewWithdrawalRequest(
+			withdrawalAddr, genDeposits[1].Pubkey,
+			types.FullExitRequestAmount, 1,
+		),
+	}
+	require.NoError(t, ds.EnqueueWithdrawalRequests(requests))
+	_, err := transitionRequestsBlock(t, sp, st, ctx, depRoot, requests)
+	require.NoError(t, err)
+
+	for i, expectedExitEpoch := range []math.Epoch{
+		1, 2, math.Epoch(constants.FarFutureEpoch),
+	} {
+		var val *types.Validator
+		val, err = st.ValidatorByIndex(math.ValidatorIndex(i))
+		require.NoError(t, err)
+		require.Equal(t, expectedExitEpoch, val.GetExitEpoch())
+	}
+}
+
+func TestTransitionWithdrawalRequestInvalid(t *testing.T) {
+	withdrawalAddr := common.ExecutionAddress{0xaa}
+	cs := setupForkChain(t, func(sd *testSpecData) {
+		sd.ElectraForkEpoch = 0
+		sd.WithdrawalRequestsForkEpoch = 0
+	})
+	genDeposits := requestsDeposits(cs, withdrawalAddr)
+	exitRequest := func(index uint64) *types.WithdrawalRequest {
+		return types.NewWithdrawalRequest(
+			withdrawalAddr, genDeposits[0].Pubkey,
+			types.FullExitRequestAmount, index,
+		)
+	}
+
+	tests := []struct {
+		name    string
+		local   types.WithdrawalRequests
+		block   types.WithdrawalRequests
+		wantErr error
+	}{
+		{
+			name:    "not fetched locally",
+			block:   types.WithdrawalRequests{exitRequest(0)},
+			wantErr: core.ErrWithdrawalRequestsMismatch,
+		},
+		{
+			name:  "different from local",
+			local: types.WithdrawalRequests{exitRequest(0)},
+			block: types.WithdrawalRequests{
+				types.NewWithdrawalRequest(
+					withdrawalAddr, genDeposits[1].Pubkey,
+					types.FullExitRequestAmount, 0,
+				),
+			},
+			wantErr: core.ErrWithdrawalRequestsMismatch,
+		},
+		{
+			name:    "skipped index",
+			local:   types.WithdrawalRequests{exitRequest(0), exitRequest(1)},
+			block:   types.WithdrawalRequests{exitRequest(1)},
+			wantErr: core.ErrWithdrawalRequestIndexOutOfOrder,
+		},
+		{
+			name: "above limit",
+			block: func() types.WithdrawalRequests {
+				requests := make(
+					types.WithdrawalRequests,
+					cs.MaxWithdrawalRequestsPerBlock()+1,
+				)
+				for i := range requests {
+					//#nosec:G701 // can't overflow.
+					requests[i] = exitRequest(uint64(i))
+				}
+				return requests
+			}(),
+			wantErr: core.ErrExceedsBlockWithdrawalRequestLimit,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sp, st, ds, ctx := initGenesisState(
+				t, cs, genDeposits, len(genDeposits),
+			)
+			require.NoError(t, ds.EnqueueWithdrawalRequests(tt.local))
+			_, err := transitionRequestsBlock(
+				t, sp, st, ctx, genDeposits.HashTreeRoot(), tt.block,
+			)
+			require.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestTransitionWithdrawalRequestBeforeFork(t *testing.T) {
+	withdrawalAddr := common.ExecutionAddress{0xaa}
+	cs := setupForkChain(t, func(sd *testSpecData) {
+		sd.ElectraForkEpoch = 0
+		sd.WithdrawalRequestsForkEpoch = 10
+	})
+	genDeposits := requestsDeposits(cs, withdrawalAddr)
+	sp, st, ds, ctx := initGenesisState(t, cs, genDeposits, len(genDeposits))
+
+	requests := types.WithdrawalRequests{
+		types.NewWithdrawalRequest(
+			withdrawalAddr, genDeposits[0].Pubkey,
+			types.FullExitRequestAmount, 0,
+		),
+	}
+	require.NoError(t, ds.EnqueueWithdrawalRequests(requests))
+	_, err := transitionRequestsBlock(
+		t, sp, st, ctx, genDeposits.HashTreeRoot(), requests,
+	)
+	require.ErrorIs(t, err, core.ErrWithdrawalRequestsBeforeFork)
+}
+
+func TestTransitionCredentialsChangeRequest(t *testing.T) {
+	var (
+		withdrawalAddr = common.ExecutionAddress{0xaa}
+		newAddr        = common.ExecutionAddress{0xcc}
+	)
+	cs := setupForkChain(t, func(sd *testSpecData) {
+		sd.ElectraForkEpoch = 0
+		sd.WithdrawalRequestsForkEpoch = 0
+	})
+	genDeposits := requestsDeposits(cs, withdrawalAddr)
+	sp, st, ds, ctx := initGenesisState(t, cs, genDeposits, len(genDeposits))
+
+	// The second request comes from the former withdrawal address, which no
+	// longer controls the validator.
+	requests := types.WithdrawalRequests{
+		types.NewCredentialsChangeRequest(
+			withdrawalAddr, genDeposits[2].Pubkey, newAddr, 0,
+		),
+		types.NewWithdrawalRequest(
+			withdrawalAddr, genDeposits[2].Pubkey,
+			types.FullExitRequestAmount, 1,
+		),
+	}
+	require.NoError(t, ds.EnqueueWithdrawalRequests(requests))
+	_, err := transitionRequestsBlock(
+		t, sp, st, ctx, genDeposits.HashTreeRoot(), requests,
+	)
+	require.NoError(t, err)
+
+	val, err := st.ValidatorByIndex(2)
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		types.NewCredentialsFromExecutionAddress(newAddr),
+		val.GetWithdrawalCredentials(),
+	)
+	require.Equal(t, math.Epoch(constants.FarFutureEpoch), val.GetExitEpoch())
+}
+
+func TestTransitionPartialWithdrawalRequest(t *testing.T) {
+	withdrawalAddr := common.ExecutionAddress{0xaa}
+	cs := setupForkChain(t, func(sd *testSpecData) {
+		sd.ElectraForkEpoch = 0
+		sd.WithdrawalRequestsForkEpoch = 0
+	})
+	genDeposits := requestsDeposits(cs, withdrawalAddr)
+	sp, st, ds, ctx := initGenesisState(t, cs, genDeposits, len(genDeposits))
+	depRoot := genDeposits.HashTreeRoot()
+
+	// The validator keeps one increment above the ejection balance, so the
+	// request is capped.
+	var (
+		maxBalance = math.Gwei(cs.MaxEffectiveBalance(false))
+		minBalance = math.Gwei(
+			cs.EjectionBalance() + cs.EffectiveBalanceIncrement(),
+		)
+		requests = types.WithdrawalRequests{
+			types.NewWithdrawalRequest(
+				withdrawalAddr, genDeposits[2].Pubkey, maxBalance, 0,
+			),
+		}
+	)
+	require.NoError(t, ds.EnqueueWithdrawalRequests(requests))
+	_, err := transitionRequestsBlock(t, sp, st, ctx, depRoot, requests)
+	require.NoError(t, err)
+
+	pending, err := st.GetPendingPartialWithdrawal(2)
+	require.NoError(t, err)
+	require.Equal(t, maxBalance-minBalance, pending)
+
+	// The next block pays out the pending amount.
+	_, err = transitionTestBlock(
+		t, sp, st, ctx, depRoot, 0,
+		&engineprimitives.Withdrawal{
+			Index:     0,
+			Validator: 2,
+			Address:   withdrawalAddr,
+			Amount:    pending,
+		},
+	)
+	require.NoError(t, err)
+
+	balance, err := st.GetBalance(2)
+	require.NoError(t, err)
+	require.Equal(t, minBalance, balance)
+	pending, err = st.GetPendingPartialWithdrawal(2)
+	require.NoError(t, err)
+	require.Zero(t, pending)
+	val, err := st.ValidatorByIndex(2)
+	require.NoError(t, err)
+	require.Equal(t, math.Epoch(constants.FarFutureEpoch), val.GetExitEpoch())
+}
+
+func TestTransitionPartialWithdrawalRequestAcrossSweeps(t *testing.T) {
+	withdrawalAddr := common.ExecutionAddress{0xaa}
+	cs := setupForkChain(t, func(sd *testSpecData) {
+		sd.ElectraForkEpoch = 0
+		sd.WithdrawalRequestsForkEpoch = 0
+	})
+	genDeposits := requestsDeposits(cs, withdrawalAddr)
+	sp, st, ds, ctx := initGenesisState(t, cs, genDeposits, len(genDeposits))
+	depRoot := genDeposits.HashTreeRoot()
+
+	var (
+		maxBalance = math.Gwei(cs.MaxEffectiveBalance(false))
+		minBalance = math.Gwei(
+			cs.EjectionBalance() + cs.EffectiveBalanceIncrement(),
+		)
+		shortfall = math.Gwei(cs.EffectiveBalanceIncrement())
+		requests  = types.WithdrawalRequests{
+			types.NewWithdrawalRequest(
+				withdrawalAddr, genDeposits[2].Pubkey, maxBalance, 0,
+			),
+		}
+	)
+	require.NoError(t, ds.EnqueueWithdrawalRequests(requests))
+	_, err := transitionRequestsBlock(t, sp, st, ctx, depRoot, requests)
+	require.NoError(t, err)
+	requested, err := st.GetPendingPartialWithdrawal(2)
+	require.NoError(t, err)
+	require.Equal(t, maxBalance-minBalance, requested)
+
+	// The balance drops before the sweep, which can then only pay part of
+	// the request. The rest stays pending.
+	require.NoError(t, st.DecreaseBalance(2, shortfall))
+	_, err = transitionTestBlock(
+		t, sp, st, ctx, depRoot, 0,
+		&engineprimitives.Withdrawal{
+			Index:     0,
+			Validator: 2,
+			Address:   withdrawalAddr,
+			Amount:    requested - shortfall,
+		},
+	)
+	require.NoError(t, err)
+	pending, err := st.GetPendingPartialWithdrawal(2)
+	require.NoError(t, err)
+	require.Equal(t, shortfall, pending)
+
+	// Once the balance is back, the next sweep pays out the rest.
+	require.NoError(t, st.IncreaseBalance(2, shortfall))
+	_, err = transitionTestBlock(
+		t, sp, st, ctx, depRoot, 1,
+		&engineprimitives.Withdrawal{
+			Index:     1,
+			Validator: 2,
+			Address:   withdrawalAddr,
+			Amount:    shortfall,
+		},
+	)
+	require.NoError(t, err)
+	pending, err = st.GetPendingPartialWithdrawal(2)
+	require.NoError(t, err)
+	require.Zero(t, pending)
+	balance, err := st.GetBalance(2)
+	require.NoError(t, err)
+	require.Equal(t, minBalance, balance)
+}
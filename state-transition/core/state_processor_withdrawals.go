@@ -154,6 +154,11 @@ func (sp *StateProcessor[
 		); err != nil {
 			return err
 		}
+		if err := sp.deductPendingPartialWithdrawal(
+			st, wd, slot,
+		); err != nil {
+			return err
+		}
 	}
 
 	if len(expectedWithdrawals) != 0 {
@@ -233,6 +238,11 @@ func (sp *StateProcessor[
 		); err != nil {
 			return err
 		}
+		if err := sp.deductPendingPartialWithdrawal(
+			st, expectedWithdrawals[i], slot,
+		); err != nil {
+			return err
+		}
 	}
 
 	if numWithdrawals > 1 {
@@ -285,3 +295,42 @@ func (sp *StateProcessor[
 
 	return nil
 }
+
+// deductPendingPartialWithdrawal deducts the given withdrawal, once paid out,
+// from the pending partial withdrawal of its validator. The part of the
+// withdrawal taken from the balance above the max effective balance is not
+// deducted, as the sweep pays it regardless of any request. Whatever the
+// sweep could not pay, because of the minimum balance left to the validator,
+// stays pending for the next sweeps. A full withdrawal settles the request.
+func (sp *StateProcessor[
+	_, _,
+]) deductPendingPartialWithdrawal(
+	st *state.StateDB,
+	wd *engineprimitives.Withdrawal,
+	slot math.Slot,
+) error {
+	idx := wd.GetValidatorIndex()
+	pending, err := st.GetPendingPartialWithdrawal(idx)
+	if err != nil || pending == 0 {
+		return err
+	}
+	balance, err := st.GetBalance(idx)
+	if err != nil {
+		return err
+	}
+	if balance == 0 {
+		return st.SetPendingPartialWithdrawal(idx, 0)
+	}
+
+	var (
+		paid                = wd.GetAmount()
+		balanceBefore       = balance + paid
+		maxEffectiveBalance = math.Gwei(sp.cs.MaxEffectiveBalance(
+			state.IsPostFork3(sp.cs.DepositEth1ChainID(), slot),
+		))
+	)
+	if balanceBefore > maxEffectiveBalance {
+		paid -= min(paid, balanceBefore-maxEffectiveBalance)
+	}
+	return st.SetPendingPartialWithdrawal(idx, pending-min(pending, paid))
+}
@@ -30,6 +30,7 @@ import (
 	"github.com/berachain/beacon-kit/primitives/constraints"
 	"github.com/berachain/beacon-kit/primitives/crypto"
 	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/transition"
 	"github.com/karalabe/ssz"
 )
 
@@ -56,6 +57,12 @@ type Context interface {
 	// GetConsensusTime returns the timestamp of current consensus request.
 	// It is used to build next payload and to validate currentpayload.
 	GetConsensusTime() math.U64
+	// GetEquivocations returns the double-signing evidence included by
+	// consensus in the current block.
+	GetEquivocations() []transition.Equivocation
+	// GetVotes returns the participation of validators in the commit of
+	// the previous block.
+	GetVotes() []transition.Vote
 }
 
 // DepositStore defines the interface for deposit storage.
@@ -65,6 +72,12 @@ type DepositStore interface {
 		startIndex uint64,
 		numView uint64,
 	) (ctypes.Deposits, error)
+	// GetWithdrawalRequestsByIndex returns `numView` expected withdrawal
+	// requests.
+	GetWithdrawalRequestsByIndex(
+		startIndex uint64,
+		numView uint64,
+	) (ctypes.WithdrawalRequests, error)
 }
 
 // Withdrawals defines the interface for managing withdrawal operations.
@@ -0,0 +1,78 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package beacondb
+
+import (
+	"cosmossdk.io/collections"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/primitives/math"
+)
+
+// GetInactivityScore retrieves the inactivity score of the validator at the
+// given index. Validators without a recorded score have a score of zero.
+func (kv *KVStore) GetInactivityScore(
+	idx math.ValidatorIndex,
+) (uint64, error) {
+	score, err := kv.inactivityScores.Get(kv.ctx, idx.Unwrap())
+	if errors.Is(err, collections.ErrNotFound) {
+		return 0, nil
+	} else if err != nil {
+		return 0, err
+	}
+	return score, nil
+}
+
+// SetInactivityScore sets the inactivity score of the validator at the given
+// index.
+func (kv *KVStore) SetInactivityScore(
+	idx math.ValidatorIndex,
+	score uint64,
+) error {
+	return kv.inactivityScores.Set(kv.ctx, idx.Unwrap(), score)
+}
+
+// GetInactivityScores retrieves the inactivity scores of the first
+// numValidators validators in a single pass over the store. Validators
+// without a recorded score have a score of zero.
+func (kv *KVStore) GetInactivityScores(
+	numValidators uint64,
+) (scores []uint64, err error) {
+	scores = make([]uint64, numValidators)
+	iter, err := kv.inactivityScores.Iterate(kv.ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		err = errors.Join(err, iter.Close())
+	}()
+
+	var entry collections.KeyValue[uint64, uint64]
+	for ; iter.Valid(); iter.Next() {
+		entry, err = iter.KeyValue()
+		if err != nil {
+			return nil, err
+		}
+		if entry.Key < numValidators {
+			scores[entry.Key] = entry.Value
+		}
+	}
+	return scores, nil
+}
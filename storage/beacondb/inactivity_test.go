@@ -0,0 +1,50 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package beacondb_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestInactivityScores(t *testing.T) {
+	store, err := initTestStore()
+	require.NoError(t, err)
+
+	// Validators without a recorded score have a score of zero.
+	scores, err := store.GetInactivityScores(3)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{0, 0, 0}, scores)
+
+	require.NoError(t, store.SetInactivityScore(1, 7))
+	require.NoError(t, store.SetInactivityScore(2, 9))
+	require.NoError(t, store.SetInactivityScore(5, 11))
+
+	// Scores past the number of validators are left out.
+	scores, err = store.GetInactivityScores(3)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{0, 7, 9}, scores)
+
+	score, err := store.GetInactivityScore(2)
+	require.NoError(t, err)
+	require.Equal(t, uint64(9), score)
+}
@@ -44,6 +44,9 @@ const (
 	NextWithdrawalIndexPrefix
 	NextWithdrawalValidatorIndexPrefix
 	ForkPrefix
+	InactivityScoresPrefix
+	NextWithdrawalRequestIndexPrefix
+	PendingPartialWithdrawalsPrefix
 )
 
 const (
@@ -70,4 +73,7 @@ const (
 	NextWithdrawalIndexPrefixHumanReadable              = "NextWithdrawalIndexPrefix"
 	NextWithdrawalValidatorIndexPrefixHumanReadable     = "NextWithdrawalValidatorIndexPrefix"
 	ForkPrefixHumanReadable                             = "ForkPrefix"
+	InactivityScoresPrefixHumanReadable                 = "InactivityScoresPrefix"
+	NextWithdrawalRequestIndexPrefixHumanReadable       = "NextWithdrawalRequestIndexPrefix"
+	PendingPartialWithdrawalsPrefixHumanReadable        = "PendingPartialWithdrawalsPrefix"
 )
@@ -87,6 +87,16 @@ type KVStore struct {
 	slashings sdkcollections.Map[uint64, uint64]
 	// totalSlashing stores the total slashing in the vector range.
 	totalSlashing sdkcollections.Item[uint64]
+	// Rewards and penalties
+	// inactivityScores stores the inactivity score of each validator.
+	inactivityScores sdkcollections.Map[uint64, uint64]
+	// Withdrawal requests
+	// nextWithdrawalRequestIndex stores the index of the next withdrawal
+	// request to process.
+	nextWithdrawalRequestIndex sdkcollections.Item[uint64]
+	// pendingPartialWithdrawals stores the amount each validator requested
+	// to withdraw and is yet to be paid out.
+	pendingPartialWithdrawals sdkcollections.Map[uint64, uint64]
 }
 
 // New creates a new instance of Store.
@@ -214,6 +224,30 @@ func New(
 			keys.TotalSlashingPrefixHumanReadable,
 			sdkcollections.Uint64Value,
 		),
+		inactivityScores: sdkcollections.NewMap(
+			schemaBuilder,
+			sdkcollections.NewPrefix([]byte{keys.InactivityScoresPrefix}),
+			keys.InactivityScoresPrefixHumanReadable,
+			sdkcollections.Uint64Key,
+			sdkcollections.Uint64Value,
+		),
+		nextWithdrawalRequestIndex: sdkcollections.NewItem(
+			schemaBuilder,
+			sdkcollections.NewPrefix(
+				[]byte{keys.NextWithdrawalRequestIndexPrefix},
+			),
+			keys.NextWithdrawalRequestIndexPrefixHumanReadable,
+			sdkcollections.Uint64Value,
+		),
+		pendingPartialWithdrawals: sdkcollections.NewMap(
+			schemaBuilder,
+			sdkcollections.NewPrefix(
+				[]byte{keys.PendingPartialWithdrawalsPrefix},
+			),
+			keys.PendingPartialWithdrawalsPrefixHumanReadable,
+			sdkcollections.Uint64Key,
+			sdkcollections.Uint64Value,
+		),
 		latestBlockHeader: sdkcollections.NewItem(
 			schemaBuilder,
 			sdkcollections.NewPrefix(
@@ -0,0 +1,98 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package beacondb
+
+import (
+	"cosmossdk.io/collections"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/primitives/math"
+)
+
+// GetNextWithdrawalRequestIndex returns the index of the next withdrawal
+// request to process, zero until a request has been processed.
+func (kv *KVStore) GetNextWithdrawalRequestIndex() (uint64, error) {
+	index, err := kv.nextWithdrawalRequestIndex.Get(kv.ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return 0, nil
+	}
+	return index, err
+}
+
+// SetNextWithdrawalRequestIndex sets the index of the next withdrawal request
+// to process.
+func (kv *KVStore) SetNextWithdrawalRequestIndex(index uint64) error {
+	return kv.nextWithdrawalRequestIndex.Set(kv.ctx, index)
+}
+
+// GetPendingPartialWithdrawal retrieves the amount the validator at the given
+// index requested to withdraw and is yet to be paid out.
+func (kv *KVStore) GetPendingPartialWithdrawal(
+	idx math.ValidatorIndex,
+) (math.Gwei, error) {
+	amount, err := kv.pendingPartialWithdrawals.Get(kv.ctx, idx.Unwrap())
+	if errors.Is(err, collections.ErrNotFound) {
+		return 0, nil
+	} else if err != nil {
+		return 0, err
+	}
+	return math.Gwei(amount), nil
+}
+
+// SetPendingPartialWithdrawal sets the amount the validator at the given
+// index is yet to be paid out. A zero amount is removed from the store.
+func (kv *KVStore) SetPendingPartialWithdrawal(
+	idx math.ValidatorIndex,
+	amount math.Gwei,
+) error {
+	if amount == 0 {
+		return kv.pendingPartialWithdrawals.Remove(kv.ctx, idx.Unwrap())
+	}
+	return kv.pendingPartialWithdrawals.Set(
+		kv.ctx, idx.Unwrap(), amount.Unwrap(),
+	)
+}
+
+// GetPendingPartialWithdrawals retrieves the amounts the first numValidators
+// validators are yet to be paid out, in a single pass over the store.
+func (kv *KVStore) GetPendingPartialWithdrawals(
+	numValidators uint64,
+) (amounts []uint64, err error) {
+	amounts = make([]uint64, numValidators)
+	iter, err := kv.pendingPartialWithdrawals.Iterate(kv.ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		err = errors.Join(err, iter.Close())
+	}()
+
+	var entry collections.KeyValue[uint64, uint64]
+	for ; iter.Valid(); iter.Next() {
+		entry, err = iter.KeyValue()
+		if err != nil {
+			return nil, err
+		}
+		if entry.Key < numValidators {
+			amounts[entry.Key] = entry.Value
+		}
+	}
+	return amounts, nil
+}
@@ -0,0 +1,51 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package beacondb_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPendingPartialWithdrawals(t *testing.T) {
+	store, err := initTestStore()
+	require.NoError(t, err)
+
+	amounts, err := store.GetPendingPartialWithdrawals(3)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{0, 0, 0}, amounts)
+
+	require.NoError(t, store.SetPendingPartialWithdrawal(0, 5))
+	require.NoError(t, store.SetPendingPartialWithdrawal(2, 8))
+	require.NoError(t, store.SetPendingPartialWithdrawal(4, 13))
+
+	// Amounts past the number of validators are left out.
+	amounts, err = store.GetPendingPartialWithdrawals(3)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{5, 0, 8}, amounts)
+
+	// A zero amount removes the pending withdrawal.
+	require.NoError(t, store.SetPendingPartialWithdrawal(2, 0))
+	amounts, err = store.GetPendingPartialWithdrawals(3)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{5, 0, 0}, amounts)
+}
@@ -0,0 +1,93 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package block
+
+import (
+	"context"
+
+	"cosmossdk.io/core/store"
+)
+
+// batchKey is the context key of the batch which the writes of the store are
+// buffered in.
+type batchKey struct{}
+
+// batchStoreService opens the KV store of the underlying service, with its
+// writes going to the batch of the context, if any, so that they can be
+// committed at once.
+type batchStoreService struct {
+	store.KVStoreService
+}
+
+// OpenKVStore opens the KV store for the given context.
+func (s batchStoreService) OpenKVStore(ctx context.Context) store.KVStore {
+	kvs := s.KVStoreService.OpenKVStore(ctx)
+	if b, ok := ctx.Value(batchKey{}).(store.Batch); ok {
+		return batchStore{KVStore: kvs, batch: b}
+	}
+	return kvs
+}
+
+// batchStore is a KV store whose writes go to a batch. Reads do not see the
+// writes of the batch until it is committed.
+type batchStore struct {
+	store.KVStore
+	batch store.Batch
+}
+
+// Set sets the value of the key in the batch.
+func (s batchStore) Set(key, value []byte) error {
+	return s.batch.Set(key, value)
+}
+
+// Delete deletes the key in the batch.
+func (s batchStore) Delete(key []byte) error {
+	return s.batch.Delete(key)
+}
+
+// withBatch runs fn with the writes of the store buffered in a batch, which
+// is committed once fn succeeds. The writes are applied directly if the
+// underlying store does not support batches.
+func (kv *KVStore[BeaconBlockT]) withBatch(
+	fn func(ctx context.Context) error,
+) error {
+	ctx := context.TODO()
+	kvs, ok := kv.kvsp.OpenKVStore(ctx).(store.KVStoreWithBatch)
+	if !ok {
+		return fn(ctx)
+	}
+	b := kvs.NewBatch()
+	//#nosec:G703 // closing a written batch is a no-op.
+	defer b.Close()
+	if err := fn(context.WithValue(ctx, batchKey{}, b)); err != nil {
+		return err
+	}
+	return b.Write()
+}
+
+// Close closes the underlying store, if it can be closed.
+func (kv *KVStore[BeaconBlockT]) Close() error {
+	kvs, ok := kv.kvsp.OpenKVStore(context.TODO()).(store.KVStoreWithBatch)
+	if !ok {
+		return nil
+	}
+	return kvs.Close()
+}
@@ -21,78 +21,259 @@
 package block
 
 import (
+	"context"
 	"fmt"
 
+	sdkcollections "cosmossdk.io/collections"
+	"cosmossdk.io/core/store"
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
 	"github.com/berachain/beacon-kit/errors"
 	"github.com/berachain/beacon-kit/log"
 	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/crypto"
 	"github.com/berachain/beacon-kit/primitives/math"
-	lru "github.com/hashicorp/golang-lru/v2"
+	"github.com/berachain/beacon-kit/storage/encoding"
+	"github.com/berachain/beacon-kit/storage/pruner"
 )
 
-// KVStore is a simple memory store based implementation that stores metadata of
-// beacon blocks.
-type KVStore[BeaconBlockT BeaconBlock] struct {
+const (
+	// KeyBlockPrefix is the prefix under which the finalized blocks are
+	// stored.
+	KeyBlockPrefix = "block"
+	// KeyHeaderPrefix is the prefix under which the headers of the finalized
+	// blocks are stored.
+	KeyHeaderPrefix = "header"
+	// KeyBlockRootPrefix is the prefix of the block root to slot index.
+	KeyBlockRootPrefix = "slot_by_block_root"
+	// KeyTimestampPrefix is the prefix of the timestamp to slot index.
+	KeyTimestampPrefix = "slot_by_timestamp"
+	// KeyStateRootPrefix is the prefix of the state root to slot index.
+	KeyStateRootPrefix = "slot_by_state_root"
+	// KeySignaturePrefix is the prefix under which the proposer signatures of
+	// the finalized blocks are stored, when known.
+	KeySignaturePrefix = "signature"
+	// KeyPrunedUntilPrefix is the key of the slot up to which, exclusive, the
+	// store has been pruned.
+	KeyPrunedUntilPrefix = "pruned_until"
+)
+
+// pruneBatchSize is the number of blocks deleted per batch when pruning.
+const pruneBatchSize = 256
+
+var (
+	// ErrBlockNotFound is returned when no block has been stored for a slot.
+	ErrBlockNotFound = errors.New("block not found")
+	// ErrSignatureNotFound is returned when the proposer signature of the
+	// block at a slot is not known.
+	ErrSignatureNotFound = errors.New("block signature not found")
+)
+
+// Compile time check to ensure KVStore implements the Prunable interface.
+var _ pruner.Prunable = (*KVStore[*ctypes.BeaconBlock])(nil)
+
+// KVStore stores finalized beacon blocks and their headers, indexed by slot,
+// in a persistent KV store, along with the indices used to resolve slots from
+// block roots, timestamps and state roots.
+type KVStore[BeaconBlockT BeaconBlock[BeaconBlockT]] struct {
+	// blocks maps each slot to the finalized block at that slot.
+	blocks sdkcollections.Map[uint64, BeaconBlockT]
+
+	// headers maps each slot to the header of the finalized block at that
+	// slot, so that headers can be served without decoding the block body.
+	headers sdkcollections.Map[uint64, *ctypes.BeaconBlockHeader]
+
 	// Beacon block root to slot mapping is injective for finalized blocks.
-	blockRoots *lru.Cache[common.Root, math.Slot]
+	blockRoots sdkcollections.Map[[]byte, uint64]
 
 	// Timestamp to slot mapping is injective for finalized blocks. This is
 	// guaranteed by CometBFT consensus. So each slot will be associated with a
 	// different timestamp (no overwriting) as we store only finalized blocks.
-	timestamps *lru.Cache[math.U64, math.Slot]
+	timestamps sdkcollections.Map[uint64, uint64]
 
 	// Beacon state root to slot mapping is injective for finalized blocks.
-	stateRoots *lru.Cache[common.Root, math.Slot]
+	stateRoots sdkcollections.Map[[]byte, uint64]
+
+	// signatures maps each slot to the proposer signature of the header of
+	// the finalized block at that slot. The signature only travels with the
+	// blob sidecars, so it is only known for blocks carrying blobs.
+	signatures sdkcollections.Map[uint64, []byte]
+
+	// prunedUntil is the slot up to which, exclusive, the store has been
+	// pruned, so that pruning resumes where it stopped.
+	prunedUntil sdkcollections.Item[uint64]
+
+	// kvsp is the service of the underlying KV store.
+	kvsp store.KVStoreService
 
 	// Logger for the store.
 	logger log.Logger
 }
 
 // NewStore creates a new block store.
-func NewStore[BeaconBlockT BeaconBlock](
+func NewStore[BeaconBlockT BeaconBlock[BeaconBlockT]](
+	kvsp store.KVStoreService,
 	logger log.Logger,
-	availabilityWindow int,
 ) *KVStore[BeaconBlockT] {
-	blockRoots, err := lru.New[common.Root, math.Slot](availabilityWindow)
-	if err != nil {
-		panic(err)
+	schemaBuilder := sdkcollections.NewSchemaBuilder(
+		batchStoreService{KVStoreService: kvsp},
+	)
+	res := &KVStore[BeaconBlockT]{
+		blocks: sdkcollections.NewMap(
+			schemaBuilder,
+			sdkcollections.NewPrefix([]byte(KeyBlockPrefix)),
+			KeyBlockPrefix,
+			sdkcollections.Uint64Key,
+			encoding.SSZValueCodec[BeaconBlockT]{},
+		),
+		headers: sdkcollections.NewMap(
+			schemaBuilder,
+			sdkcollections.NewPrefix([]byte(KeyHeaderPrefix)),
+			KeyHeaderPrefix,
+			sdkcollections.Uint64Key,
+			encoding.SSZValueCodec[*ctypes.BeaconBlockHeader]{},
+		),
+		blockRoots: sdkcollections.NewMap(
+			schemaBuilder,
+			sdkcollections.NewPrefix([]byte(KeyBlockRootPrefix)),
+			KeyBlockRootPrefix,
+			sdkcollections.BytesKey,
+			sdkcollections.Uint64Value,
+		),
+		timestamps: sdkcollections.NewMap(
+			schemaBuilder,
+			sdkcollections.NewPrefix([]byte(KeyTimestampPrefix)),
+			KeyTimestampPrefix,
+			sdkcollections.Uint64Key,
+			sdkcollections.Uint64Value,
+		),
+		stateRoots: sdkcollections.NewMap(
+			schemaBuilder,
+			sdkcollections.NewPrefix([]byte(KeyStateRootPrefix)),
+			KeyStateRootPrefix,
+			sdkcollections.BytesKey,
+			sdkcollections.Uint64Value,
+		),
+		signatures: sdkcollections.NewMap(
+			schemaBuilder,
+			sdkcollections.NewPrefix([]byte(KeySignaturePrefix)),
+			KeySignaturePrefix,
+			sdkcollections.Uint64Key,
+			sdkcollections.BytesValue,
+		),
+		prunedUntil: sdkcollections.NewItem(
+			schemaBuilder,
+			sdkcollections.NewPrefix([]byte(KeyPrunedUntilPrefix)),
+			KeyPrunedUntilPrefix,
+			sdkcollections.Uint64Value,
+		),
+		kvsp:   kvsp,
+		logger: logger,
 	}
-	timestamps, err := lru.New[math.U64, math.Slot](availabilityWindow)
-	if err != nil {
-		panic(err)
-	}
-	stateRoots, err := lru.New[common.Root, math.Slot](availabilityWindow)
-	if err != nil {
-		panic(err)
-	}
-	return &KVStore[BeaconBlockT]{
-		blockRoots: blockRoots,
-		timestamps: timestamps,
-		stateRoots: stateRoots,
-		logger:     logger,
+	if _, err := schemaBuilder.Build(); err != nil {
+		panic(errors.Wrap(err, "failed building KVStore schema"))
 	}
+	return res
 }
 
-// Set sets the block by a given index in the store, storing the block root,
-// timestamp, and state root. Only this function may potentially evict
-// entries from the store if the availability window is reached.
+// Set persists the block and its header at its slot and indexes its block
+// root, timestamp, and state root, all at once.
 func (kv *KVStore[BeaconBlockT]) Set(blk BeaconBlockT) error {
-	slot := blk.GetSlot()
-	kv.blockRoots.Add(blk.HashTreeRoot(), slot)
-	kv.timestamps.Add(blk.GetTimestamp(), slot)
-	kv.stateRoots.Add(blk.GetStateRoot(), slot)
+	return kv.withBatch(func(ctx context.Context) error {
+		return kv.set(ctx, blk)
+	})
+}
+
+// set writes the block, its header and its indices.
+func (kv *KVStore[BeaconBlockT]) set(
+	ctx context.Context, blk BeaconBlockT,
+) error {
+	var (
+		slot      = blk.GetSlot()
+		blockRoot = blk.HashTreeRoot()
+		stateRoot = blk.GetStateRoot()
+	)
+	if err := kv.blocks.Set(ctx, slot.Unwrap(), blk); err != nil {
+		return errors.Wrapf(err, "failed to store block at slot %d", slot)
+	}
+	if err := kv.headers.Set(ctx, slot.Unwrap(), blk.GetHeader()); err != nil {
+		return errors.Wrapf(err, "failed to store header at slot %d", slot)
+	}
+	if err := kv.blockRoots.Set(
+		ctx, blockRoot[:], slot.Unwrap(),
+	); err != nil {
+		return errors.Wrapf(err, "failed to index block root at slot %d", slot)
+	}
+	if err := kv.timestamps.Set(
+		ctx, blk.GetTimestamp().Unwrap(), slot.Unwrap(),
+	); err != nil {
+		return errors.Wrapf(err, "failed to index timestamp at slot %d", slot)
+	}
+	if err := kv.stateRoots.Set(
+		ctx, stateRoot[:], slot.Unwrap(),
+	); err != nil {
+		return errors.Wrapf(err, "failed to index state root at slot %d", slot)
+	}
 	return nil
 }
 
+// GetBlockBySlot retrieves the finalized block at the given slot from the
+// store.
+func (kv *KVStore[BeaconBlockT]) GetBlockBySlot(
+	slot math.Slot,
+) (BeaconBlockT, error) {
+	blk, err := kv.blocks.Get(context.TODO(), slot.Unwrap())
+	if errors.Is(err, sdkcollections.ErrNotFound) {
+		return blk, fmt.Errorf("%w at slot: %d", ErrBlockNotFound, slot)
+	}
+	return blk, err
+}
+
+// GetHeaderBySlot retrieves the header of the finalized block at the given
+// slot from the store.
+func (kv *KVStore[BeaconBlockT]) GetHeaderBySlot(
+	slot math.Slot,
+) (*ctypes.BeaconBlockHeader, error) {
+	header, err := kv.headers.Get(context.TODO(), slot.Unwrap())
+	if errors.Is(err, sdkcollections.ErrNotFound) {
+		return nil, fmt.Errorf("%w at slot: %d", ErrBlockNotFound, slot)
+	}
+	return header, err
+}
+
+// SetSignature persists the proposer signature of the header of the block at
+// the given slot.
+func (kv *KVStore[BeaconBlockT]) SetSignature(
+	slot math.Slot, signature crypto.BLSSignature,
+) error {
+	return kv.signatures.Set(context.TODO(), slot.Unwrap(), signature[:])
+}
+
+// GetSignatureBySlot retrieves the proposer signature of the header of the
+// finalized block at the given slot, if known.
+func (kv *KVStore[BeaconBlockT]) GetSignatureBySlot(
+	slot math.Slot,
+) (crypto.BLSSignature, error) {
+	bz, err := kv.signatures.Get(context.TODO(), slot.Unwrap())
+	if errors.Is(err, sdkcollections.ErrNotFound) {
+		return crypto.BLSSignature{}, fmt.Errorf(
+			"%w at slot: %d", ErrSignatureNotFound, slot,
+		)
+	}
+	if err != nil {
+		return crypto.BLSSignature{}, err
+	}
+	return crypto.BLSSignature(bz), nil
+}
+
 // GetSlotByBlockRoot retrieves the slot by a given block root from the store.
 func (kv *KVStore[BeaconBlockT]) GetSlotByBlockRoot(
 	blockRoot common.Root,
 ) (math.Slot, error) {
-	slot, ok := kv.blockRoots.Peek(blockRoot)
-	if !ok {
+	slot, err := kv.blockRoots.Get(context.TODO(), blockRoot[:])
+	if errors.Is(err, sdkcollections.ErrNotFound) {
 		return 0, fmt.Errorf("slot not found at block root: %s", blockRoot)
 	}
-	return slot, nil
+	return math.Slot(slot), err
 }
 
 // GetParentSlotByTimestamp retrieves the parent slot by a given timestamp from
@@ -100,24 +281,124 @@ func (kv *KVStore[BeaconBlockT]) GetSlotByBlockRoot(
 func (kv *KVStore[BeaconBlockT]) GetParentSlotByTimestamp(
 	timestamp math.U64,
 ) (math.Slot, error) {
-	slot, ok := kv.timestamps.Peek(timestamp)
-	if !ok {
-		return slot, fmt.Errorf("slot not found at timestamp: %d", timestamp)
+	slot, err := kv.timestamps.Get(context.TODO(), timestamp.Unwrap())
+	if errors.Is(err, sdkcollections.ErrNotFound) {
+		return 0, fmt.Errorf("slot not found at timestamp: %d", timestamp)
+	} else if err != nil {
+		return 0, err
 	}
 	if slot == 0 {
-		return slot, errors.New("parent slot not supported for genesis slot 0")
+		return 0, errors.New("parent slot not supported for genesis slot 0")
 	}
 
-	return slot - 1, nil
+	return math.Slot(slot - 1), nil
 }
 
 // GetSlotByStateRoot retrieves the slot by a given state root from the store.
 func (kv *KVStore[BeaconBlockT]) GetSlotByStateRoot(
 	stateRoot common.Root,
 ) (math.Slot, error) {
-	slot, ok := kv.stateRoots.Peek(stateRoot)
-	if !ok {
+	slot, err := kv.stateRoots.Get(context.TODO(), stateRoot[:])
+	if errors.Is(err, sdkcollections.ErrNotFound) {
 		return 0, fmt.Errorf("slot not found at state root: %s", stateRoot)
 	}
-	return slot, nil
+	return math.Slot(slot), err
+}
+
+// Prune removes the blocks, headers and indices of the slots in
+// [start, end) from the store. Pruning resumes from the slot it last stopped
+// at, and the blocks are deleted in batches.
+func (kv *KVStore[BeaconBlockT]) Prune(start, end uint64) error {
+	if start > end {
+		return errors.Wrapf(
+			pruner.ErrInvalidRange, "BlockKVStore Prune start: %d, end: %d",
+			start, end,
+		)
+	}
+
+	prunedUntil, err := kv.prunedUntil.Get(context.TODO())
+	if err != nil && !errors.Is(err, sdkcollections.ErrNotFound) {
+		return err
+	}
+	start = max(start, prunedUntil)
+	if start >= end {
+		return nil
+	}
+
+	for from := start; from < end; {
+		if from, err = kv.pruneBatch(from, end); err != nil {
+			return err
+		}
+	}
+	kv.logger.Debug("Pruned block store", "start", start, "end", end)
+	return nil
+}
+
+// pruneBatch removes up to pruneBatchSize blocks of the slots in [start, end)
+// and records how far the store has been pruned, all at once. It returns the
+// slot to resume pruning from.
+func (kv *KVStore[BeaconBlockT]) pruneBatch(
+	start, end uint64,
+) (uint64, error) {
+	blks, err := kv.blocksInRange(start, end, pruneBatchSize)
+	if err != nil {
+		return 0, err
+	}
+	next := end
+	if len(blks) == pruneBatchSize {
+		next = blks[len(blks)-1].GetSlot().Unwrap() + 1
+	}
+	return next, kv.withBatch(func(ctx context.Context) error {
+		for _, blk := range blks {
+			if err = kv.remove(ctx, blk); err != nil {
+				return err
+			}
+		}
+		return kv.prunedUntil.Set(ctx, next)
+	})
+}
+
+// blocksInRange returns up to limit blocks of the slots in [start, end).
+func (kv *KVStore[BeaconBlockT]) blocksInRange(
+	start, end uint64, limit int,
+) ([]BeaconBlockT, error) {
+	iter, err := kv.blocks.Iterate(
+		context.TODO(),
+		new(sdkcollections.Range[uint64]).
+			StartInclusive(start).
+			EndExclusive(end),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+
+	blks := make([]BeaconBlockT, 0, limit)
+	for ; iter.Valid() && len(blks) < limit; iter.Next() {
+		blk, valueErr := iter.Value()
+		if valueErr != nil {
+			return nil, valueErr
+		}
+		blks = append(blks, blk)
+	}
+	return blks, nil
+}
+
+// remove deletes the given block along with its header and indices.
+func (kv *KVStore[BeaconBlockT]) remove(
+	ctx context.Context, blk BeaconBlockT,
+) error {
+	var (
+		slot      = blk.GetSlot().Unwrap()
+		blockRoot = blk.HashTreeRoot()
+		stateRoot = blk.GetStateRoot()
+	)
+	return errors.Join(
+		kv.blockRoots.Remove(ctx, blockRoot[:]),
+		kv.timestamps.Remove(ctx, blk.GetTimestamp().Unwrap()),
+		kv.stateRoots.Remove(ctx, stateRoot[:]),
+		kv.signatures.Remove(ctx, slot),
+		kv.headers.Remove(ctx, slot),
+		kv.blocks.Remove(ctx, slot),
+	)
 }
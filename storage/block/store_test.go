@@ -21,12 +21,18 @@
 package block_test
 
 import (
+	"encoding/binary"
 	"testing"
 
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
 	"github.com/berachain/beacon-kit/log/noop"
+	"github.com/berachain/beacon-kit/node-core/components/storage"
 	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/crypto"
 	"github.com/berachain/beacon-kit/primitives/math"
 	"github.com/berachain/beacon-kit/storage/block"
+	"github.com/berachain/beacon-kit/storage/pruner"
+	dbm "github.com/cosmos/cosmos-db"
 	"github.com/stretchr/testify/require"
 )
 
@@ -50,23 +56,48 @@ func (m MockBeaconBlock) GetStateRoot() common.Root {
 	return [32]byte{byte(m.slot)}
 }
 
+func (m MockBeaconBlock) GetHeader() *ctypes.BeaconBlockHeader {
+	return &ctypes.BeaconBlockHeader{
+		Slot:      m.slot,
+		StateRoot: m.GetStateRoot(),
+	}
+}
+
+func (*MockBeaconBlock) Empty() *MockBeaconBlock {
+	return &MockBeaconBlock{}
+}
+
+func (m *MockBeaconBlock) MarshalSSZ() ([]byte, error) {
+	return binary.LittleEndian.AppendUint64(nil, m.slot.Unwrap()), nil
+}
+
+func (m *MockBeaconBlock) UnmarshalSSZ(bz []byte) error {
+	m.slot = math.Slot(binary.LittleEndian.Uint64(bz))
+	return nil
+}
+
+func newTestStore(db dbm.DB) *block.KVStore[*MockBeaconBlock] {
+	return block.NewStore[*MockBeaconBlock](
+		storage.NewKVStoreProvider(db),
+		noop.NewLogger[any](),
+	)
+}
+
 func TestBlockStore(t *testing.T) {
-	blockStore := block.NewStore[*MockBeaconBlock](noop.NewLogger[any](), 5)
+	blockStore := newTestStore(dbm.NewMemDB())
 
 	var (
 		slot math.Slot
 		err  error
 	)
 
-	// Set 7 blocks.
-	// The latest block is 7 and should hold the last 5 blocks in the window.
 	for i := 1; i <= 7; i++ {
 		err = blockStore.Set(&MockBeaconBlock{slot: math.Slot(i)})
 		require.NoError(t, err)
 	}
 
 	// Get the slots by roots & timestamps.
-	for i := math.Slot(3); i <= 7; i++ {
+	for i := math.Slot(1); i <= 7; i++ {
 		slot, err = blockStore.GetSlotByBlockRoot([32]byte{byte(i)})
 		require.NoError(t, err)
 		require.Equal(t, i, slot)
@@ -83,6 +114,140 @@ func TestBlockStore(t *testing.T) {
 	// Try getting a slot that doesn't exist.
 	_, err = blockStore.GetSlotByBlockRoot([32]byte{byte(8)})
 	require.ErrorContains(t, err, "not found")
-	_, err = blockStore.GetParentSlotByTimestamp(2)
+	_, err = blockStore.GetParentSlotByTimestamp(8)
 	require.ErrorContains(t, err, "not found")
+	_, err = blockStore.GetSlotByStateRoot([32]byte{byte(8)})
+	require.ErrorContains(t, err, "not found")
+}
+
+func TestBlockStoreGetBlockBySlot(t *testing.T) {
+	blockStore := newTestStore(dbm.NewMemDB())
+
+	for i := 1; i <= 4; i++ {
+		require.NoError(t, blockStore.Set(&MockBeaconBlock{slot: math.Slot(i)}))
+	}
+
+	for i := math.Slot(1); i <= 4; i++ {
+		blk, err := blockStore.GetBlockBySlot(i)
+		require.NoError(t, err)
+		require.Equal(t, i, blk.GetSlot())
+
+		header, err := blockStore.GetHeaderBySlot(i)
+		require.NoError(t, err)
+		require.Equal(t, i, header.GetSlot())
+		require.Equal(t, common.Root{byte(i)}, header.GetStateRoot())
+	}
+
+	_, err := blockStore.GetBlockBySlot(5)
+	require.ErrorIs(t, err, block.ErrBlockNotFound)
+	_, err = blockStore.GetHeaderBySlot(5)
+	require.ErrorIs(t, err, block.ErrBlockNotFound)
+}
+
+func TestBlockStoreSignatures(t *testing.T) {
+	blockStore := newTestStore(dbm.NewMemDB())
+	for i := 1; i <= 6; i++ {
+		require.NoError(t, blockStore.Set(&MockBeaconBlock{slot: math.Slot(i)}))
+	}
+	require.NoError(t, blockStore.SetSignature(2, crypto.BLSSignature{0xaa}))
+	require.NoError(t, blockStore.SetSignature(5, crypto.BLSSignature{0xbb}))
+
+	signature, err := blockStore.GetSignatureBySlot(5)
+	require.NoError(t, err)
+	require.Equal(t, crypto.BLSSignature{0xbb}, signature)
+	_, err = blockStore.GetSignatureBySlot(3)
+	require.ErrorIs(t, err, block.ErrSignatureNotFound)
+
+	// Signatures are pruned along with their blocks.
+	require.NoError(t, blockStore.Prune(0, 4))
+	_, err = blockStore.GetSignatureBySlot(2)
+	require.ErrorIs(t, err, block.ErrSignatureNotFound)
+	_, err = blockStore.GetSignatureBySlot(5)
+	require.NoError(t, err)
+}
+
+func TestBlockStoreSurvivesRestart(t *testing.T) {
+	db := dbm.NewMemDB()
+	for i := 1; i <= 3; i++ {
+		require.NoError(t, newTestStore(db).Set(
+			&MockBeaconBlock{slot: math.Slot(i)},
+		))
+	}
+
+	// A new store over the same database serves every index.
+	blockStore := newTestStore(db)
+	slot, err := blockStore.GetParentSlotByTimestamp(3)
+	require.NoError(t, err)
+	require.Equal(t, math.Slot(2), slot)
+
+	slot, err = blockStore.GetSlotByBlockRoot([32]byte{1})
+	require.NoError(t, err)
+	require.Equal(t, math.Slot(1), slot)
+
+	header, err := blockStore.GetHeaderBySlot(2)
+	require.NoError(t, err)
+	require.Equal(t, math.Slot(2), header.GetSlot())
+}
+
+func TestBlockStorePrune(t *testing.T) {
+	blockStore := newTestStore(dbm.NewMemDB())
+	for i := 1; i <= 10; i++ {
+		require.NoError(t, blockStore.Set(&MockBeaconBlock{slot: math.Slot(i)}))
+	}
+
+	// Keep a rolling window of 4 slots behind the head.
+	start, end := pruner.WindowRange(10, 4)
+	require.NoError(t, blockStore.Prune(start, end))
+
+	for i := math.Slot(1); i <= 10; i++ {
+		_, blkErr := blockStore.GetBlockBySlot(i)
+		_, headerErr := blockStore.GetHeaderBySlot(i)
+		_, rootErr := blockStore.GetSlotByBlockRoot([32]byte{byte(i)})
+		_, stateRootErr := blockStore.GetSlotByStateRoot([32]byte{byte(i)})
+		_, timestampErr := blockStore.GetParentSlotByTimestamp(i)
+		if i < 6 {
+			require.ErrorIs(t, blkErr, block.ErrBlockNotFound)
+			require.ErrorIs(t, headerErr, block.ErrBlockNotFound)
+			require.ErrorContains(t, rootErr, "not found")
+			require.ErrorContains(t, stateRootErr, "not found")
+			require.ErrorContains(t, timestampErr, "not found")
+			continue
+		}
+		require.NoError(t, blkErr)
+		require.NoError(t, headerErr)
+		require.NoError(t, rootErr)
+		require.NoError(t, stateRootErr)
+		require.NoError(t, timestampErr)
+	}
+
+	// Pruning an already pruned range is a no-op.
+	require.NoError(t, blockStore.Prune(start, end))
+	require.ErrorIs(t, blockStore.Prune(5, 4), pruner.ErrInvalidRange)
+}
+
+func TestBlockStorePruneResumes(t *testing.T) {
+	db := dbm.NewMemDB()
+	blockStore := newTestStore(db)
+	for i := 1; i <= 600; i++ {
+		require.NoError(t, blockStore.Set(&MockBeaconBlock{slot: math.Slot(i)}))
+	}
+
+	// Pruning spans several batches.
+	require.NoError(t, blockStore.Prune(0, 550))
+	_, err := blockStore.GetBlockBySlot(549)
+	require.ErrorIs(t, err, block.ErrBlockNotFound)
+	_, err = blockStore.GetBlockBySlot(550)
+	require.NoError(t, err)
+
+	// A restarted store resumes pruning where it stopped, so the slots
+	// already pruned are not visited again.
+	blockStore = newTestStore(db)
+	require.NoError(t, blockStore.Set(&MockBeaconBlock{slot: 10}))
+	require.NoError(t, blockStore.Prune(0, 560))
+	_, err = blockStore.GetBlockBySlot(10)
+	require.NoError(t, err)
+	_, err = blockStore.GetBlockBySlot(559)
+	require.ErrorIs(t, err, block.ErrBlockNotFound)
+	_, err = blockStore.GetBlockBySlot(560)
+	require.NoError(t, err)
 }
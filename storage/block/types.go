@@ -21,15 +21,21 @@
 package block
 
 import (
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
 	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/constraints"
 	"github.com/berachain/beacon-kit/primitives/math"
 )
 
 // BeaconBlock is a block in the beacon chain that has a slot, block root (hash
-// tree root), timestamp, and state root.
-type BeaconBlock interface {
+// tree root), timestamp, state root and header. It must be SSZ marshallable so
+// that it can be persisted.
+type BeaconBlock[T any] interface {
+	constraints.SSZMarshallable
+	constraints.Empty[T]
 	GetSlot() math.U64
 	HashTreeRoot() common.Root
 	GetTimestamp() math.U64
 	GetStateRoot() common.Root
+	GetHeader() *ctypes.BeaconBlockHeader
 }
@@ -28,6 +28,14 @@ import (
 
 // OpenDB opens the application database using the appropriate driver.
 func OpenDB(rootDir string, backendType dbm.BackendType) (dbm.DB, error) {
+	return OpenNamedDB(rootDir, "application", backendType)
+}
+
+// OpenNamedDB opens the database with the given name in the data directory
+// using the appropriate driver.
+func OpenNamedDB(
+	rootDir, name string, backendType dbm.BackendType,
+) (dbm.DB, error) {
 	dataDir := filepath.Join(rootDir, "data")
-	return dbm.NewDB("application", backendType, dataDir)
+	return dbm.NewDB(name, backendType, dataDir)
 }
@@ -0,0 +1,121 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package deposit
+
+import (
+	"context"
+
+	sdkcollections "cosmossdk.io/collections"
+	"github.com/berachain/beacon-kit/errors"
+)
+
+// checkpointRetention is the number of consensus heights for which the
+// checkpoints are kept, long enough for a state sync snapshot taken at one of
+// them to complete.
+const checkpointRetention = 10_000
+
+// checkpoint is the deposit cursor, joined with the number of deposits and
+// the number of withdrawal requests.
+type checkpoint = sdkcollections.Pair[
+	uint64, sdkcollections.Pair[uint64, uint64],
+]
+
+// ErrCheckpointNotFound is returned when no checkpoint was recorded at a
+// consensus height, e.g. because execution blocks up to the deposit cursor
+// were still missing.
+var ErrCheckpointNotFound = errors.New("deposit store checkpoint not found")
+
+// RecordCheckpoint records the deposit cursor, the number of deposits and the
+// number of withdrawal requests at the given consensus height, which bound
+// the data exported by a state sync snapshot at that height. Nothing is
+// recorded while execution blocks up to the cursor are missing, since the
+// number of deposits is not known then.
+func (kv *KVStore) RecordCheckpoint(height uint64) error {
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+
+	ctx := context.TODO()
+	if height > checkpointRetention {
+		if err := kv.checkpoints.Remove(
+			ctx, height-checkpointRetention,
+		); err != nil {
+			return err
+		}
+	}
+
+	cursor, err := kv.cursor.Get(ctx)
+	if errors.Is(err, sdkcollections.ErrNotFound) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	oldest, missing, err := kv.oldestMissingBlock(ctx)
+	if err != nil || (missing && oldest <= cursor) {
+		return err
+	}
+	deposits, err := kv.depositCount(ctx)
+	if err != nil {
+		return err
+	}
+	requests, err := kv.withdrawalRequestCount(ctx)
+	if err != nil {
+		return err
+	}
+	return kv.checkpoints.Set(ctx, height, sdkcollections.Join(
+		cursor, sdkcollections.Join(deposits, requests),
+	))
+}
+
+// getCheckpoint returns the deposit cursor, the number of deposits and the
+// number of withdrawal requests recorded at the given consensus height.
+func (kv *KVStore) getCheckpoint(
+	ctx context.Context, height uint64,
+) (uint64, uint64, uint64, error) {
+	cp, err := kv.checkpoints.Get(ctx, height)
+	if errors.Is(err, sdkcollections.ErrNotFound) {
+		return 0, 0, 0, errors.Wrapf(
+			ErrCheckpointNotFound, "at height %d", height,
+		)
+	} else if err != nil {
+		return 0, 0, 0, err
+	}
+	return cp.K1(), cp.K2().K1(), cp.K2().K2(), nil
+}
+
+// depositCount returns the number of deposits in the store, i.e. the index
+// following the one of the last deposit.
+func (kv *KVStore) depositCount(ctx context.Context) (uint64, error) {
+	iter, err := kv.store.Iterate(
+		ctx, new(sdkcollections.Range[uint64]).Descending(),
+	)
+	if err != nil {
+		return 0, err
+	}
+	defer iter.Close()
+	if !iter.Valid() {
+		return 0, nil
+	}
+	last, err := iter.Key()
+	if err != nil {
+		return 0, err
+	}
+	return last + 1, nil
+}
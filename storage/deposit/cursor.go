@@ -0,0 +1,187 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package deposit
+
+import (
+	"context"
+
+	sdkcollections "cosmossdk.io/collections"
+	"github.com/berachain/beacon-kit/errors"
+)
+
+// GetEth1BlockCursor returns the highest execution block up to which every
+// block has either been processed or recorded as failed. It returns 0 if no
+// block has been processed yet.
+func (kv *KVStore) GetEth1BlockCursor() (uint64, error) {
+	kv.mu.RLock()
+	defer kv.mu.RUnlock()
+	cursor, err := kv.cursor.Get(context.TODO())
+	if errors.Is(err, sdkcollections.ErrNotFound) {
+		return 0, nil
+	}
+	return cursor, err
+}
+
+// SetEth1BlockCursor sets the highest execution block up to which every block
+// has either been processed or recorded as failed.
+func (kv *KVStore) SetEth1BlockCursor(blockNum uint64) error {
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+	return kv.cursor.Set(context.TODO(), blockNum)
+}
+
+// GetFailedEth1Blocks returns the execution blocks whose deposits failed to
+// be fetched, in ascending order.
+func (kv *KVStore) GetFailedEth1Blocks() ([]uint64, error) {
+	kv.mu.RLock()
+	defer kv.mu.RUnlock()
+	iter, err := kv.failedBlocks.Iterate(context.TODO(), nil)
+	if err != nil {
+		return nil, err
+	}
+	return iter.Keys()
+}
+
+// AddFailedEth1Block records that the deposits of the given execution block
+// failed to be fetched.
+func (kv *KVStore) AddFailedEth1Block(blockNum uint64) error {
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+	return kv.failedBlocks.Set(context.TODO(), blockNum)
+}
+
+// RemoveFailedEth1Block removes the given execution block from the failed
+// set once its deposits have been fetched.
+func (kv *KVStore) RemoveFailedEth1Block(blockNum uint64) error {
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+	return kv.failedBlocks.Remove(context.TODO(), blockNum)
+}
+
+// MarkEth1BlocksMissing records the execution blocks after the cursor up to
+// the given one as missing, as a single range, and moves the cursor to it.
+// Missing blocks are moved to the failed set in batches by
+// TakeMissingEth1Blocks. It does nothing if no block was processed yet or
+// the cursor is already past the given block.
+func (kv *KVStore) MarkEth1BlocksMissing(blockNum uint64) error {
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+	ctx := context.TODO()
+	cursor, err := kv.cursor.Get(ctx)
+	if errors.Is(err, sdkcollections.ErrNotFound) || blockNum <= cursor {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	if err = kv.missingBlocks.Set(ctx, cursor+1, blockNum); err != nil {
+		return err
+	}
+	return kv.cursor.Set(ctx, blockNum)
+}
+
+// TakeMissingEth1Blocks moves up to limit blocks of the lowest missing range
+// into the failed set, and returns them in ascending order.
+func (kv *KVStore) TakeMissingEth1Blocks(limit uint64) ([]uint64, error) {
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+	ctx := context.TODO()
+	iter, err := kv.missingBlocks.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+	if !iter.Valid() || limit == 0 {
+		return nil, nil
+	}
+	missing, err := iter.KeyValue()
+	if err != nil {
+		return nil, err
+	}
+
+	start, end := missing.Key, missing.Value
+	last := end
+	if end-start >= limit {
+		last = start + limit - 1
+	}
+	blocks := make([]uint64, 0, last-start+1)
+	for blockNum := start; blockNum <= last; blockNum++ {
+		if err = kv.failedBlocks.Set(ctx, blockNum); err != nil {
+			return nil, err
+		}
+		blocks = append(blocks, blockNum)
+	}
+	if err = kv.missingBlocks.Remove(ctx, start); err != nil {
+		return nil, err
+	}
+	if last < end {
+		if err = kv.missingBlocks.Set(ctx, last+1, end); err != nil {
+			return nil, err
+		}
+	}
+	return blocks, nil
+}
+
+// GetOldestMissingEth1Block returns the lowest execution block whose deposits
+// are still to be fetched, either failed or missing, and whether there is
+// any.
+func (kv *KVStore) GetOldestMissingEth1Block() (uint64, bool, error) {
+	kv.mu.RLock()
+	defer kv.mu.RUnlock()
+	return kv.oldestMissingBlock(context.TODO())
+}
+
+// oldestMissingBlock returns the lowest failed or missing execution block,
+// and whether there is any.
+func (kv *KVStore) oldestMissingBlock(
+	ctx context.Context,
+) (uint64, bool, error) {
+	var (
+		oldest uint64
+		found  bool
+	)
+	failedIter, err := kv.failedBlocks.Iterate(ctx, nil)
+	if err != nil {
+		return 0, false, err
+	}
+	defer failedIter.Close()
+	if failedIter.Valid() {
+		if oldest, err = failedIter.Key(); err != nil {
+			return 0, false, err
+		}
+		found = true
+	}
+
+	missingIter, err := kv.missingBlocks.Iterate(ctx, nil)
+	if err != nil {
+		return 0, false, err
+	}
+	defer missingIter.Close()
+	if missingIter.Valid() {
+		var start uint64
+		if start, err = missingIter.Key(); err != nil {
+			return 0, false, err
+		}
+		if !found || start < oldest {
+			oldest, found = start, true
+		}
+	}
+	return oldest, found, nil
+}
@@ -0,0 +1,331 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package deposit
+
+import (
+	"context"
+	"encoding/binary"
+	"io"
+
+	sdkcollections "cosmossdk.io/collections"
+	snapshottypes "cosmossdk.io/store/snapshots/types"
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/primitives/common"
+)
+
+const (
+	// SnapshotName is the name of the deposit store state sync snapshot
+	// extension.
+	SnapshotName = "deposit_store"
+	// SnapshotFormat is the format of the deposit store snapshot payloads:
+	// a payload kind byte, followed by the SSZ encoding of a deposit or of a
+	// withdrawal request, or by the big-endian deposit cursor.
+	SnapshotFormat uint32 = 3
+)
+
+// Kinds of the deposit store snapshot payloads.
+const (
+	payloadDeposit byte = iota + 1
+	payloadWithdrawalRequest
+	payloadCursor
+)
+
+var (
+	// ErrRestoredDepositsMismatch is returned when the deposits of a snapshot
+	// do not match the beacon state restored at the same height.
+	ErrRestoredDepositsMismatch = errors.New(
+		"snapshot deposits do not match the restored beacon state",
+	)
+
+	// errInvalidSnapshotPayload is returned when a snapshot payload cannot be
+	// decoded.
+	errInvalidSnapshotPayload = errors.New("invalid deposit snapshot payload")
+
+	// errNoRestoredState is returned when a snapshot is restored without a
+	// way to read the restored beacon state.
+	errNoRestoredState = errors.New(
+		"deposit store has no restored state to verify the snapshot against",
+	)
+)
+
+// RestoredStateFn returns the deposit index of the beacon state restored from
+// a snapshot at the given height, i.e. the number of deposits it processed,
+// and the root of these deposits recorded in its Eth1Data.
+type RestoredStateFn func(
+	height uint64,
+) (depositIndex uint64, depositRoot common.Root, err error)
+
+// SetRestoredStateFn sets the function reading the beacon state restored from
+// a snapshot, which the snapshot deposits are verified against. Snapshots are
+// refused until it is set.
+func (kv *KVStore) SetRestoredStateFn(fn RestoredStateFn) {
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+	kv.restoredState = fn
+}
+
+// SnapshotName implements snapshottypes.ExtensionSnapshotter.
+func (*KVStore) SnapshotName() string {
+	return SnapshotName
+}
+
+// SnapshotFormat implements snapshottypes.ExtensionSnapshotter.
+func (*KVStore) SnapshotFormat() uint32 {
+	return SnapshotFormat
+}
+
+// SupportedFormats implements snapshottypes.ExtensionSnapshotter.
+func (*KVStore) SupportedFormats() []uint32 {
+	return []uint32{SnapshotFormat}
+}
+
+// SnapshotExtension writes the deposit store as of the given height into the
+// state sync snapshot, i.e. the deposits, the withdrawal requests and the
+// deposit cursor of the checkpoint recorded at that height. A node restored
+// from the snapshot needs them to verify the deposits and process the
+// withdrawal requests of the following blocks. The execution blocks that
+// failed to be fetched are local to the node and are not exported.
+func (kv *KVStore) SnapshotExtension(
+	height uint64,
+	payloadWriter snapshottypes.ExtensionPayloadWriter,
+) error {
+	payloads, err := kv.snapshotPayloads(height)
+	if err != nil {
+		return err
+	}
+	for _, payload := range payloads {
+		if err = payloadWriter(payload); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// snapshotPayloads encodes the snapshot payloads of the given height, holding
+// the lock only while the store is read so that the store keeps being written
+// while the snapshot is streamed.
+func (kv *KVStore) snapshotPayloads(height uint64) ([][]byte, error) {
+	kv.mu.RLock()
+	defer kv.mu.RUnlock()
+
+	ctx := context.TODO()
+	cursor, numDeposits, numRequests, err := kv.getCheckpoint(ctx, height)
+	if err != nil {
+		return nil, err
+	}
+
+	depositIter, err := kv.store.Iterate(
+		ctx, new(sdkcollections.Range[uint64]).EndExclusive(numDeposits),
+	)
+	if err != nil {
+		return nil, err
+	}
+	deposits, err := depositIter.Values()
+	if err != nil {
+		return nil, err
+	}
+
+	requestIter, err := kv.withdrawalRequests.Iterate(
+		ctx, new(sdkcollections.Range[uint64]).EndExclusive(numRequests),
+	)
+	if err != nil {
+		return nil, err
+	}
+	requests, err := requestIter.Values()
+	if err != nil {
+		return nil, err
+	}
+
+	payloads := make([][]byte, 0, len(deposits)+len(requests)+1)
+	for _, deposit := range deposits {
+		var bz []byte
+		if bz, err = deposit.MarshalSSZ(); err != nil {
+			return nil, err
+		}
+		payloads = append(payloads, append([]byte{payloadDeposit}, bz...))
+	}
+	for _, request := range requests {
+		var bz []byte
+		if bz, err = request.MarshalSSZ(); err != nil {
+			return nil, err
+		}
+		payloads = append(
+			payloads, append([]byte{payloadWithdrawalRequest}, bz...),
+		)
+	}
+	return append(payloads, binary.BigEndian.AppendUint64(
+		[]byte{payloadCursor}, cursor,
+	)), nil
+}
+
+// RestoreExtension restores the deposit store from a state sync snapshot.
+// The snapshot deposits are checked against the beacon state restored at the
+// same height before anything is written, so that a node never follows the
+// chain with deposits that the state did not commit to.
+func (kv *KVStore) RestoreExtension(
+	height uint64,
+	format uint32,
+	payloadReader snapshottypes.ExtensionPayloadReader,
+) error {
+	if format != SnapshotFormat {
+		return errors.Wrapf(
+			snapshottypes.ErrUnknownFormat, "format %v", format,
+		)
+	}
+
+	var (
+		snapshot restoredSnapshot
+		restored int
+	)
+	for {
+		payload, err := payloadReader()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if err = snapshot.decodePayload(payload); err != nil {
+			return err
+		}
+		restored++
+	}
+
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+
+	if err := kv.verifyRestoredDeposits(height, snapshot.deposits); err != nil {
+		return err
+	}
+	if err := kv.writeSnapshot(context.TODO(), &snapshot); err != nil {
+		return err
+	}
+
+	kv.logger.Info(
+		"Restored deposit store from snapshot",
+		"height", height, "entries", restored,
+	)
+	return nil
+}
+
+// restoredSnapshot holds the decoded payloads of a deposit store snapshot.
+type restoredSnapshot struct {
+	deposits  ctypes.Deposits
+	requests  ctypes.WithdrawalRequests
+	cursor    uint64
+	hasCursor bool
+}
+
+// decodePayload decodes a snapshot payload into the snapshot.
+func (rs *restoredSnapshot) decodePayload(payload []byte) error {
+	if len(payload) == 0 {
+		return errInvalidSnapshotPayload
+	}
+	kind, data := payload[0], payload[1:]
+	switch kind {
+	case payloadDeposit:
+		deposit := new(ctypes.Deposit)
+		if err := deposit.UnmarshalSSZ(data); err != nil {
+			return errors.Join(errInvalidSnapshotPayload, err)
+		}
+		rs.deposits = append(rs.deposits, deposit)
+	case payloadWithdrawalRequest:
+		request := new(ctypes.WithdrawalRequest)
+		if err := request.UnmarshalSSZ(data); err != nil {
+			return errors.Join(errInvalidSnapshotPayload, err)
+		}
+		rs.requests = append(rs.requests, request)
+	case payloadCursor:
+		if len(data) != 8 { //nolint:mnd // size of the cursor.
+			return errInvalidSnapshotPayload
+		}
+		rs.cursor, rs.hasCursor = binary.BigEndian.Uint64(data), true
+	default:
+		return errors.Wrapf(
+			errInvalidSnapshotPayload, "unknown payload kind %d", kind,
+		)
+	}
+	return nil
+}
+
+// verifyRestoredDeposits checks that the snapshot deposits start at index 0,
+// are contiguous, and that the deposits processed by the beacon state
+// restored at the given height hash to the deposit root of its Eth1Data.
+func (kv *KVStore) verifyRestoredDeposits(
+	height uint64,
+	deposits ctypes.Deposits,
+) error {
+	if kv.restoredState == nil {
+		return errNoRestoredState
+	}
+	depositIndex, depositRoot, err := kv.restoredState(height)
+	if err != nil {
+		return err
+	}
+
+	for i, deposit := range deposits {
+		//#nosec:G115 // i is a non-negative slice index.
+		if deposit.GetIndex().Unwrap() != uint64(i) {
+			return errors.Wrapf(ErrRestoredDepositsMismatch,
+				"deposit index: %d, expected index: %d",
+				deposit.GetIndex().Unwrap(), i,
+			)
+		}
+	}
+	if uint64(len(deposits)) < depositIndex {
+		return errors.Wrapf(ErrRestoredDepositsMismatch,
+			"snapshot deposits: %d, state deposit index: %d",
+			len(deposits), depositIndex,
+		)
+	}
+	if !depositRoot.Equals(deposits[:depositIndex].HashTreeRoot()) {
+		return errors.Wrapf(ErrRestoredDepositsMismatch,
+			"deposit root does not match the state at height %d", height,
+		)
+	}
+	return nil
+}
+
+// writeSnapshot writes the decoded snapshot into the store.
+func (kv *KVStore) writeSnapshot(
+	ctx context.Context,
+	snapshot *restoredSnapshot,
+) error {
+	for _, deposit := range snapshot.deposits {
+		if err := kv.store.Set(
+			ctx, deposit.GetIndex().Unwrap(), deposit,
+		); err != nil {
+			return err
+		}
+	}
+	for _, request := range snapshot.requests {
+		if err := kv.withdrawalRequests.Set(
+			ctx, request.Index, request,
+		); err != nil {
+			return err
+		}
+	}
+	if !snapshot.hasCursor {
+		return nil
+	}
+	return kv.cursor.Set(ctx, snapshot.cursor)
+}
@@ -0,0 +1,210 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package deposit_test
+
+import (
+	"io"
+	"testing"
+
+	snapshottypes "cosmossdk.io/store/snapshots/types"
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/log/noop"
+	"github.com/berachain/beacon-kit/node-core/components/storage"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/storage/deposit"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSnapshotRestore(t *testing.T) {
+	source := deposit.NewStore(
+		storage.NewKVStoreProvider(dbm.NewMemDB()), noop.NewLogger[any](),
+	)
+	deposits := []*ctypes.Deposit{
+		{Pubkey: [48]byte{0x01}, Amount: 32e9, Index: 0},
+		{Pubkey: [48]byte{0x02}, Amount: 32e9, Index: 1},
+	}
+	reqs := []*ctypes.WithdrawalRequest{
+		ctypes.NewWithdrawalRequest(
+			common.ExecutionAddress{0xaa}, [48]byte{0x01}, 0, 0,
+		),
+		ctypes.NewWithdrawalRequest(
+			common.ExecutionAddress{0xaa}, [48]byte{0x02}, 0, 1,
+		),
+	}
+	require.NoError(t, source.EnqueueDeposits(deposits))
+	require.NoError(t, source.EnqueueWithdrawalRequests(reqs[:1]))
+	require.NoError(t, source.SetEth1BlockCursor(10))
+	require.NoError(t, source.RecordCheckpoint(5))
+
+	// Data fetched after the snapshot height is not exported, and neither
+	// are the failed blocks, which are local to the node.
+	require.NoError(t, source.EnqueueDeposits([]*ctypes.Deposit{
+		{Pubkey: [48]byte{0x03}, Amount: 32e9, Index: 2},
+	}))
+	require.NoError(t, source.EnqueueWithdrawalRequests(reqs[1:]))
+	require.NoError(t, source.SetEth1BlockCursor(12))
+	require.NoError(t, source.AddFailedEth1Block(11))
+	require.NoError(t, source.RecordCheckpoint(6))
+
+	var payloads [][]byte
+	writer := func(payload []byte) error {
+		payloads = append(payloads, payload)
+		return nil
+	}
+	require.ErrorIs(
+		t, source.SnapshotExtension(6, writer), deposit.ErrCheckpointNotFound,
+	)
+	require.NoError(t, source.SnapshotExtension(5, writer))
+
+	restored := deposit.NewStore(
+		storage.NewKVStoreProvider(dbm.NewMemDB()), noop.NewLogger[any](),
+	)
+	require.ErrorIs(t, restored.RestoreExtension(
+		5, deposit.SnapshotFormat+1, nil,
+	), snapshottypes.ErrUnknownFormat)
+	restored.SetRestoredStateFn(restoredState(5, 2, deposits))
+	require.NoError(t, restored.RestoreExtension(
+		5, deposit.SnapshotFormat, payloadReader(payloads),
+	))
+
+	gotDeposits, err := restored.GetDepositsByIndex(0, 10)
+	require.NoError(t, err)
+	require.Equal(t, ctypes.Deposits(deposits), gotDeposits)
+	gotReqs, err := restored.GetWithdrawalRequestsByIndex(0, 16)
+	require.NoError(t, err)
+	require.Equal(t, ctypes.WithdrawalRequests(reqs[:1]), gotReqs)
+	cursor, err := restored.GetEth1BlockCursor()
+	require.NoError(t, err)
+	require.Equal(t, uint64(10), cursor)
+	failed, err := restored.GetFailedEth1Blocks()
+	require.NoError(t, err)
+	require.Empty(t, failed)
+}
+
+func TestSnapshotRestoreVerifiesDeposits(t *testing.T) {
+	source := deposit.NewStore(
+		storage.NewKVStoreProvider(dbm.NewMemDB()), noop.NewLogger[any](),
+	)
+	deposits := []*ctypes.Deposit{
+		{Pubkey: [48]byte{0x01}, Amount: 32e9, Index: 0},
+		{Pubkey: [48]byte{0x02}, Amount: 32e9, Index: 1},
+	}
+	require.NoError(t, source.EnqueueDeposits(deposits))
+	require.NoError(t, source.SetEth1BlockCursor(10))
+	require.NoError(t, source.RecordCheckpoint(5))
+
+	var payloads [][]byte
+	require.NoError(t, source.SnapshotExtension(5, func(bz []byte) error {
+		payloads = append(payloads, bz)
+		return nil
+	}))
+
+	tests := []struct {
+		name    string
+		stateFn deposit.RestoredStateFn
+		wantErr error
+	}{
+		{
+			name:    "deposits not yet processed by the state",
+			stateFn: restoredState(5, 1, deposits),
+		},
+		{
+			name: "deposit root mismatch",
+			stateFn: restoredState(5, 2, []*ctypes.Deposit{
+				deposits[0],
+				{Pubkey: [48]byte{0x03}, Amount: 32e9, Index: 1},
+			}),
+			wantErr: deposit.ErrRestoredDepositsMismatch,
+		},
+		{
+			name:    "state processed more deposits than the snapshot has",
+			stateFn: restoredState(5, 3, append(
+				ctypes.Deposits{}, append(deposits, &ctypes.Deposit{
+					Pubkey: [48]byte{0x03}, Amount: 32e9, Index: 2,
+				})...,
+			)),
+			wantErr: deposit.ErrRestoredDepositsMismatch,
+		},
+		{
+			name: "no restored state",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			restored := deposit.NewStore(
+				storage.NewKVStoreProvider(dbm.NewMemDB()),
+				noop.NewLogger[any](),
+			)
+			if tt.stateFn != nil {
+				restored.SetRestoredStateFn(tt.stateFn)
+			}
+			err := restored.RestoreExtension(
+				5, deposit.SnapshotFormat, payloadReader(payloads),
+			)
+			if tt.stateFn != nil && tt.wantErr == nil {
+				require.NoError(t, err)
+				return
+			}
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+			} else {
+				require.Error(t, err)
+			}
+
+			// Nothing is written when the snapshot is refused.
+			got, err := restored.GetDepositsByIndex(0, 10)
+			require.NoError(t, err)
+			require.Empty(t, got)
+			cursor, err := restored.GetEth1BlockCursor()
+			require.NoError(t, err)
+			require.Zero(t, cursor)
+		})
+	}
+}
+
+// restoredState returns a deposit.RestoredStateFn for a state restored at the
+// given height, which processed the first depositIndex of the given deposits.
+func restoredState(
+	height, depositIndex uint64,
+	deposits ctypes.Deposits,
+) deposit.RestoredStateFn {
+	root := deposits[:depositIndex].HashTreeRoot()
+	return func(h uint64) (uint64, common.Root, error) {
+		if h != height {
+			return 0, common.Root{}, io.ErrUnexpectedEOF
+		}
+		return depositIndex, root, nil
+	}
+}
+
+// payloadReader returns a reader over a copy of the given payloads.
+func payloadReader(payloads [][]byte) snapshottypes.ExtensionPayloadReader {
+	payloads = append([][]byte(nil), payloads...)
+	return func() ([]byte, error) {
+		if len(payloads) == 0 {
+			return nil, io.EOF
+		}
+		payload := payloads[0]
+		payloads = payloads[1:]
+		return payload, nil
+	}
+}
@@ -25,6 +25,7 @@ import (
 	"sync"
 
 	sdkcollections "cosmossdk.io/collections"
+	"cosmossdk.io/collections/codec"
 	"cosmossdk.io/core/store"
 	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
 	"github.com/berachain/beacon-kit/errors"
@@ -33,14 +34,59 @@ import (
 	"github.com/berachain/beacon-kit/storage/pruner"
 )
 
-const KeyDepositPrefix = "deposit"
+const (
+	KeyDepositPrefix = "deposit"
+	// KeyEth1CursorPrefix is the prefix of the highest execution block up to
+	// which every block has either been processed or recorded as failed.
+	KeyEth1CursorPrefix = "eth1_cursor"
+	// KeyFailedEth1BlocksPrefix is the prefix of the execution blocks whose
+	// deposits failed to be fetched and must be retried.
+	KeyFailedEth1BlocksPrefix = "eth1_failed_blocks"
+	// KeyMissingEth1BlocksPrefix is the prefix of the ranges of execution
+	// blocks skipped by the cursor, whose deposits are yet to be fetched.
+	KeyMissingEth1BlocksPrefix = "eth1_missing_blocks"
+	// KeyWithdrawalRequestPrefix is the prefix of the withdrawal requests,
+	// keyed by request index.
+	KeyWithdrawalRequestPrefix = "withdrawal_requests"
+	// KeyCheckpointPrefix is the prefix of the deposit cursor and number of
+	// deposits recorded at each consensus height.
+	KeyCheckpointPrefix = "checkpoints"
+)
 
 // KVStore is a simple KV store based implementation that assumes
 // the deposit indexes are tracked outside of the kv store.
 type KVStore struct {
 	store sdkcollections.Map[uint64, *ctypes.Deposit]
 
-	// mu protects store for concurrent access
+	// cursor is the highest execution block up to which every block has
+	// either been processed or recorded in failedBlocks.
+	cursor sdkcollections.Item[uint64]
+
+	// failedBlocks is the set of execution blocks whose deposits failed to
+	// be fetched and must be retried.
+	failedBlocks sdkcollections.KeySet[uint64]
+
+	// missingBlocks maps the first execution block of each range skipped by
+	// the cursor to its last one. The ranges are moved to failedBlocks in
+	// batches, so that a large gap is never written block by block.
+	missingBlocks sdkcollections.Map[uint64, uint64]
+
+	// withdrawalRequests holds the withdrawal requests read from the
+	// execution layer and not yet included in a block, keyed by request
+	// index.
+	withdrawalRequests sdkcollections.Map[uint64, *ctypes.WithdrawalRequest]
+
+	// checkpoints maps each recent consensus height to the deposit cursor,
+	// the number of deposits and the number of withdrawal requests at that
+	// height, so that a state sync snapshot only exports the data the chain
+	// had at its height.
+	checkpoints sdkcollections.Map[uint64, checkpoint]
+
+	// restoredState reads the beacon state restored from a snapshot, which
+	// the restored deposits are verified against.
+	restoredState RestoredStateFn
+
+	// mu protects the collections for concurrent access
 	mu sync.RWMutex
 
 	// logger is used for logging information and errors.
@@ -61,6 +107,44 @@ func NewStore(
 			sdkcollections.Uint64Key,
 			encoding.SSZValueCodec[*ctypes.Deposit]{},
 		),
+		cursor: sdkcollections.NewItem(
+			schemaBuilder,
+			sdkcollections.NewPrefix([]byte(KeyEth1CursorPrefix)),
+			KeyEth1CursorPrefix,
+			sdkcollections.Uint64Value,
+		),
+		failedBlocks: sdkcollections.NewKeySet(
+			schemaBuilder,
+			sdkcollections.NewPrefix([]byte(KeyFailedEth1BlocksPrefix)),
+			KeyFailedEth1BlocksPrefix,
+			sdkcollections.Uint64Key,
+		),
+		missingBlocks: sdkcollections.NewMap(
+			schemaBuilder,
+			sdkcollections.NewPrefix([]byte(KeyMissingEth1BlocksPrefix)),
+			KeyMissingEth1BlocksPrefix,
+			sdkcollections.Uint64Key,
+			sdkcollections.Uint64Value,
+		),
+		withdrawalRequests: sdkcollections.NewMap(
+			schemaBuilder,
+			sdkcollections.NewPrefix([]byte(KeyWithdrawalRequestPrefix)),
+			KeyWithdrawalRequestPrefix,
+			sdkcollections.Uint64Key,
+			encoding.SSZValueCodec[*ctypes.WithdrawalRequest]{},
+		),
+		checkpoints: sdkcollections.NewMap(
+			schemaBuilder,
+			sdkcollections.NewPrefix([]byte(KeyCheckpointPrefix)),
+			KeyCheckpointPrefix,
+			sdkcollections.Uint64Key,
+			codec.KeyToValueCodec(sdkcollections.PairKeyCodec(
+				sdkcollections.Uint64Key,
+				sdkcollections.PairKeyCodec(
+					sdkcollections.Uint64Key, sdkcollections.Uint64Key,
+				),
+			)),
+		),
 		logger: logger,
 	}
 	if _, err := schemaBuilder.Build(); err != nil {
@@ -132,10 +216,10 @@ func (kv *KVStore) Prune(start, end uint64) error {
 	var ctx = context.TODO()
 	kv.mu.Lock()
 	defer kv.mu.Unlock()
-	for i := range end {
+	for i := start; i < end; i++ {
 		// This only errors if the key passed in cannot be encoded.
-		if err := kv.store.Remove(ctx, start+i); err != nil {
-			return errors.Wrapf(err, "failed to prune deposit %d", start+i)
+		if err := kv.store.Remove(ctx, i); err != nil {
+			return errors.Wrapf(err, "failed to prune deposit %d", i)
 		}
 	}
 
@@ -0,0 +1,172 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package deposit_test
+
+import (
+	"testing"
+
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/log/noop"
+	"github.com/berachain/beacon-kit/node-core/components/storage"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/storage/deposit"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEth1BlockCursorPersistence(t *testing.T) {
+	db := dbm.NewMemDB()
+	store := deposit.NewStore(
+		storage.NewKVStoreProvider(db), noop.NewLogger[any](),
+	)
+
+	// Nothing processed yet.
+	cursor, err := store.GetEth1BlockCursor()
+	require.NoError(t, err)
+	require.Zero(t, cursor)
+	failed, err := store.GetFailedEth1Blocks()
+	require.NoError(t, err)
+	require.Empty(t, failed)
+
+	require.NoError(t, store.SetEth1BlockCursor(42))
+	require.NoError(t, store.AddFailedEth1Block(40))
+	require.NoError(t, store.AddFailedEth1Block(12))
+	require.NoError(t, store.AddFailedEth1Block(41))
+	require.NoError(t, store.RemoveFailedEth1Block(40))
+
+	// A store reopened on the same database, as after a restart, sees the
+	// cursor and the failed blocks in ascending order.
+	reopened := deposit.NewStore(
+		storage.NewKVStoreProvider(db), noop.NewLogger[any](),
+	)
+	cursor, err = reopened.GetEth1BlockCursor()
+	require.NoError(t, err)
+	require.Equal(t, uint64(42), cursor)
+	failed, err = reopened.GetFailedEth1Blocks()
+	require.NoError(t, err)
+	require.Equal(t, []uint64{12, 41}, failed)
+
+	// The cursor and failed blocks do not leak into the deposits.
+	deposits, err := reopened.GetDepositsByIndex(0, 10)
+	require.NoError(t, err)
+	require.Empty(t, deposits)
+}
+
+func TestWithdrawalRequestsByIndex(t *testing.T) {
+	store := deposit.NewStore(
+		storage.NewKVStoreProvider(dbm.NewMemDB()), noop.NewLogger[any](),
+	)
+	var (
+		addr = common.ExecutionAddress{0xaa}
+		reqs = []*ctypes.WithdrawalRequest{
+			ctypes.NewWithdrawalRequest(addr, [48]byte{0x01}, 0, 6),
+			ctypes.NewCredentialsChangeRequest(
+				addr, [48]byte{0x02}, common.ExecutionAddress{0xbb}, 7,
+			),
+			ctypes.NewWithdrawalRequest(addr, [48]byte{0x03}, 1e9, 8),
+		}
+	)
+	require.NoError(t, store.EnqueueWithdrawalRequests(reqs[1:]))
+	require.NoError(t, store.EnqueueWithdrawalRequests(reqs[:1]))
+
+	// Requests are returned by index, up to the first missing one.
+	got, err := store.GetWithdrawalRequestsByIndex(6, 16)
+	require.NoError(t, err)
+	require.Equal(t, ctypes.WithdrawalRequests(reqs), got)
+	got, err = store.GetWithdrawalRequestsByIndex(5, 16)
+	require.NoError(t, err)
+	require.Empty(t, got)
+
+	// Requests included in a block are pruned.
+	require.NoError(t, store.PruneWithdrawalRequests(6, 8))
+	got, err = store.GetWithdrawalRequestsByIndex(6, 16)
+	require.NoError(t, err)
+	require.Empty(t, got)
+	got, err = store.GetWithdrawalRequestsByIndex(8, 16)
+	require.NoError(t, err)
+	require.Equal(t, ctypes.WithdrawalRequests(reqs[2:]), got)
+}
+
+func TestPruneDeposits(t *testing.T) {
+	store := deposit.NewStore(
+		storage.NewKVStoreProvider(dbm.NewMemDB()), noop.NewLogger[any](),
+	)
+	deposits := ctypes.Deposits{
+		{Pubkey: [48]byte{0x01}, Amount: 32e9, Index: 0},
+		{Pubkey: [48]byte{0x02}, Amount: 32e9, Index: 1},
+		{Pubkey: [48]byte{0x03}, Amount: 32e9, Index: 2},
+		{Pubkey: [48]byte{0x04}, Amount: 32e9, Index: 3},
+	}
+	require.NoError(t, store.EnqueueDeposits(deposits))
+
+	// Only the deposits in [start, end) are removed.
+	require.NoError(t, store.Prune(1, 3))
+	got, err := store.GetDepositsByIndex(0, 10)
+	require.NoError(t, err)
+	require.Equal(t, deposits[:1], got)
+	got, err = store.GetDepositsByIndex(3, 10)
+	require.NoError(t, err)
+	require.Equal(t, deposits[3:], got)
+}
+
+func TestMissingEth1Blocks(t *testing.T) {
+	store := deposit.NewStore(
+		storage.NewKVStoreProvider(dbm.NewMemDB()), noop.NewLogger[any](),
+	)
+
+	// Nothing is missing before a block was processed.
+	require.NoError(t, store.MarkEth1BlocksMissing(100))
+	_, found, err := store.GetOldestMissingEth1Block()
+	require.NoError(t, err)
+	require.False(t, found)
+
+	// The gap is recorded as a range and the cursor moves past it.
+	require.NoError(t, store.SetEth1BlockCursor(10))
+	require.NoError(t, store.MarkEth1BlocksMissing(1_000_010))
+	cursor, err := store.GetEth1BlockCursor()
+	require.NoError(t, err)
+	require.Equal(t, uint64(1_000_010), cursor)
+	oldest, found, err := store.GetOldestMissingEth1Block()
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, uint64(11), oldest)
+
+	// Missing blocks are moved to the failed set in batches.
+	blocks, err := store.TakeMissingEth1Blocks(3)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{11, 12, 13}, blocks)
+	failed, err := store.GetFailedEth1Blocks()
+	require.NoError(t, err)
+	require.Equal(t, []uint64{11, 12, 13}, failed)
+	for _, blockNum := range blocks {
+		require.NoError(t, store.RemoveFailedEth1Block(blockNum))
+	}
+	oldest, _, err = store.GetOldestMissingEth1Block()
+	require.NoError(t, err)
+	require.Equal(t, uint64(14), oldest)
+
+	blocks, err = store.TakeMissingEth1Blocks(2_000_000)
+	require.NoError(t, err)
+	require.Len(t, blocks, 1_000_010-13)
+	blocks, err = store.TakeMissingEth1Blocks(1)
+	require.NoError(t, err)
+	require.Empty(t, blocks)
+}
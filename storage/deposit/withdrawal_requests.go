@@ -0,0 +1,123 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package deposit
+
+import (
+	"context"
+
+	sdkcollections "cosmossdk.io/collections"
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/storage/pruner"
+)
+
+// GetWithdrawalRequestsByIndex returns the first N withdrawal requests
+// starting from the given index. If N is greater than the number of
+// contiguous requests, it returns up to the last of them.
+func (kv *KVStore) GetWithdrawalRequestsByIndex(
+	startIndex uint64,
+	numView uint64,
+) (ctypes.WithdrawalRequests, error) {
+	kv.mu.RLock()
+	defer kv.mu.RUnlock()
+	var (
+		requests = make(ctypes.WithdrawalRequests, 0, numView)
+		endIdx   = startIndex + numView
+	)
+
+	for i := startIndex; i < endIdx; i++ {
+		request, err := kv.withdrawalRequests.Get(context.TODO(), i)
+		switch {
+		case err == nil:
+			requests = append(requests, request)
+		case errors.Is(err, sdkcollections.ErrNotFound):
+			return requests, nil
+		default:
+			return requests, errors.Wrapf(
+				err, "failed to get withdrawal request %d", i,
+			)
+		}
+	}
+	return requests, nil
+}
+
+// EnqueueWithdrawalRequests pushes multiple withdrawal requests to the queue.
+func (kv *KVStore) EnqueueWithdrawalRequests(
+	requests []*ctypes.WithdrawalRequest,
+) error {
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+
+	for _, req := range requests {
+		if err := kv.withdrawalRequests.Set(
+			context.TODO(), req.Index, req,
+		); err != nil {
+			return errors.Wrapf(
+				err, "failed to enqueue withdrawal request %d", req.Index,
+			)
+		}
+	}
+	return nil
+}
+
+// PruneWithdrawalRequests removes the [start, end) withdrawal requests from
+// the store. Unlike deposits, processed requests are not needed to verify
+// the following blocks.
+func (kv *KVStore) PruneWithdrawalRequests(start, end uint64) error {
+	if start > end {
+		return errors.Wrapf(
+			pruner.ErrInvalidRange,
+			"PruneWithdrawalRequests start: %d, end: %d", start, end,
+		)
+	}
+
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+	for i := start; i < end; i++ {
+		// This only errors if the key passed in cannot be encoded.
+		if err := kv.withdrawalRequests.Remove(context.TODO(), i); err != nil {
+			return errors.Wrapf(err, "failed to prune withdrawal request %d", i)
+		}
+	}
+	return nil
+}
+
+// withdrawalRequestCount returns the index following the one of the last
+// withdrawal request in the store.
+func (kv *KVStore) withdrawalRequestCount(
+	ctx context.Context,
+) (uint64, error) {
+	iter, err := kv.withdrawalRequests.Iterate(
+		ctx, new(sdkcollections.Range[uint64]).Descending(),
+	)
+	if err != nil {
+		return 0, err
+	}
+	defer iter.Close()
+	if !iter.Valid() {
+		return 0, nil
+	}
+	last, err := iter.Key()
+	if err != nil {
+		return 0, err
+	}
+	return last + 1, nil
+}